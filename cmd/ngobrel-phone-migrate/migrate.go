@@ -0,0 +1,133 @@
+// ngobrel-phone-migrate normalizes the phone numbers of existing profiles to E.164.
+//
+// Numbers which can not be parsed, and numbers which would end up the same as the number of
+// another profile, are reported and left untouched so they can be merged by hand. Without
+// -apply nothing is written to the database.
+//
+//	ngobrel-phone-migrate -region ID -apply
+package main
+
+import (
+	"database/sql"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+
+	_ "github.com/lib/pq"
+	pb "ngobrel.rocks/ngobrel"
+)
+
+type profile struct {
+	userID      string
+	phoneNumber string
+}
+
+func main() {
+	region := flag.String("region", pb.DefaultPhoneRegion, "region of phone numbers without a country code")
+	apply := flag.Bool("apply", false, "write the normalized phone numbers to the database")
+	flag.Parse()
+
+	db, err := sql.Open("postgres", os.Getenv("DB_URL"))
+	if err != nil {
+		log.Fatalln(err)
+	}
+	defer db.Close()
+
+	profiles, err := loadProfiles(db)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	// Groups the profiles by their normalized phone number
+	byNumber := make(map[string][]profile)
+	var invalid []profile
+	for _, p := range profiles {
+		normalized, err := pb.NormalizePhoneNumber(p.phoneNumber, *region)
+		if err != nil {
+			invalid = append(invalid, p)
+			continue
+		}
+		byNumber[normalized] = append(byNumber[normalized], p)
+	}
+
+	var numbers []string
+	for number := range byNumber {
+		numbers = append(numbers, number)
+	}
+	sort.Strings(numbers)
+
+	var changes []profile
+	collisions := 0
+	for _, number := range numbers {
+		list := byNumber[number]
+		if len(list) > 1 {
+			collisions++
+			fmt.Println("collision", number)
+			for _, p := range list {
+				fmt.Printf("\t%s %q\n", p.userID, p.phoneNumber)
+			}
+			continue
+		}
+
+		if list[0].phoneNumber != number {
+			changes = append(changes, profile{userID: list[0].userID, phoneNumber: number})
+		}
+	}
+
+	for _, p := range invalid {
+		fmt.Printf("invalid %s %q\n", p.userID, p.phoneNumber)
+	}
+
+	fmt.Printf("%d profiles, %d to normalize, %d collisions, %d invalid\n", len(profiles), len(changes), collisions, len(invalid))
+	if *apply == false || len(changes) == 0 {
+		return
+	}
+
+	if err := updateProfiles(db, changes); err != nil {
+		log.Fatalln(err)
+	}
+	fmt.Println("normalized", len(changes), "profiles")
+}
+
+func loadProfiles(db *sql.DB) ([]profile, error) {
+	rows, err := db.Query(`SELECT user_id, phone_number FROM profile WHERE deleted_at IS NULL ORDER BY created_at`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []profile
+	for rows.Next() {
+		var p profile
+		if err := rows.Scan(&p.userID, &p.phoneNumber); err != nil {
+			return nil, err
+		}
+		list = append(list, p)
+	}
+	return list, rows.Err()
+}
+
+func updateProfiles(db *sql.DB, changes []profile) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+
+	for _, p := range changes {
+		_, err := tx.Exec(`UPDATE profile SET phone_number=$1, updated_at=now() WHERE user_id=$2`, p.phoneNumber, p.userID)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	// Pending OTPs were sent to the old form of the numbers
+	if _, err := tx.Exec(`DELETE FROM otp`); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
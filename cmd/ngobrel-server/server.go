@@ -1,10 +1,15 @@
 package main
 
 import (
+	"expvar"
 	"fmt"
 	"log"
 	"net"
+	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	minio "github.com/minio/minio-go"
 	"google.golang.org/grpc"
@@ -21,16 +26,10 @@ func main() {
 		log.Fatalf("failed to listen: %v", err)
 	}
 
-	var smsClient pb.Sms
-	smsAccount, smsAccountExists := os.LookupEnv("SMS_ACCOUNT")
-
-	if smsAccountExists {
-		smsClient = pb.NewZenzivaSms()
-	} else {
-		smsClient = pb.NewDummySms()
+	smsClient, err := pb.NewSmsFromEnv()
+	if err != nil {
+		log.Fatalln(err)
 	}
-	smsClient.SetAccount(smsAccount, os.Getenv("SMS_TOKEN"))
-	smsClient.SetValue("subdomain", os.Getenv("SMS_SUBDOMAIN"))
 
 	minioClient, err := minio.New(os.Getenv("MINIO_URL"), os.Getenv("MINIO_ACCESS_KEY"), os.Getenv("MINIO_SECRET_KEY"), false)
 	if err != nil {
@@ -38,14 +37,57 @@ func main() {
 		log.Fatalln(err)
 	}
 
-	s := grpc.NewServer()
 	server := pb.NewServer(smsClient, *minioClient)
 	server.InitDB()
+	server.StartAccountDeletionWorker()
+	server.StartSmsWorkers()
+	server.StartPushWorkers()
+
+	// Delivery receipts of the SMS providers
+	if addr := os.Getenv("SMS_CALLBACK_ADDR"); addr != "" {
+		handler, err := server.SmsCallbackHandler()
+		if err != nil {
+			log.Fatalln(err)
+		}
+		mux := http.NewServeMux()
+		mux.Handle("/sms/callback", handler)
+		go func() {
+			log.Fatalln(http.ListenAndServe(addr, mux))
+		}()
+	}
+
+	// Counters, e.g. of the push notifications, at /debug/vars
+	if addr := os.Getenv("METRICS_ADDR"); addr != "" {
+		mux := http.NewServeMux()
+		mux.Handle("/debug/vars", expvar.Handler())
+		go func() {
+			log.Fatalln(http.ListenAndServe(addr, mux))
+		}()
+	}
+
+	s := grpc.NewServer(
+		grpc.UnaryInterceptor(server.UnaryAuthInterceptor),
+		grpc.StreamInterceptor(server.StreamAuthInterceptor),
+	)
 
 	pb.RegisterNgobrelServer(s, server)
 	// Register reflection service on gRPC server.
 	reflection.Register(s)
+
+	// On shutdown the requests in flight are finished, long lived streams are given
+	// a few seconds, then the notifications still queued are sent
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		<-stop
+		log.Println("Shutting down")
+		timer := time.AfterFunc(10*time.Second, s.Stop)
+		s.GracefulStop()
+		timer.Stop()
+	}()
+
 	if err := s.Serve(lis); err != nil {
 		log.Fatalf("failed to serve: %v", err)
 	}
+	server.StopPushWorkers()
 }
@@ -9,11 +9,59 @@ REDIS_HOST=${REDIS_HOST:-localhost}
 REDIS_PORT=${REDIS_PORT:-6379}
 REDIS_URL="$REDIS_HOST:$REDIS_PORT"
 
+# Returns the OTP in the responses, never set it in production
+DEBUG_MODE=${DEBUG_MODE:-0}
+
 SMS_ACCOUNT=${SMS_ACCOUNT:-twilio-account-id}
 SMS_TOKEN=${SMS_TOKEN:-twilio-token}
+SMS_CONFIG_PATH=${SMS_CONFIG_PATH:-}
+SMS_PROVIDERS=${SMS_PROVIDERS:-}
+TWILIO_ACCOUNT=${TWILIO_ACCOUNT:-}
+TWILIO_TOKEN=${TWILIO_TOKEN:-}
+SMS_WORKERS=${SMS_WORKERS:-4}
+SMS_CALLBACK_ADDR=${SMS_CALLBACK_ADDR:-}
+SMS_CALLBACK_TOKEN=${SMS_CALLBACK_TOKEN:-}
+SMS_TEMPLATES_PATH=${SMS_TEMPLATES_PATH:-}
+OTP_CHANNELS=${OTP_CHANNELS:-sms}
+VOICE_ACCOUNT=${VOICE_ACCOUNT:-}
+VOICE_TOKEN=${VOICE_TOKEN:-}
+VOICE_FROM=${VOICE_FROM:-}
+VOICE_URL=${VOICE_URL:-}
+SMTP_ADDR=${SMTP_ADDR:-}
+SMTP_USER=${SMTP_USER:-}
+SMTP_PASSWORD=${SMTP_PASSWORD:-}
+SMTP_FROM=${SMTP_FROM:-}
+SMTP_SUBJECT=${SMTP_SUBJECT:-}
 FCM_CONFIG_PATH=
+FCM_PROJECT_URL=${FCM_PROJECT_URL:-}
+APNS_KEY_PATH=${APNS_KEY_PATH:-}
+APNS_KEY_ID=${APNS_KEY_ID:-}
+APNS_TEAM_ID=${APNS_TEAM_ID:-}
+APNS_TOPIC=${APNS_TOPIC:-}
+APNS_URL=${APNS_URL:-https://api.push.apple.com}
+VAPID_PRIVATE_KEY=${VAPID_PRIVATE_KEY:-}
+VAPID_SUBJECT=${VAPID_SUBJECT:-}
+METRICS_ADDR=${METRICS_ADDR:-}
+PUSH_WORKERS=${PUSH_WORKERS:-8}
+PUSH_QUEUE_SIZE=${PUSH_QUEUE_SIZE:-1000}
+
+OTP_LENGTH=${OTP_LENGTH:-6}
+OTP_SECRET=${OTP_SECRET:-}
+SMS_DAILY_BUDGET=${SMS_DAILY_BUDGET:-10000}
+PHONE_DEFAULT_REGION=${PHONE_DEFAULT_REGION:-ID}
 
 export FCM_CONFIG_PATH
+export FCM_PROJECT_URL
+export APNS_KEY_PATH
+export APNS_KEY_ID
+export APNS_TEAM_ID
+export APNS_TOPIC
+export APNS_URL
+export VAPID_PRIVATE_KEY
+export VAPID_SUBJECT
+export METRICS_ADDR
+export PUSH_WORKERS
+export PUSH_QUEUE_SIZE
 export DB_NAME
 export DB_USER
 export DB_PASS
@@ -25,5 +73,28 @@ export REDIS_PORT
 
 export DB_URL
 export REDIS_URL
+export DEBUG_MODE
 export SMS_ACCOUNT
 export SMS_TOKEN
+export SMS_CONFIG_PATH
+export SMS_PROVIDERS
+export TWILIO_ACCOUNT
+export TWILIO_TOKEN
+export SMS_WORKERS
+export SMS_CALLBACK_ADDR
+export SMS_CALLBACK_TOKEN
+export SMS_TEMPLATES_PATH
+export OTP_CHANNELS
+export VOICE_ACCOUNT
+export VOICE_TOKEN
+export VOICE_FROM
+export VOICE_URL
+export SMTP_ADDR
+export SMTP_USER
+export SMTP_PASSWORD
+export SMTP_FROM
+export SMTP_SUBJECT
+export OTP_LENGTH
+export OTP_SECRET
+export SMS_DAILY_BUDGET
+export PHONE_DEFAULT_REGION
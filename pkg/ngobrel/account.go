@@ -0,0 +1,412 @@
+package ngobrel
+
+import (
+	"database/sql"
+	"log"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"golang.org/x/net/context"
+)
+
+// Deleting an account runs as a background job recorded in account_deletions, which
+// also serves as the audit record. The job goes through accountDeletionSteps in order
+// and saves its progress after every step, so a job interrupted by an error or a
+// restart resumes where it stopped. Every step can be run more than once.
+//
+// The phone number is released and the sessions are revoked right away by DeleteAccount,
+// the profile row itself is kept anonymized so old messages and group lists still resolve.
+
+type accountDeletionStep struct {
+	name string
+	run  func(srv *Server, userID string) error
+}
+
+var accountDeletionSteps = []accountDeletionStep{
+	{"sessions", deleteAccountSessions},
+	{"groups", deleteAccountGroups},
+	{"conversations", deleteAccountConversations},
+	{"chat_list", deleteAccountChatList},
+	{"contacts", deleteAccountContacts},
+	{"media", deleteAccountMedia},
+	{"devices", deleteAccountDevices},
+	{"profile", deleteAccountProfile},
+}
+
+func (req *DeleteAccountRequest) DeleteAccount(srv *Server, ctx context.Context, userID uuid.UUID, deviceID uuid.UUID) (*DeleteAccountResponse, error) {
+	isSet, err := hasPIN(srv, userID.String())
+	if err != nil {
+		return nil, err
+	}
+	if isSet {
+		if err := srv.checkPIN(ctx, userID.String(), req.CurrentPIN); err != nil {
+			return nil, err
+		}
+	}
+
+	tx, err := srv.db.Begin()
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	_, err = tx.Exec(`INSERT INTO account_deletions (user_id, requested_by, requested_at, step, attempts, last_error)
+	values ($1, $2, now(), 0, 0, '') ON CONFLICT (user_id) DO NOTHING`, userID.String(), deviceID.String())
+	if err != nil {
+		_ = tx.Rollback()
+		log.Println(err)
+		return nil, err
+	}
+
+	// The phone number can be registered again right away, and the email address is forgotten
+	_, err = tx.Exec(`UPDATE profile SET phone_number='deleted-' || user_id, email=NULL, pending_email=NULL, pending_email_hash=NULL, pending_email_expired_at=NULL,
+	deleted_at=now(), updated_at=now() WHERE user_id=$1`, userID.String())
+	if err != nil {
+		_ = tx.Rollback()
+		log.Println(err)
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	log.Println("Account deletion requested", userID.String())
+
+	err = deleteAccountSessions(srv, userID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	srv.wakeAccountDeletions()
+	return &DeleteAccountResponse{Success: true}, nil
+}
+
+func (srv *Server) wakeAccountDeletions() {
+	select {
+	case srv.accountDeletionWake <- struct{}{}:
+	default:
+	}
+}
+
+// Runs the pending account deletions, on start, every AccountDeletionRetryInterval
+// and whenever an account deletion is requested
+func (srv *Server) StartAccountDeletionWorker() {
+	go func() {
+		ticker := time.NewTicker(AccountDeletionRetryInterval)
+		defer ticker.Stop()
+
+		for {
+			srv.runAccountDeletions()
+
+			select {
+			case <-ticker.C:
+			case <-srv.accountDeletionWake:
+			}
+		}
+	}()
+}
+
+func (srv *Server) runAccountDeletions() {
+	rows, err := srv.db.Query(`SELECT user_id, step FROM account_deletions WHERE completed_at IS NULL ORDER BY requested_at`)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	type job struct {
+		userID string
+		step   int
+	}
+
+	var jobs []job
+	for rows.Next() {
+		var j job
+		if err := rows.Scan(&j.userID, &j.step); err != nil {
+			log.Println(err)
+			rows.Close()
+			return
+		}
+		jobs = append(jobs, j)
+	}
+	rows.Close()
+
+	for _, j := range jobs {
+		// Another server may be working on the same job
+		locked, err := srv.redisClient.SetNX("LOCK-DELETE-"+j.userID, "1", AccountDeletionLockTTL).Result()
+		if err != nil {
+			log.Println(err)
+			return
+		}
+		if locked == false {
+			continue
+		}
+
+		srv.runAccountDeletion(j.userID, j.step)
+		srv.redisClient.Del("LOCK-DELETE-" + j.userID)
+	}
+}
+
+func (srv *Server) runAccountDeletion(userID string, step int) {
+	for ; step < len(accountDeletionSteps); step++ {
+		s := accountDeletionSteps[step]
+
+		err := s.run(srv, userID)
+		if err != nil {
+			log.Println("Account deletion", userID, "failed at", s.name, err)
+			_, err = srv.db.Exec(`UPDATE account_deletions SET attempts=attempts+1, last_error=$1 WHERE user_id=$2`, s.name+": "+err.Error(), userID)
+			if err != nil {
+				log.Println(err)
+			}
+			return
+		}
+
+		_, err = srv.db.Exec(`UPDATE account_deletions SET step=$1 WHERE user_id=$2`, step+1, userID)
+		if err != nil {
+			log.Println(err)
+			return
+		}
+	}
+
+	_, err := srv.db.Exec(`UPDATE account_deletions SET completed_at=now(), last_error='' WHERE user_id=$1`, userID)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	log.Println("Account deleted", userID)
+}
+
+func getAccountDeviceIDs(srv *Server, userID string) ([]string, error) {
+	rows, err := srv.db.Query(`SELECT device_id FROM devices WHERE user_id=$1`, userID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	defer rows.Close()
+	var deviceIDs []string
+	for rows.Next() {
+		var deviceID string
+		if err := rows.Scan(&deviceID); err != nil {
+			log.Println(err)
+			return nil, err
+		}
+		deviceIDs = append(deviceIDs, deviceID)
+	}
+	return deviceIDs, nil
+}
+
+// Revokes every token and drops the push tokens of the account
+func deleteAccountSessions(srv *Server, userID string) error {
+	err := revokeSessions(srv, userID, "")
+	if err != nil {
+		return err
+	}
+
+	deviceIDs, err := getAccountDeviceIDs(srv, userID)
+	if err != nil {
+		return err
+	}
+
+	keys := []string{"FCM-" + userID, "SES-" + userID}
+	for _, deviceID := range deviceIDs {
+		keys = append(keys, pushTokenKeys(deviceID)...)
+		keys = append(keys, "SEEN-"+deviceID)
+	}
+
+	err = srv.redisClient.Del(keys...).Err()
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	_, err = srv.db.Exec(`UPDATE devices SET device_state=0, is_primary=false, updated_at=now() WHERE user_id=$1`, userID)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+// Leaves every group of the account. Admin roles are handed to the longest standing
+// member when the account was the last admin, and groups without members are dissolved.
+func deleteAccountGroups(srv *Server, userID string) error {
+	rows, err := srv.db.Query(`SELECT chat_id FROM chat_list WHERE user_id=$1 AND chat_type=1`, userID)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	var groupIDs []string
+	for rows.Next() {
+		var groupID string
+		if err := rows.Scan(&groupID); err != nil {
+			log.Println(err)
+			rows.Close()
+			return err
+		}
+		groupIDs = append(groupIDs, groupID)
+	}
+	rows.Close()
+
+	for _, groupID := range groupIDs {
+		dissolved, err := leaveGroupForDeletion(srv, userID, groupID)
+		if err != nil {
+			return err
+		}
+
+		if dissolved {
+			err = srv.RemoveGroupBucket(groupID)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	_, err = srv.db.Exec(`DELETE FROM group_bans WHERE user_id=$1`, userID)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	_, err = srv.db.Exec(`DELETE FROM group_mutes WHERE user_id=$1`, userID)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+func leaveGroupForDeletion(srv *Server, userID, groupID string) (bool, error) {
+	ctx := context.Background()
+	tx, err := srv.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		log.Println(err)
+		return false, err
+	}
+
+	_, err = tx.Exec(`DELETE FROM chat_list WHERE user_id=$1 AND chat_id=$2`, userID, groupID)
+	if err != nil {
+		_ = tx.Rollback()
+		log.Println(err)
+		return false, err
+	}
+
+	var members, admins int
+	err = tx.QueryRow(`SELECT count(*), count(*) FILTER (WHERE is_admin=1) FROM chat_list WHERE chat_id=$1`, groupID).Scan(&members, &admins)
+	if err != nil {
+		_ = tx.Rollback()
+		log.Println(err)
+		return false, err
+	}
+
+	if members == 0 {
+		_, err = tx.Exec(`UPDATE group_list SET dissolved_at=now(), updated_at=now() WHERE chat_id=$1 AND dissolved_at IS NULL`, groupID)
+		if err == nil {
+			_, err = tx.Exec(`DELETE FROM media WHERE uploader=$1`, groupID)
+		}
+		if err != nil {
+			_ = tx.Rollback()
+			log.Println(err)
+			return false, err
+		}
+
+		return true, tx.Commit()
+	}
+
+	if admins == 0 {
+		_, err = tx.Exec(`UPDATE chat_list SET is_admin=1, updated_at=now() WHERE chat_id=$1 AND user_id=
+		(SELECT user_id FROM chat_list WHERE chat_id=$1 ORDER BY created_at LIMIT 1)`, groupID)
+		if err != nil {
+			_ = tx.Rollback()
+			log.Println(err)
+			return false, err
+		}
+	}
+
+	// The group keeps a creator, the role goes to an admin
+	_, err = tx.Exec(`UPDATE group_list SET creator_id=
+	(SELECT user_id FROM chat_list WHERE chat_id=$1 AND is_admin=1 ORDER BY created_at LIMIT 1), updated_at=now()
+	WHERE chat_id=$1 AND creator_id=$2`, groupID, userID)
+	if err != nil {
+		_ = tx.Rollback()
+		log.Println(err)
+		return false, err
+	}
+
+	return false, tx.Commit()
+}
+
+func deleteAccountConversations(srv *Server, userID string) error {
+	_, err := srv.db.Exec(`DELETE FROM conversations WHERE recipient_id=$1 OR sender_id=$1`, userID)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	_, err = srv.db.Exec(`DELETE FROM conversations_state WHERE recipient_id=$1 OR sender_id=$1`, userID)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+func deleteAccountChatList(srv *Server, userID string) error {
+	_, err := srv.db.Exec(`DELETE FROM chat_list WHERE user_id=$1`, userID)
+	if err != nil {
+		log.Println(err)
+	}
+	return err
+}
+
+func deleteAccountContacts(srv *Server, userID string) error {
+	_, err := srv.db.Exec(`DELETE FROM contacts WHERE user_id=$1 OR chat_id=$1`, userID)
+	if err != nil {
+		log.Println(err)
+	}
+	return err
+}
+
+func deleteAccountMedia(srv *Server, userID string) error {
+	err := srv.removeBucket(userID)
+	if err != nil {
+		return err
+	}
+
+	_, err = srv.db.Exec(`DELETE FROM media WHERE uploader=$1`, userID)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	_, err = srv.db.Exec(`DELETE FROM data_exports WHERE user_id=$1`, userID)
+	if err != nil {
+		log.Println(err)
+	}
+	return err
+}
+
+func deleteAccountDevices(srv *Server, userID string) error {
+	_, err := srv.db.Exec(`DELETE FROM devices WHERE user_id=$1`, userID)
+	if err != nil {
+		log.Println(err)
+	}
+	return err
+}
+
+func deleteAccountProfile(srv *Server, userID string) error {
+	_, err := srv.db.Exec(`UPDATE profile SET name='', user_name=NULL, avatar=NULL, avatar_thumbnail=NULL, custom_data=NULL,
+	pin_hash=NULL, pin_verified_at=NULL, email=NULL, pending_email=NULL, pending_email_hash=NULL, pending_email_expired_at=NULL,
+	phone_number='deleted-' || user_id, deleted_at=COALESCE(deleted_at, now()), updated_at=now()
+	WHERE user_id=$1`, userID)
+	if err != nil {
+		log.Println(err)
+	}
+	return err
+}
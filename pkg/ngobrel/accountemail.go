@@ -0,0 +1,110 @@
+package ngobrel
+
+import (
+	"errors"
+	"log"
+	"net/mail"
+	"strings"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// The email address of an account is only stored in profile.email once it is verified with
+// a code sent to it, so the email OTP channel never sends to an address nobody has proven to own.
+// The address being verified waits in profile.pending_email, with the hash of its code.
+
+// Checks an email address, which has to be a bare address without a display name
+func normalizeEmail(email string) (string, error) {
+	email = strings.TrimSpace(email)
+	address, err := mail.ParseAddress(email)
+	if err != nil || address.Address != email {
+		return "", errors.New("invalid-email")
+	}
+	return strings.ToLower(email), nil
+}
+
+func (req *RequestEmailVerificationRequest) RequestEmailVerification(srv *Server, userID uuid.UUID, deviceID uuid.UUID) (*RequestEmailVerificationResponse, error) {
+	if _, ok := srv.otpChannels[OTPChannelEmail]; ok == false {
+		return nil, errors.New("otp-channel-not-available")
+	}
+
+	code, err := generateOTP(srv.otpLength)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	codeHash := hashOTP(srv.otpSecret, req.Email, deviceID.String(), code)
+
+	log.Println("RequestEmailVerification", userID.String(), req.Email)
+
+	tx, err := srv.db.Begin()
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	var phoneNumber string
+	err = tx.QueryRow(`UPDATE profile SET pending_email=$1, pending_email_hash=$2, pending_email_expired_at=now() + $3::float8 * interval '1 second'
+	WHERE user_id=$4 RETURNING phone_number`, req.Email, codeHash, OTPTTL.Seconds(), userID.String()).Scan(&phoneNumber)
+	if err != nil {
+		_ = tx.Rollback()
+		log.Println(err)
+		return nil, err
+	}
+
+	otpKey, err := storeOTPCode(srv, code)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	brand, _, locale := srv.smsTemplates.lookup(phoneNumber, req.Locale, req.Brand)
+	err = queueMessage(tx, OTPChannelEmail, brand.sender, req.Email, locale, req.Brand, "", otpKey, 0)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	srv.wakeSmsQueue()
+
+	if srv.debugMode == false {
+		code = ""
+	}
+
+	return &RequestEmailVerificationResponse{
+		CodeDebug: code, // DEBUG Mode
+	}, nil
+}
+
+func (req *VerifyEmailRequest) VerifyEmail(srv *Server, userID uuid.UUID, deviceID uuid.UUID) (*VerifyEmailResponse, error) {
+	codeHash := hashOTP(srv.otpSecret, req.Email, deviceID.String(), req.Code)
+
+	// The code can only be used once
+	result, err := srv.db.Exec(`UPDATE profile SET email=pending_email, pending_email=NULL, pending_email_hash=NULL, pending_email_expired_at=NULL, updated_at=now()
+	WHERE user_id=$1 AND pending_email=$2 AND pending_email_hash=$3 AND pending_email_expired_at > now()`,
+		userID.String(), req.Email, codeHash)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	if count != 1 {
+		log.Println("Email verification failed for", userID.String())
+		return nil, errors.New("verification-email-failed")
+	}
+
+	log.Println("Email verified", userID.String(), req.Email)
+
+	return &VerifyEmailResponse{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,263 @@
+package ngobrel
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"expvar"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/http2"
+)
+
+// Counts the outcomes of the APNs requests, as fcmStats does for FCM
+var apnsStats = expvar.NewMap("apns")
+
+// Sends notifications straight to Apple Push Notification service over HTTP/2,
+// authenticated with a provider token signed with the .p8 key of the team
+type APNsPush struct {
+	client *http.Client
+	url    string
+	keyID  string
+	teamID string
+	// The bundle ID of the app
+	topic string
+	key   *ecdsa.PrivateKey
+
+	lock     sync.Mutex
+	token    string
+	issuedAt time.Time
+}
+
+// An error response of APNs, see
+// https://developer.apple.com/documentation/usernotifications/handling-notification-responses-from-apns
+type APNsError struct {
+	StatusCode int
+	Reason     string
+	RetryAfter time.Duration
+}
+
+func (e *APNsError) Error() string {
+	return fmt.Sprintf("apns: %d %s", e.StatusCode, e.Reason)
+}
+
+func (e *APNsError) TokenInvalid() bool {
+	return e.StatusCode == http.StatusGone || e.Reason == "BadDeviceToken" || e.Reason == "DeviceTokenNotForTopic" || e.Reason == "Unregistered"
+}
+
+func (e *APNsError) Retryable() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= 500 || e.Reason == "ExpiredProviderToken"
+}
+
+func (e *APNsError) retryAfter() time.Duration {
+	return e.RetryAfter
+}
+
+func (e *APNsError) outcome() string {
+	switch {
+	case e.StatusCode == http.StatusGone || e.Reason == "Unregistered":
+		return "unregistered"
+	case e.TokenInvalid():
+		return "bad_device_token"
+	case e.Reason == "ExpiredProviderToken":
+		return "expired_provider_token"
+	case e.StatusCode == http.StatusTooManyRequests:
+		return "too_many_requests"
+	case e.StatusCode >= 500:
+		return "server_error"
+	}
+	return "rejected"
+}
+
+// Whether a device token is what iOS gives, 32 bytes in hex. It is put in the request path.
+func validAPNsToken(token string) bool {
+	if len(token) != 64 {
+		return false
+	}
+	_, err := hex.DecodeString(token)
+	return err == nil
+}
+
+// Reads the .p8 key APNs gives for token based authentication
+func ParseAPNsKey(data []byte) (*ecdsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("invalid-apns-key")
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	ecKey, ok := key.(*ecdsa.PrivateKey)
+	if ok == false {
+		return nil, errors.New("invalid-apns-key")
+	}
+	return ecKey, nil
+}
+
+// Sets up APNs at url, https://api.push.apple.com or https://api.sandbox.push.apple.com.
+// A plain http url is a local fake APNs endpoint, which is reached over HTTP/1.1.
+func NewAPNsPush(key *ecdsa.PrivateKey, keyID, teamID, topic, url string) *APNsPush {
+	client := &http.Client{Timeout: 30 * time.Second}
+	if strings.HasPrefix(url, "http://") == false {
+		client.Transport = &http2.Transport{}
+	}
+
+	return &APNsPush{
+		client: client,
+		url:    strings.TrimSuffix(url, "/"),
+		keyID:  keyID,
+		teamID: teamID,
+		topic:  topic,
+		key:    key,
+	}
+}
+
+func newAPNsFromEnv() (*APNsPush, error) {
+	data, err := ioutil.ReadFile(os.Getenv("APNS_KEY_PATH"))
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := ParseAPNsKey(data)
+	if err != nil {
+		return nil, err
+	}
+
+	keyID := os.Getenv("APNS_KEY_ID")
+	teamID := os.Getenv("APNS_TEAM_ID")
+	topic := os.Getenv("APNS_TOPIC")
+	if keyID == "" || teamID == "" || topic == "" {
+		return nil, errors.New("APNS_KEY_ID, APNS_TEAM_ID and APNS_TOPIC must be set")
+	}
+
+	url := os.Getenv("APNS_URL")
+	if url == "" {
+		url = "https://api.push.apple.com"
+	}
+
+	return NewAPNsPush(key, keyID, teamID, topic, url), nil
+}
+
+// Returns the provider token, signing a new one every APNsTokenRefresh
+func (a *APNsPush) providerToken(renew bool) (string, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	if a.token != "" && renew == false && time.Since(a.issuedAt) < APNsTokenRefresh {
+		return a.token, nil
+	}
+
+	now := time.Now()
+	token, err := signJWT(a.key,
+		map[string]string{"alg": "ES256", "kid": a.keyID},
+		map[string]interface{}{"iss": a.teamID, "iat": now.Unix()})
+	if err != nil {
+		return "", err
+	}
+
+	a.token = token
+	a.issuedAt = now
+	return token, nil
+}
+
+func (a *APNsPush) SendNotification(token string, notification *PushNotification) error {
+	if validAPNsToken(token) == false {
+		return &APNsError{StatusCode: http.StatusBadRequest, Reason: "BadDeviceToken"}
+	}
+
+	payload := make(map[string]interface{})
+	for k, v := range notification.Data {
+		payload[k] = v
+	}
+
+	pushType := "background"
+	priority := "5"
+	if notification.Title != "" || notification.Body != "" {
+		pushType = "alert"
+		priority = "10"
+		payload["aps"] = map[string]interface{}{
+			"alert": map[string]string{
+				"title": notification.Title,
+				"body":  notification.Body,
+			},
+			"sound": "default",
+		}
+	} else {
+		payload["aps"] = map[string]interface{}{
+			"content-available": 1,
+		}
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	renew := false
+	return sendWithRetry(apnsStats, "APNs", func() error {
+		err := a.send(token, body, pushType, priority, renew)
+		if apnsErr, ok := err.(*APNsError); ok && apnsErr.Reason == "ExpiredProviderToken" {
+			renew = true
+		}
+		return err
+	})
+}
+
+// Sends a notification once. APNs errors are returned as *APNsError.
+func (a *APNsPush) send(deviceToken string, body []byte, pushType, priority string, renew bool) error {
+	providerToken, err := a.providerToken(renew)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	req, err := http.NewRequest("POST", a.url+"/3/device/"+deviceToken, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("authorization", "bearer "+providerToken)
+	req.Header.Set("apns-topic", a.topic)
+	req.Header.Set("apns-push-type", pushType)
+	req.Header.Set("apns-priority", priority)
+	req.Header.Set("apns-expiration", strconv.FormatInt(time.Now().Add(PushTTL).Unix(), 10))
+	req.Header.Set("content-type", "application/json")
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode == http.StatusOK {
+		return nil
+	}
+
+	apnsErr := &APNsError{StatusCode: resp.StatusCode}
+	var reason struct {
+		Reason string `json:"reason"`
+	}
+	if json.Unmarshal(data, &reason) == nil {
+		apnsErr.Reason = reason.Reason
+	}
+	if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds > 0 {
+		apnsErr.RetryAfter = time.Duration(seconds) * time.Second
+	}
+	return apnsErr
+}
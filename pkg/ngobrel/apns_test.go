@@ -0,0 +1,157 @@
+package ngobrel
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+const testAPNsToken = "740f4707bebcf74f9b7c25d48e3358945f6aa01da5ddb387462c7eaf61bb78ad"
+
+// A local APNs endpoint, answering each device token with the responses queued for it, then success
+func newFakeAPNs() *fakeProvider {
+	return newFakeProvider(func(r *http.Request, body []byte) string {
+		return strings.TrimPrefix(r.URL.Path, "/3/device/")
+	}, fakeResponse{header: map[string]string{"apns-id": "EC1BF194-B3B2-424A-89A9-5A918A6E6B5D"}})
+}
+
+// An error response of APNs
+func apnsError(statusCode int, reason string) fakeResponse {
+	return fakeResponse{statusCode, map[string]string{"Content-Type": "application/json"}, fmt.Sprintf(`{"reason": "%s"}`, reason)}
+}
+
+func newTestAPNsKey(t *testing.T) *ecdsa.PrivateKey {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// As a .p8 file, to go through ParseAPNsKey
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := ParseAPNsKey(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return parsed
+}
+
+func TestAPNsSend(t *testing.T) {
+	apns := newFakeAPNs()
+	defer apns.Close()
+
+	key := newTestAPNsKey(t)
+	push := NewAPNsPush(key, "KEY123", "TEAM123", "rocks.ngobrel.app", apns.URL)
+
+	err := push.SendNotification(testAPNsToken, &PushNotification{Title: "Horas", Body: "Hi", Data: map[string]string{"chatID": "1"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	requests := apns.requestsOf(testAPNsToken)
+	if len(requests) != 1 {
+		t.Fatalf("%d requests", len(requests))
+	}
+	r := requests[0]
+	if r.header.Get("apns-topic") != "rocks.ngobrel.app" || r.header.Get("apns-push-type") != "alert" || r.header.Get("apns-priority") != "10" {
+		t.Errorf("headers: %v", r.header)
+	}
+
+	authorization := r.header.Get("authorization")
+	if strings.HasPrefix(authorization, "bearer ") == false {
+		t.Fatalf("authorization: %s", authorization)
+	}
+	claims := verifyJWT(t, strings.TrimPrefix(authorization, "bearer "), &key.PublicKey)
+	if claims["iss"] != "TEAM123" {
+		t.Errorf("claims: %v", claims)
+	}
+
+	var payload map[string]interface{}
+	json.Unmarshal(r.body, &payload)
+	aps, _ := payload["aps"].(map[string]interface{})
+	alert, _ := aps["alert"].(map[string]interface{})
+	if alert["title"] != "Horas" || alert["body"] != "Hi" || payload["chatID"] != "1" {
+		t.Errorf("payload: %v", payload)
+	}
+
+	// A silent notification is a background push
+	err = push.SendNotification(testAPNsToken, &PushNotification{Data: map[string]string{"chatID": "1"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r = apns.requestsOf(testAPNsToken)[1]
+	if r.header.Get("apns-push-type") != "background" || r.header.Get("apns-priority") != "5" {
+		t.Errorf("headers: %v", r.header)
+	}
+}
+
+func TestAPNsExpiredProviderToken(t *testing.T) {
+	apns := newFakeAPNs()
+	defer apns.Close()
+	waits, restore := fakePushSleep()
+	defer restore()
+
+	push := NewAPNsPush(newTestAPNsKey(t), "KEY123", "TEAM123", "rocks.ngobrel.app", apns.URL)
+	apns.respond(testAPNsToken, apnsError(http.StatusForbidden, "ExpiredProviderToken"))
+
+	if err := push.SendNotification(testAPNsToken, &PushNotification{}); err != nil {
+		t.Fatal(err)
+	}
+	if len(apns.requestsOf(testAPNsToken)) != 2 || len(*waits) != 1 {
+		t.Errorf("%d requests, waits %v", len(apns.requestsOf(testAPNsToken)), *waits)
+	}
+}
+
+func TestAPNsErrors(t *testing.T) {
+	apns := newFakeAPNs()
+	defer apns.Close()
+	waits, restore := fakePushSleep()
+	defer restore()
+
+	push := NewAPNsPush(newTestAPNsKey(t), "KEY123", "TEAM123", "rocks.ngobrel.app", apns.URL)
+
+	unregistered := strings.Repeat("a", 64)
+	badToken := strings.Repeat("b", 64)
+	busy := strings.Repeat("c", 64)
+	apns.respond(unregistered, apnsError(http.StatusGone, "Unregistered"))
+	apns.respond(badToken, apnsError(http.StatusBadRequest, "BadDeviceToken"))
+	apns.respond(busy, apnsError(http.StatusTooManyRequests, "TooManyRequests"))
+
+	// Tokens APNs rejects are to be dropped by sendToPushTokens, and so are malformed ones
+	for token, drop := range map[string]bool{unregistered: true, badToken: true, "not-hex/../../x": true} {
+		err := push.SendNotification(token, &PushNotification{Title: "Horas", Body: "Hi"})
+		if pushErr, ok := err.(pushError); ok == false || pushErr.TokenInvalid() != drop {
+			t.Errorf("%s: got %v, want TokenInvalid %v", token, err, drop)
+		}
+	}
+	if len(apns.requestsOf("not-hex")) != 0 {
+		t.Errorf("a malformed token was sent")
+	}
+
+	if err := push.SendNotification(busy, &PushNotification{Title: "Horas", Body: "Hi"}); err != nil {
+		t.Errorf("busy: %v", err)
+	}
+	if len(apns.requestsOf(busy)) != 2 || len(*waits) != 1 {
+		t.Errorf("busy: %d requests, waits %v", len(apns.requestsOf(busy)), *waits)
+	}
+}
+
+func TestValidAPNsToken(t *testing.T) {
+	if validAPNsToken(testAPNsToken) == false {
+		t.Errorf("a device token was refused")
+	}
+	for _, token := range []string{"", "abc", testAPNsToken + "00", strings.Repeat("g", 64), testAPNsToken[:62] + "/x"} {
+		if validAPNsToken(token) {
+			t.Errorf("%q was accepted", token)
+		}
+	}
+}
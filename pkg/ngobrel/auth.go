@@ -0,0 +1,133 @@
+package ngobrel
+
+import (
+	"errors"
+	"log"
+
+	uuid "github.com/satori/go.uuid"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Methods which can be called without a token
+var publicMethods = map[string]bool{
+	"/Ngobrel/CreateProfile":      true,
+	"/Ngobrel/VerifyOTP":          true,
+	"/Ngobrel/RefreshToken":       true,
+	"/Ngobrel/RequestDeviceLink":  true,
+	"/Ngobrel/CompleteDeviceLink": true,
+	"/Ngobrel/Echo":               true,
+	"/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo": true,
+}
+
+// Methods which can be called with a restricted token, given by VerifyOTP when the account has a PIN
+var restrictedMethods = map[string]bool{
+	"/Ngobrel/VerifyPIN": true,
+}
+
+// The currently logged in user and device, resolved from the token once per call
+type Principal struct {
+	UserID   uuid.UUID
+	DeviceID uuid.UUID
+	// Set when the PIN has not been verified yet
+	Restricted bool
+	token      string
+}
+
+type principalKey struct{}
+
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*Principal)
+	return p, ok
+}
+
+func getPrincipal(srv *Server, ctx context.Context) (*Principal, error) {
+	token, err := getToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, deviceID, restricted, err := getSessionFromToken(srv, token)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Principal{Restricted: restricted, token: token}
+	if p.UserID, err = uuid.FromString(userID); err != nil {
+		return nil, err
+	}
+	if p.DeviceID, err = uuid.FromString(deviceID); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (srv *Server) authenticate(ctx context.Context, method string) (context.Context, error) {
+	if publicMethods[method] {
+		return ctx, nil
+	}
+
+	p, err := getPrincipal(srv, ctx)
+	if err != nil {
+		log.Println(method, err)
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	if p.Restricted {
+		if restrictedMethods[method] == false {
+			log.Println(method, "called with a restricted token")
+			return nil, status.Error(codes.PermissionDenied, "pin-verification-required")
+		}
+		return context.WithValue(ctx, principalKey{}, p), nil
+	}
+
+	srv.touchDevice(p)
+	return context.WithValue(ctx, principalKey{}, p), nil
+}
+
+// Resolves the token of every non-public unary call
+func (srv *Server) UnaryAuthInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, err := srv.authenticate(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+// Resolves the token of every non-public streaming call
+func (srv *Server) StreamAuthInterceptor(svc interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := srv.authenticate(stream.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+
+	return handler(svc, &authenticatedStream{ServerStream: stream, ctx: ctx})
+}
+
+func getDeviceID(srv *Server, ctx context.Context) (uuid.UUID, error) {
+	p, ok := PrincipalFromContext(ctx)
+	if ok == false {
+		return uuid.Nil, errors.New("invalid-session")
+	}
+	return p.DeviceID, nil
+}
+
+func getUserID(srv *Server, ctx context.Context) (uuid.UUID, error) {
+	p, ok := PrincipalFromContext(ctx)
+	if ok == false {
+		return uuid.Nil, errors.New("invalid-session")
+	}
+	return p.UserID, nil
+}
@@ -4,20 +4,16 @@ package ngobrel
 import (
 	"context"
 	"database/sql"
-	"encoding/binary"
-	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"log"
-	"strconv"
 	"strings"
 	"time"
 
 	"github.com/minio/minio-go"
 
-	"github.com/cespare/xxhash"
 	"github.com/go-redis/redis"
 
 	"os"
@@ -42,29 +38,27 @@ func (srv *Server) InitDB() {
 	log.Println(pong, err)
 }
 
-func getUserIDFromToken(srv *Server, token string) (string, error) {
-	val, err := srv.redisClient.Get("UID-" + token).Result()
-	if err != nil || (err == nil && val == "") {
+// Gets the user ID and device ID of an authentication token, and whether the token is restricted
+func getSessionFromToken(srv *Server, token string) (string, string, bool, error) {
+	vals, err := srv.redisClient.MGet("UID-"+token, "DEV-"+token, "SCOPE-"+token).Result()
+	if err != nil {
 		log.Println(err)
-
-		err = errors.New("invalid-session")
-		return "", err
+		return "", "", false, errors.New("invalid-session")
 	}
-	return val, nil
-}
 
-func getDeviceIDFromToken(srv *Server, token string) (string, error) {
-	val, err := srv.redisClient.Get("DEV-" + token).Result()
-	if err != nil || (err == nil && val == "") {
-		log.Println(err)
-		err = errors.New("invalid-session")
-		return "", err
+	userID, _ := vals[0].(string)
+	deviceID, _ := vals[1].(string)
+	scope, _ := vals[2].(string)
+	if userID == "" || deviceID == "" {
+		return "", "", false, errors.New("invalid-session")
 	}
-	return val, nil
+	return userID, deviceID, scope == "pin", nil
 }
 
-func (req *PutMessageRequest) putMessageToUserIDCheckGroup(srv *Server, senderID uuid.UUID, senderDeviceID uuid.UUID, recipientID uuid.UUID, now float64) error {
-	rows, err := srv.db.Query(`SELECT chat_id FROM group_list WHERE chat_id=$1`, recipientID.String())
+// Puts a message to a group or a user. Only members may put to a group, and muted members
+// only the messages built by the server, a client being able to set any message type
+func (req *PutMessageRequest) putMessageToUserIDCheckGroup(srv *Server, senderID uuid.UUID, senderDeviceID uuid.UUID, recipientID uuid.UUID, now float64, fromServer bool) error {
+	rows, err := srv.db.Query(`SELECT chat_id, dissolved_at IS NOT NULL FROM group_list WHERE chat_id=$1`, recipientID.String())
 	if err != nil {
 		fmt.Println("err: " + err.Error())
 		return err
@@ -76,13 +70,49 @@ func (req *PutMessageRequest) putMessageToUserIDCheckGroup(srv *Server, senderID
 
 	for rows.Next() {
 		var groupID uuid.UUID
-		if err := rows.Scan(&groupID); err != nil {
+		var dissolved bool
+		if err := rows.Scan(&groupID, &dissolved); err != nil {
+			log.Println(err)
+			return err
+		}
+
+		if dissolved {
+			tx.Rollback()
+			err := errors.New("group-dissolved")
+			log.Println(err)
+			return err
+		}
+
+		isMember, err := isGroupMember(srv, senderID.String(), groupID.String())
+		if err != nil {
+			tx.Rollback()
+			log.Println(err)
+			return err
+		}
+		if isMember == false {
+			tx.Rollback()
+			err := errors.New("not-a-member")
 			log.Println(err)
 			return err
 		}
 
+		if fromServer == false {
+			muted, err := isMutedInGroup(srv, senderID.String(), groupID.String())
+			if err != nil {
+				tx.Rollback()
+				log.Println(err)
+				return err
+			}
+			if muted {
+				tx.Rollback()
+				err := errors.New("muted-in-group")
+				log.Println(err)
+				return err
+			}
+		}
+
 		log.Println("It's a group.")
-		err = req.putMessageToGroupMember(srv, tx, senderID, senderDeviceID, groupID, now)
+		recipients, err := req.putMessageToGroupMember(srv, tx, senderID, senderDeviceID, groupID, now)
 
 		if err != nil {
 			log.Println(err)
@@ -92,12 +122,23 @@ func (req *PutMessageRequest) putMessageToUserIDCheckGroup(srv *Server, senderID
 
 		if err = tx.Commit(); err != nil {
 			log.Println(err)
+			return err
 		}
-		return err
+
+		// Notifications must not hold the transaction open
+		srv.dispatchPush(&pushJob{
+			senderID:        senderID,
+			chatID:          groupID,
+			groupRecipients: recipients,
+			excerpt:         req.MessageExcerpt,
+			isManagement:    req.MessageType == 1,
+			timestamp:       time.Now().UnixNano() / 1000,
+		})
+		return nil
 	}
 
 	// not found in group list, so it must be individual recipient
-	err = req.putMessageToUserID(srv, tx, false, senderID, senderDeviceID, recipientID, now)
+	notify, err := req.putMessageToUserID(srv, tx, senderID, senderDeviceID, recipientID, now)
 	if err != nil {
 		log.Println(err)
 		tx.Rollback()
@@ -106,49 +147,94 @@ func (req *PutMessageRequest) putMessageToUserIDCheckGroup(srv *Server, senderID
 
 	if err = tx.Commit(); err != nil {
 		log.Println(err)
+		return err
 	}
-	return err
+
+	if notify {
+		srv.dispatchPush(&pushJob{
+			senderID:     senderID,
+			chatID:       recipientID,
+			excerpt:      req.MessageExcerpt,
+			isManagement: req.MessageType == 1,
+			timestamp:    time.Now().UnixNano() / 1000,
+		})
+	}
+	return nil
 }
 
-func (req *PutMessageRequest) putMessageToGroupMember(srv *Server, tx *sql.Tx, senderID uuid.UUID, senderDeviceID uuid.UUID, chatID uuid.UUID, now float64) error {
-	rows, err := srv.db.Query(`SELECT user_id FROM chat_list WHERE chat_id=$1`, chatID.String())
+// A member of a group which has received a message
+type groupRecipient struct {
+	userID uuid.UUID
+	// The name of the sender in the member's contact list, if any
+	contactName string
+}
+
+// Puts a message to all active devices of all members of a group with a single statement,
+// returns the members to be notified once the transaction is committed
+func (req *PutMessageRequest) putMessageToGroupMember(srv *Server, tx *sql.Tx, senderID uuid.UUID, senderDeviceID uuid.UUID, chatID uuid.UUID, now float64) ([]groupRecipient, error) {
+	if req.MessageEncrypted {
+		// XXX TODO Encrypted version
+		return nil, nil
+	}
+
+	// 87654321
+	// ---*---- bit #4 is set when a member has blocked the sender
+	rows, err := tx.Query(`
+	WITH recipients AS (
+		SELECT c.user_id, d.device_id
+		FROM chat_list c, devices d
+		WHERE c.chat_id=$1
+		AND d.user_id=c.user_id
+		AND d.device_state=1
+		AND NOT EXISTS (SELECT 1 FROM chat_list x WHERE x.user_id=c.user_id AND x.chat_id=$2 AND (x.chat_type & 16) = 16)
+	), delivered AS (
+		INSERT INTO conversations (recipient_id, message_id, sender_id, sender_device_id, recipient_device_id, message_timestamp, message_contents, message_encrypted)
+		SELECT $1::uuid, $3::bigint, $2::uuid, $4::uuid, device_id, to_timestamp($5), $6::text, $7::boolean FROM recipients
+	)
+	SELECT DISTINCT r.user_id, COALESCE(ct.name, '')
+	FROM recipients r LEFT JOIN contacts ct ON ct.user_id=r.user_id AND ct.chat_id=$2`,
+		chatID.String(), senderID.String(), req.MessageID, senderDeviceID.String(),
+		now, req.MessageContents, req.MessageEncrypted)
 	if err != nil {
 		log.Println(err)
-		return err
+		return nil, err
 	}
 	defer rows.Close()
 
+	var recipients []groupRecipient
 	for rows.Next() {
-		var recipientID uuid.UUID
-		if err := rows.Scan(&recipientID); err != nil {
-			log.Println(err)
-			return err
-		}
-
-		err = req.putMessageToUserID(srv, tx, true, senderID, senderDeviceID, recipientID, now)
-		if err != nil {
+		var recipient groupRecipient
+		if err := rows.Scan(&recipient.userID, &recipient.contactName); err != nil {
 			log.Println(err)
-			return err
+			return nil, err
 		}
+		recipients = append(recipients, recipient)
+	}
+	if err := rows.Err(); err != nil {
+		log.Println(err)
+		return nil, err
+	}
 
-		_, err = tx.Exec(`
-		INSERT INTO chat_list (user_id, chat_id, created_at, updated_at, excerpt, chat_type) values ($3, $2, now(), now(), $1, 1) ON CONFLICT (user_id, chat_id) DO UPDATE SET excerpt=$1, updated_at=now()`,
-			req.MessageExcerpt, chatID.String(), recipientID.String())
-
+	if req.MessageType == 0 {
+		_, err = tx.Exec(`UPDATE chat_list SET excerpt=$1, updated_at=now() WHERE chat_id=$2`,
+			req.MessageExcerpt, chatID.String())
 		if err != nil {
 			log.Println(err)
-			return err
+			return nil, err
 		}
 	}
 
-	return nil
+	return recipients, nil
 }
 
-func (req *PutMessageRequest) putMessageToUserID(srv *Server, tx *sql.Tx, isGroup bool, senderID uuid.UUID, senderDeviceID uuid.UUID, recipientID uuid.UUID, now float64) error {
+// Puts a message to all active devices of a user, returns whether the user is to be notified
+// once the transaction is committed
+func (req *PutMessageRequest) putMessageToUserID(srv *Server, tx *sql.Tx, senderID uuid.UUID, senderDeviceID uuid.UUID, recipientID uuid.UUID, now float64) (bool, error) {
+	notify := false
 	rows, err := srv.db.Query(`SELECT chat_type FROM chat_list WHERE user_id=$2 AND chat_id=$1`, senderID.String(), recipientID.String())
 	if err != nil {
 		log.Println(err)
-		return err
+		return false, err
 	}
 	defer rows.Close()
 	blocked := false
@@ -156,7 +242,7 @@ func (req *PutMessageRequest) putMessageToUserID(srv *Server, tx *sql.Tx, isGrou
 		var chatType int
 		if err := rows.Scan(&chatType); err != nil {
 			log.Println(err)
-			return err
+			return false, err
 		}
 
 		log.Println("Chat type is ", chatType)
@@ -168,14 +254,14 @@ func (req *PutMessageRequest) putMessageToUserID(srv *Server, tx *sql.Tx, isGrou
 
 	if blocked {
 		log.Println("User is blocked")
-		return nil
+		return false, nil
 	}
 
 	if req.MessageEncrypted == false {
 		rows, err := srv.db.Query(`SELECT device_id FROM devices WHERE user_id=$1 AND device_state = 1`, recipientID.String())
 		if err != nil {
 			log.Println(err)
-			return err
+			return false, err
 		}
 		defer rows.Close()
 		found := false
@@ -183,17 +269,24 @@ func (req *PutMessageRequest) putMessageToUserID(srv *Server, tx *sql.Tx, isGrou
 			var deviceID uuid.UUID
 			if err := rows.Scan(&deviceID); err != nil {
 				log.Println(err)
-				return err
+				return false, err
 			}
 
 			err = req.putMessageToDeviceID(srv, tx, senderID, senderDeviceID, recipientID, deviceID, now)
 			if err != nil {
 				log.Println(err)
-				return err
+				return false, err
 			}
 			found = true
 		}
-		if found && isGroup == false && req.MessageType == 0 {
+		if found && recipientID != senderID {
+			err = req.putMessageToSenderDevices(srv, tx, senderID, senderDeviceID, now)
+			if err != nil {
+				log.Println(err)
+				return false, err
+			}
+		}
+		if found && req.MessageType == 0 {
 			time.Sleep(100 * time.Millisecond)
 			log.Println("Updating chat_list")
 			_, err = tx.Exec(`
@@ -201,23 +294,40 @@ func (req *PutMessageRequest) putMessageToUserID(srv *Server, tx *sql.Tx, isGrou
 				req.MessageExcerpt, recipientID.String(), senderID.String())
 			if err != nil {
 				log.Println(err)
-				return err
+				return false, err
 			}
 			_, err = tx.Exec(`
 			INSERT INTO chat_list  (user_id, chat_id, created_at, updated_at, excerpt) values ($3, $2, now(), now(), $1) ON CONFLICT (user_id, chat_id) DO UPDATE SET excerpt=$1, updated_at=now()`,
 				req.MessageExcerpt, senderID.String(), recipientID.String())
 			if err != nil {
 				log.Println(err)
-				return err
+				return false, err
 			}
 
 		}
 		if found == false {
 			log.Println("No devices found for recipient ", recipientID.String())
 		}
+		// A single notification reaches every active device of the recipient
+		notify = found
 	} else {
 		// XXX TODO Encrypted version
 	}
+	return notify, nil
+}
+
+// Puts a copy of a message to the other active devices of the sender, so they are in sync
+func (req *PutMessageRequest) putMessageToSenderDevices(srv *Server, tx *sql.Tx, senderID uuid.UUID, senderDeviceID uuid.UUID, now float64) error {
+	_, err := tx.Exec(`INSERT INTO conversations (recipient_id, message_id, sender_id, sender_device_id, recipient_device_id, message_timestamp, message_contents, message_encrypted)
+	SELECT $1::uuid, $2::bigint, $3::uuid, $4::uuid, device_id, to_timestamp($5), $6::text, $7::boolean
+	FROM devices WHERE user_id=$3 AND device_state=1 AND device_id <> $4`,
+		req.RecipientID, req.MessageID, senderID.String(), senderDeviceID.String(),
+		now, req.MessageContents, req.MessageEncrypted)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
 	return nil
 }
 
@@ -299,11 +409,6 @@ func (req *PutMessageRequest) putMessageToDeviceID(srv *Server, tx *sql.Tx, send
 		return err
 	}
 
-	log.Println("Sending FCM notification")
-	senderName, _ := getNameFromUserID(srv, senderID.String(), req.RecipientID)
-	log.Println("--->", senderName, req.MessageExcerpt)
-	ts := time.Now().UnixNano() / 1000
-	srv.sendFCM(senderID.String(), senderName, req.RecipientID, recipientID.String(), req.MessageExcerpt, ts, req.MessageType == 1)
 	/*
 		data, ok := srv.receiptStream.Load(recipientDeviceID.String())
 		if ok && data != nil {
@@ -455,7 +560,10 @@ func (req *ListConversationsRequest) ListConversations(srv *Server, userID uuid.
 		a.title as chat_name,
 		a.avatar_thumbnail as avatar_thumbnail,
 		b.updated_at,
-		'','',''
+		'','',
+		a.custom_data,
+		a.description,
+		a.topic
 		FROM group_list a, chat_list b WHERE a.chat_id = b.chat_id and b.user_id=$1
 	UNION ALL
 	SELECT 
@@ -468,7 +576,8 @@ func (req *ListConversationsRequest) ListConversations(srv *Server, userID uuid.
 		b.updated_at,
 		c.phone_number,
 		c.user_name,
-		c.custom_data
+		c.custom_data,
+		'',''
 		FROM contacts a, chat_list b, profile c WHERE a.chat_id = b.chat_id and a.user_id = b.user_id and c.user_id=b.chat_id and b.user_id=$1
 	ORDER BY updated_at DESC
 	`, userID.String())
@@ -488,6 +597,8 @@ func (req *ListConversationsRequest) ListConversations(srv *Server, userID uuid.
 		var phoneNumber sql.NullString
 		var userName sql.NullString
 		var customData sql.NullString
+		var description sql.NullString
+		var topic sql.NullString
 
 		//var notification int64
 		var updatedAt time.Time
@@ -497,7 +608,9 @@ func (req *ListConversationsRequest) ListConversations(srv *Server, userID uuid.
 			&updatedAt,
 			&phoneNumber,
 			&userName,
-			&customData); err != nil {
+			&customData,
+			&description,
+			&topic); err != nil {
 			return nil, err
 		}
 
@@ -513,6 +626,8 @@ func (req *ListConversationsRequest) ListConversations(srv *Server, userID uuid.
 			PhoneNumber:     phoneNumber.String,
 			UserName:        userName.String,
 			CustomData:      customData.String,
+			Description:     description.String,
+			Topic:           topic.String,
 		}
 		list = append(list, item)
 	}
@@ -559,46 +674,38 @@ func (req *CreateProfileRequest) CreateProfile(srv *Server) (*CreateProfileRespo
 	}
 
 	fmt.Println(userID + ":" + req.DeviceID + ":" + req.PhoneNumber)
-	_, err = tx.Exec(`UPDATE devices set updated_at = now(), device_state = 0 WHERE device_id != $1 AND user_id=$2`, req.DeviceID, userID)
-	if err != nil {
-		_ = tx.Rollback()
-		log.Println(err)
-		return nil, err
-	}
 
-	_, err = tx.Exec(`INSERT INTO devices (user_id, device_id, updated_at, created_at, device_state) values ($1, $2, now(), now(), 0)
-	ON CONFLICT (user_id, device_id) DO UPDATE SET device_state=0, user_id=$1, device_id=$2, updated_at=now() 
-	`, userID, req.DeviceID)
+	// Other devices of the user stay active, the device only becomes active
+	// (and the primary device) once the OTP is verified
+	_, err = tx.Exec(`INSERT INTO devices (user_id, device_id, updated_at, created_at, device_state, device_name, platform) values ($1, $2, now(), now(), 0, $3, $4)
+	ON CONFLICT (user_id, device_id) DO UPDATE SET updated_at=now(),
+	device_name=COALESCE(NULLIF($3, ''), devices.device_name), platform=COALESCE(NULLIF($4, ''), devices.platform)
+	`, userID, req.DeviceID, req.DeviceName, req.Platform)
 	if err != nil {
 		_ = tx.Rollback()
 		fmt.Println(err.Error())
 		return nil, err
 	}
 
-	now := strconv.FormatInt(time.Now().UnixNano(), 10)
-	nowSlice := now[len(now)-4 : len(now)]
-	otpCode := fmt.Sprintf("%s", nowSlice)
-	//	otpCode = "1234" // XXX
-	otpHash := xxhash.Sum64String(req.PhoneNumber+otpCode) &^ (1 << 63)
-
-	smsMessage := fmt.Sprintf(SmsMessage, otpCode)
-	srv.smsClient.SendMessage(SmsSender, req.PhoneNumber, smsMessage)
-
-	log.Println("HASH:" + otpCode)
-	_, err = tx.Exec(`INSERT INTO otp (otp_code, expired_at) values ($1, now() + interval '1 day')`, int64(otpHash))
+	otpCode, err := putOTP(srv, tx, req.PhoneNumber, req.DeviceID, &otpHint{
+		locale:  req.Locale,
+		brand:   req.Brand,
+		channel: req.Channel,
+	})
 	if err != nil {
 		_ = tx.Rollback()
-		fmt.Println(err.Error())
 		return nil, err
 	}
 
-	if DebugMode == false {
+	if srv.debugMode == false {
 		otpCode = ""
 	}
 
 	if err := tx.Commit(); err != nil {
 		log.Println(err)
+		return nil, err
 	}
+	srv.wakeSmsQueue()
 
 	return &CreateProfileResponse{
 		UserID:   userID,
@@ -846,58 +953,74 @@ func (req *VerifyOTPRequest) VerifyOTP(srv *Server) (*VerifyOTPResponse, error)
 		}
 	}
 
-	if userID == "" {
+	if deviceID == "" {
 		return nil, errors.New("verification-otp-no-device-found")
 	}
 
-	otpHash := xxhash.Sum64String(req.PhoneNumber+req.OTP) &^ (1 << 63)
-	rows, err := srv.db.Query(`DELETE FROM otp WHERE otp_code=$1 AND expired_at > now() RETURNING otp_code`, int64(otpHash))
+	verified, err := consumeOTP(srv, req.PhoneNumber, deviceID, req.OTP)
 	if err != nil {
-		log.Println(err)
 		return nil, err
 	}
-	defer rows.Close()
 
-	for rows.Next() {
-		var dbOTPHash uint64
+	if verified == false {
+		log.Println("Verification failed for", req.PhoneNumber)
+		return nil, errors.New("verification-otp-failed")
+	}
 
-		if err := rows.Scan(&dbOTPHash); err != nil {
-			log.Println(err)
+	log.Println("Verified")
+
+	hasPIN, err := hasPIN(srv, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The device is only activated once the PIN is verified as well
+	if hasPIN {
+		s, err := createRestrictedSession(srv, userID, deviceID)
+		if err != nil {
 			return nil, err
 		}
 
-		if dbOTPHash == otpHash {
-			log.Println("Verified")
-
-			_, err := srv.db.Exec(`UPDATE devices set updated_at = now(), device_state = 1 WHERE device_id=$1 AND user_id=$2`, deviceID, userID)
-			if err != nil {
-				log.Println(err)
-				return nil, err
-			}
+		return &VerifyOTPResponse{
+			Token:       s.token,
+			ExpiredAt:   s.expiredAt.UnixNano() / 1000000,
+			PinRequired: true,
+		}, nil
+	}
 
-			b := make([]byte, 8)
-			binary.LittleEndian.PutUint64(b, uint64(time.Now().UnixNano()))
-			code := hex.EncodeToString(b)
+	err = activateVerifiedDevice(srv, userID, deviceID)
+	if err != nil {
+		return nil, err
+	}
 
-			binary.LittleEndian.PutUint64(b, otpHash)
-			code += hex.EncodeToString(b)
+	s, err := createSession(srv, userID, deviceID)
+	if err != nil {
+		return nil, err
+	}
 
-			err = srv.redisClient.Set("DEV-"+code, deviceID, 0).Err()
-			if err != nil {
-				log.Println(err)
-			}
+	return &VerifyOTPResponse{
+		Token:        s.token,
+		RefreshToken: s.refreshToken,
+		ExpiredAt:    s.expiredAt.UnixNano() / 1000000,
+	}, nil
+}
 
-			err = srv.redisClient.Set("UID-"+code, userID, 0).Err()
-			if err != nil {
-				log.Println(err)
-			}
+// Activates a device which has been verified by OTP, and by PIN if the account has one
+func activateVerifiedDevice(srv *Server, userID, deviceID string) error {
+	_, err := srv.db.Exec(`UPDATE devices set updated_at = now(), device_state = 1 WHERE device_id=$1 AND user_id=$2`, deviceID, userID)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
 
-			return &VerifyOTPResponse{Token: code}, nil
-		}
+	// The device verified by OTP becomes the primary device, the previous one stays as a linked device
+	_, err = srv.db.Exec(`UPDATE devices set is_primary = (device_id=$1) WHERE user_id=$2`, deviceID, userID)
+	if err != nil {
+		log.Println(err)
+		return err
 	}
 
-	log.Println("Verification failed for", req.PhoneNumber)
-	return nil, errors.New("verification-otp-failed")
+	return nil
 }
 
 func (req *ListGroupParticipantsRequest) ListGroupParticipants(srv *Server, userID uuid.UUID) (*ListGroupParticipantsResponse, error) {
@@ -995,6 +1118,63 @@ func isGroupAdmin(srv *Server, userID, groupID string) (bool, error) {
 	return foundGroupID == groupID, nil
 }
 
+func isGroupMember(srv *Server, userID, groupID string) (bool, error) {
+	var foundGroupID string
+	foundRow, err := srv.db.Query(`SELECT chat_id FROM chat_list where user_id=$1 AND chat_id=$2`, userID, groupID)
+	if err != nil {
+		log.Println(err)
+		return false, err
+	}
+
+	defer foundRow.Close()
+	for foundRow.Next() {
+		if err := foundRow.Scan(&foundGroupID); err != nil {
+			log.Println(err)
+			return false, err
+		}
+	}
+
+	return foundGroupID == groupID, nil
+}
+
+func isGroupCreator(srv *Server, userID, groupID string) (bool, error) {
+	var foundGroupID string
+	foundRow, err := srv.db.Query(`SELECT chat_id FROM group_list where dissolved_at IS NULL AND creator_id=$1 AND chat_id=$2`, userID, groupID)
+	if err != nil {
+		log.Println(err)
+		return false, err
+	}
+
+	defer foundRow.Close()
+	for foundRow.Next() {
+		if err := foundRow.Scan(&foundGroupID); err != nil {
+			log.Println(err)
+			return false, err
+		}
+	}
+
+	return foundGroupID == groupID, nil
+}
+
+func isGroup(srv *Server, chatID string) (bool, error) {
+	var foundGroupID string
+	foundRow, err := srv.db.Query(`SELECT chat_id FROM group_list where chat_id=$1`, chatID)
+	if err != nil {
+		log.Println(err)
+		return false, err
+	}
+
+	defer foundRow.Close()
+	for foundRow.Next() {
+		if err := foundRow.Scan(&foundGroupID); err != nil {
+			log.Println(err)
+			return false, err
+		}
+	}
+
+	return foundGroupID == chatID, nil
+}
+
 func (req *RemoveAdminRoleRequest) RemoveAdminRole(srv *Server, userID uuid.UUID) (*RemoveAdminRoleResponse, error) {
 	log.Println("Remove admin role")
 
@@ -1112,7 +1292,7 @@ func (req *RenameGroupRequest) RenameGroup(srv *Server, userID uuid.UUID) (*Rena
 	return &RenameGroupResponse{Success: success}, nil
 }
 
-func (req *AddToGroupRequest) AddToGroup(srv *Server, userID uuid.UUID) (*AddToGroupResponse, error) {
+func (req *EditGroupRequest) EditGroup(srv *Server, userID uuid.UUID) (*EditGroupResponse, error) {
 	isGroupAdmin, err := isGroupAdmin(srv, userID.String(), req.GroupID)
 	if err != nil {
 		log.Println(err)
@@ -1123,82 +1303,695 @@ func (req *AddToGroupRequest) AddToGroup(srv *Server, userID uuid.UUID) (*AddToG
 		return nil, err
 	}
 
-	ctx := context.Background()
+	// An empty custom_data clears it
+	if req.CustomData != "" && json.Valid([]byte(req.CustomData)) == false {
+		return nil, errors.New("invalid-custom-data")
+	}
 
-	tx, err := srv.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	result, err := srv.db.Exec(`UPDATE group_list SET description=$1, topic=$2, custom_data=$3, updated_at=now() WHERE chat_id=$4`,
+		req.Description, req.Topic, req.CustomData, req.GroupID)
 	if err != nil {
 		log.Println(err)
+		return nil, err
 	}
 
-	for _, participant := range req.Participants {
-		_, execErr := tx.Exec(`INSERT INTO chat_list (user_id, chat_id, created_at, updated_at, chat_type) values ($1, $2, now(), now(), 1)`, participant.UserID, req.GroupID)
-		if execErr != nil {
-			_ = tx.Rollback()
-
-			log.Println(execErr)
-			return nil, errors.New("error-add-group-when-inserting-participant")
-		}
-	}
-
-	if err := tx.Commit(); err != nil {
+	count, err := result.RowsAffected()
+	if err != nil {
 		log.Println(err)
+		return nil, err
 	}
 
-	return &AddToGroupResponse{
-		GroupID: req.GroupID,
+	return &EditGroupResponse{
+		Success: count > 0,
 	}, nil
 }
 
-func uploadMedia(srv *Server, userID uuid.UUID, mediaID string, isEncrypted bool, fileName, contentType string, fileSize int) error {
-	_, err := srv.db.Exec(`INSERT INTO media 
-		(uploader, file_id, created_at, is_encrypted, file_name, content_type, file_size)
-		values
-		($1, $2, now(), $3, $4, $5, $6)
-		`, userID.String(), mediaID, isEncrypted, fileName, contentType, fileSize)
+func (req *GetGroupInfoRequest) GetGroupInfo(srv *Server, userID uuid.UUID) (*GetGroupInfoResponse, error) {
+	rows, err := srv.db.Query(`
+	SELECT a.chat_id, a.title, a.description, a.topic, a.custom_data, a.creator_id, a.avatar, a.avatar_thumbnail, a.created_at
+	FROM group_list a, chat_list b
+	WHERE a.chat_id = b.chat_id AND b.user_id=$1 AND a.chat_id=$2`, userID.String(), req.GroupID)
 	if err != nil {
 		log.Println(err)
-		return err
+		return nil, err
 	}
+	defer rows.Close()
 
-	return nil
-}
-
-func uploadProfilePicture(srv *Server, userID uuid.UUID, mediaID string, thumbnail []byte, fileSize int) error {
-	err := uploadMedia(srv, userID, mediaID, false, "avatar", "application/png", fileSize)
-	if err != nil {
-		log.Println(err)
-		return err
+	var groupID string
+	var title sql.NullString
+	var description sql.NullString
+	var topic sql.NullString
+	var customData sql.NullString
+	var creatorID string
+	var avatar sql.NullString
+	var avatarThumbnail []byte
+	var createdAt time.Time
+	for rows.Next() {
+		if err := rows.Scan(&groupID,
+			&title,
+			&description,
+			&topic,
+			&customData,
+			&creatorID,
+			&avatar,
+			&avatarThumbnail,
+			&createdAt); err != nil {
+			log.Println(err)
+			return nil, err
+		}
 	}
-	_, err = srv.db.Exec(`UPDATE profile set avatar=$1, updated_at=now(), avatar_thumbnail=$2 WHERE user_id=$3`, mediaID, thumbnail, userID.String())
 
-	if err != nil {
+	if groupID != req.GroupID {
+		err := errors.New("group-not-found")
 		log.Println(err)
-		return err
+		return nil, err
 	}
 
-	return nil
+	return &GetGroupInfoResponse{
+		GroupID:         groupID,
+		Name:            title.String,
+		Description:     description.String,
+		Topic:           topic.String,
+		CustomData:      customData.String,
+		CreatorID:       creatorID,
+		Avatar:          avatar.String,
+		AvatarThumbnail: avatarThumbnail,
+		CreatedAt:       createdAt.UnixNano() / 1000000,
+	}, nil
 }
 
-func updateGroupAvatar(srv *Server, userID uuid.UUID, groupID, mediaID string, thumbnail []byte) error {
-	_, err := srv.db.Exec(`UPDATE media set uploader=$1 WHERE uploader=$2 and file_id=$3`, groupID, userID.String(), mediaID)
+func (req *DisbandGroupRequest) DisbandGroup(srv *Server, userID uuid.UUID, senderDeviceID uuid.UUID, now float64) (*DisbandGroupResponse, error) {
+	// The creator and the admins own the group
+	isGroupCreator, err := isGroupCreator(srv, userID.String(), req.GroupID)
 	if err != nil {
 		log.Println(err)
-		return err
+		return nil, err
 	}
-	_, err = srv.db.Exec(`UPDATE group_list set avatar=$1, updated_at=now(), avatar_thumbnail=$2 WHERE chat_id=$3`, mediaID, thumbnail, groupID)
-
+	isGroupAdmin, err := isGroupAdmin(srv, userID.String(), req.GroupID)
 	if err != nil {
 		log.Println(err)
-		return err
+		return nil, err
+	}
+	if isGroupCreator == false && isGroupAdmin == false {
+		err := errors.New("not-an-admin")
+		return nil, err
 	}
 
-	return nil
+	groupID, err := uuid.FromString(req.GroupID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	// Tell the members before the memberships are gone
+	contents, _ := json.Marshal(&ManagementMessage{
+		MessageType: "management",
+		Text:        "group-disbanded",
+	})
+
+	err = putManagementMessage(srv, userID, senderDeviceID, groupID, contents, now)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	ctx := context.Background()
+
+	tx, err := srv.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	_, err = tx.Exec(`DELETE FROM chat_list WHERE chat_id=$1`, req.GroupID)
+	if err != nil {
+		_ = tx.Rollback()
+		log.Println(err)
+		return nil, err
+	}
+
+	_, err = tx.Exec(`UPDATE group_list SET dissolved_at=now(), updated_at=now() WHERE chat_id=$1`, req.GroupID)
+	if err != nil {
+		_ = tx.Rollback()
+		log.Println(err)
+		return nil, err
+	}
+
+	_, err = tx.Exec(`DELETE FROM media WHERE uploader=$1`, req.GroupID)
+	if err != nil {
+		_ = tx.Rollback()
+		log.Println(err)
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	// The group is already gone, a leftover bucket is not fatal
+	err = srv.RemoveGroupBucket(req.GroupID)
+	if err != nil {
+		log.Println(err)
+	}
+
+	return &DisbandGroupResponse{Success: true}, nil
+}
+
+func (req *UpdateGroupAvatarRequest) UpdateGroupAvatar(srv *Server, userID uuid.UUID, senderDeviceID uuid.UUID, now float64) (*UpdateGroupAvatarResponse, error) {
+	if req.Avatar == "" {
+		err := errors.New("invalid-avatar")
+		log.Println(err)
+		return nil, err
+	}
+
+	isGroupAdmin, err := isGroupAdmin(srv, userID.String(), req.GroupID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	if isGroupAdmin == false {
+		err := errors.New("not-an-admin")
+		return nil, err
+	}
+
+	groupID, err := uuid.FromString(req.GroupID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	oldAvatar, err := getGroupAvatar(srv, req.GroupID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	// The avatar is set again under the same name. Unless it has been uploaded again,
+	// the object has already been moved to the group and there is nothing to do.
+	if oldAvatar == req.Avatar {
+		_, err := srv.minioClient.StatObject(userID.String(), req.Avatar, minio.StatObjectOptions{})
+		if err != nil {
+			if minio.ToErrorResponse(err).Code != "NoSuchKey" {
+				log.Println(err)
+				return nil, err
+			}
+
+			_, err = srv.minioClient.StatObject(req.GroupID, req.Avatar, minio.StatObjectOptions{})
+			if err != nil {
+				log.Println(err)
+				return nil, err
+			}
+			return &UpdateGroupAvatarResponse{Success: true}, nil
+		}
+	}
+
+	err = srv.PrepareMediaForGroup(userID, req.GroupID, req.Avatar)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	if oldAvatar != "" && oldAvatar != req.Avatar {
+		// The new avatar is already in place, a leftover object is not fatal
+		err = srv.RemoveMediaFromGroup(req.GroupID, oldAvatar)
+		if err != nil {
+			log.Println(err)
+		}
+	}
+
+	contents, _ := json.Marshal(&ManagementMessage{
+		MessageType: "management",
+		Text:        "group-avatar-updated",
+	})
+
+	err = putManagementMessage(srv, userID, senderDeviceID, groupID, contents, now)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return &UpdateGroupAvatarResponse{Success: true}, nil
+}
+
+func isBannedFromGroup(srv *Server, userID, groupID string) (bool, error) {
+	var foundUserID string
+	foundRow, err := srv.db.Query(`SELECT user_id FROM group_bans WHERE user_id=$1 AND chat_id=$2`, userID, groupID)
+	if err != nil {
+		log.Println(err)
+		return false, err
+	}
+
+	defer foundRow.Close()
+	for foundRow.Next() {
+		if err := foundRow.Scan(&foundUserID); err != nil {
+			log.Println(err)
+			return false, err
+		}
+	}
+
+	return foundUserID == userID, nil
+}
+
+func isMutedInGroup(srv *Server, userID, groupID string) (bool, error) {
+	var foundUserID string
+	foundRow, err := srv.db.Query(`SELECT user_id FROM group_mutes WHERE user_id=$1 AND chat_id=$2 AND expired_at > now()`, userID, groupID)
+	if err != nil {
+		log.Println(err)
+		return false, err
+	}
+
+	defer foundRow.Close()
+	for foundRow.Next() {
+		if err := foundRow.Scan(&foundUserID); err != nil {
+			log.Println(err)
+			return false, err
+		}
+	}
+
+	return foundUserID == userID, nil
+}
+
+func (req *BanFromGroupRequest) BanFromGroup(srv *Server, userID uuid.UUID) (*BanFromGroupResponse, error) {
+	isGroupAdmin, err := isGroupAdmin(srv, userID.String(), req.GroupID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	if isGroupAdmin == false {
+		err := errors.New("not-an-admin")
+		return nil, err
+	}
+
+	ctx := context.Background()
+
+	tx, err := srv.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	_, err = tx.Exec(`INSERT INTO group_bans (chat_id, user_id, banned_by, created_at) values ($1, $2, $3, now()) ON CONFLICT (chat_id, user_id) DO NOTHING`,
+		req.GroupID, req.UserID, userID.String())
+	if err != nil {
+		_ = tx.Rollback()
+		log.Println(err)
+		return nil, err
+	}
+
+	_, err = tx.Exec(`DELETE FROM chat_list WHERE user_id=$1 AND chat_id=$2`, req.UserID, req.GroupID)
+	if err != nil {
+		_ = tx.Rollback()
+		log.Println(err)
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return &BanFromGroupResponse{Success: true}, nil
+}
+
+func (req *UnbanFromGroupRequest) UnbanFromGroup(srv *Server, userID uuid.UUID) (*UnbanFromGroupResponse, error) {
+	isGroupAdmin, err := isGroupAdmin(srv, userID.String(), req.GroupID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	if isGroupAdmin == false {
+		err := errors.New("not-an-admin")
+		return nil, err
+	}
+
+	result, err := srv.db.Exec(`DELETE FROM group_bans WHERE user_id=$1 AND chat_id=$2`, req.UserID, req.GroupID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return &UnbanFromGroupResponse{Success: count == 1}, nil
+}
+
+func (req *ListGroupBansRequest) ListGroupBans(srv *Server, userID uuid.UUID) (*ListGroupBansResponse, error) {
+	isGroupAdmin, err := isGroupAdmin(srv, userID.String(), req.GroupID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	if isGroupAdmin == false {
+		err := errors.New("not-an-admin")
+		return nil, err
+	}
+
+	rows, err := srv.db.Query(`SELECT user_id, banned_by, created_at FROM group_bans WHERE chat_id=$1 ORDER BY created_at`, req.GroupID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*GroupRestriction = []*GroupRestriction{}
+	for rows.Next() {
+		var bannedID string
+		var bannedBy string
+		var createdAt time.Time
+		if err := rows.Scan(&bannedID, &bannedBy, &createdAt); err != nil {
+			log.Println(err)
+			return nil, err
+		}
+
+		list = append(list, &GroupRestriction{
+			UserID:    bannedID,
+			ByUserID:  bannedBy,
+			CreatedAt: createdAt.UnixNano() / 1000000,
+		})
+	}
+
+	return &ListGroupBansResponse{List: list}, nil
+}
+
+func (req *MuteGroupMemberRequest) MuteGroupMember(srv *Server, userID uuid.UUID) (*MuteGroupMemberResponse, error) {
+	isGroupAdmin, err := isGroupAdmin(srv, userID.String(), req.GroupID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	if isGroupAdmin == false {
+		err := errors.New("not-an-admin")
+		return nil, err
+	}
+
+	if req.Duration <= 0 {
+		err := errors.New("mute-group-member-invalid-duration")
+		return nil, err
+	}
+
+	rows, err := srv.db.Query(`
+	INSERT INTO group_mutes (chat_id, user_id, muted_by, created_at, expired_at)
+	SELECT chat_id, user_id, $3::uuid, now(), now() + $4::float8 * interval '1 second' FROM chat_list WHERE chat_id=$1 AND user_id=$2
+	ON CONFLICT (chat_id, user_id) DO UPDATE SET muted_by=$3::uuid, created_at=now(), expired_at=now() + $4::float8 * interval '1 second'
+	RETURNING expired_at`,
+		req.GroupID, req.UserID, userID.String(), req.Duration)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var expiredAt time.Time
+	for rows.Next() {
+		if err := rows.Scan(&expiredAt); err != nil {
+			log.Println(err)
+			return nil, err
+		}
+	}
+
+	if expiredAt.IsZero() {
+		err := errors.New("not-a-member")
+		log.Println(err)
+		return nil, err
+	}
+
+	return &MuteGroupMemberResponse{
+		Success:   true,
+		ExpiredAt: expiredAt.UnixNano() / 1000000,
+	}, nil
+}
+
+func (req *UnmuteGroupMemberRequest) UnmuteGroupMember(srv *Server, userID uuid.UUID) (*UnmuteGroupMemberResponse, error) {
+	isGroupAdmin, err := isGroupAdmin(srv, userID.String(), req.GroupID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	if isGroupAdmin == false {
+		err := errors.New("not-an-admin")
+		return nil, err
+	}
+
+	result, err := srv.db.Exec(`DELETE FROM group_mutes WHERE user_id=$1 AND chat_id=$2 AND expired_at > now()`, req.UserID, req.GroupID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return &UnmuteGroupMemberResponse{Success: count == 1}, nil
+}
+
+func (req *ListGroupMutesRequest) ListGroupMutes(srv *Server, userID uuid.UUID) (*ListGroupMutesResponse, error) {
+	isGroupAdmin, err := isGroupAdmin(srv, userID.String(), req.GroupID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	if isGroupAdmin == false {
+		err := errors.New("not-an-admin")
+		return nil, err
+	}
+
+	rows, err := srv.db.Query(`SELECT user_id, muted_by, created_at, expired_at FROM group_mutes WHERE chat_id=$1 AND expired_at > now() ORDER BY expired_at`, req.GroupID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*GroupRestriction = []*GroupRestriction{}
+	for rows.Next() {
+		var mutedID string
+		var mutedBy string
+		var createdAt time.Time
+		var expiredAt time.Time
+		if err := rows.Scan(&mutedID, &mutedBy, &createdAt, &expiredAt); err != nil {
+			log.Println(err)
+			return nil, err
+		}
+
+		list = append(list, &GroupRestriction{
+			UserID:    mutedID,
+			ByUserID:  mutedBy,
+			CreatedAt: createdAt.UnixNano() / 1000000,
+			ExpiredAt: expiredAt.UnixNano() / 1000000,
+		})
+	}
+
+	return &ListGroupMutesResponse{List: list}, nil
+}
+
+func (req *DeleteConversationRequest) DeleteConversation(srv *Server, userID uuid.UUID) (*DeleteConversationResponse, error) {
+	isGroup, err := isGroup(srv, req.ChatID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	if isGroup {
+		exit := &ExitFromGroupRequest{GroupID: req.ChatID}
+		ret, err := exit.ExitFromGroup(srv, userID)
+		if err != nil {
+			log.Println(err)
+			return nil, err
+		}
+		return &DeleteConversationResponse{Success: ret.Success, Message: req.ChatID}, nil
+	}
+
+	ctx := context.Background()
+
+	tx, err := srv.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	// 87654321
+	// ---*---- bit #4 is set when a contact is blocked, such entry is kept
+	// so the block survives the deletion
+	result, err := tx.Exec(`DELETE FROM chat_list WHERE user_id=$1 AND chat_id=$2 AND (chat_type & 16) = 0`, userID.String(), req.ChatID)
+	if err != nil {
+		_ = tx.Rollback()
+		log.Println(err)
+		return nil, err
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		_ = tx.Rollback()
+		log.Println(err)
+		return nil, err
+	}
+
+	if count == 0 {
+		_, err = tx.Exec(`UPDATE chat_list SET excerpt='', updated_at=now() WHERE user_id=$1 AND chat_id=$2`, userID.String(), req.ChatID)
+		if err != nil {
+			_ = tx.Rollback()
+			log.Println(err)
+			return nil, err
+		}
+	}
+
+	// Pending messages from the peer are discarded as well
+	_, err = tx.Exec(`DELETE FROM conversations WHERE recipient_id=$1 AND sender_id=$2`, userID.String(), req.ChatID)
+	if err != nil {
+		_ = tx.Rollback()
+		log.Println(err)
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return &DeleteConversationResponse{Success: true, Message: req.ChatID}, nil
+}
+
+func (req *AddToGroupRequest) AddToGroup(srv *Server, userID uuid.UUID) (*AddToGroupResponse, error) {
+	isGroupAdmin, err := isGroupAdmin(srv, userID.String(), req.GroupID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	if isGroupAdmin == false {
+		err := errors.New("not-an-admin")
+		return nil, err
+	}
+
+	ctx := context.Background()
+
+	tx, err := srv.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		log.Println(err)
+	}
+
+	for _, participant := range req.Participants {
+		isBanned, err := isBannedFromGroup(srv, participant.UserID, req.GroupID)
+		if err != nil {
+			_ = tx.Rollback()
+
+			log.Println(err)
+			return nil, err
+		}
+		if isBanned {
+			_ = tx.Rollback()
+
+			err := errors.New("user-is-banned-from-group")
+			log.Println(err, participant.UserID)
+			return nil, err
+		}
+
+		_, execErr := tx.Exec(`INSERT INTO chat_list (user_id, chat_id, created_at, updated_at, chat_type) values ($1, $2, now(), now(), 1)`, participant.UserID, req.GroupID)
+		if execErr != nil {
+			_ = tx.Rollback()
+
+			log.Println(execErr)
+			return nil, errors.New("error-add-group-when-inserting-participant")
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Println(err)
+	}
+
+	return &AddToGroupResponse{
+		GroupID: req.GroupID,
+	}, nil
+}
+
+func uploadMedia(srv *Server, userID uuid.UUID, mediaID string, isEncrypted bool, fileName, contentType string, fileSize int) error {
+	_, err := srv.db.Exec(`INSERT INTO media 
+		(uploader, file_id, created_at, is_encrypted, file_name, content_type, file_size)
+		values
+		($1, $2, now(), $3, $4, $5, $6)
+		`, userID.String(), mediaID, isEncrypted, fileName, contentType, fileSize)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+func uploadProfilePicture(srv *Server, userID uuid.UUID, mediaID string, thumbnail []byte, fileSize int) error {
+	err := uploadMedia(srv, userID, mediaID, false, "avatar", "application/png", fileSize)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+	_, err = srv.db.Exec(`UPDATE profile set avatar=$1, updated_at=now(), avatar_thumbnail=$2 WHERE user_id=$3`, mediaID, thumbnail, userID.String())
+
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+func updateGroupAvatar(srv *Server, userID uuid.UUID, groupID, mediaID string, thumbnail []byte) error {
+	_, err := srv.db.Exec(`UPDATE media set uploader=$1 WHERE uploader=$2 and file_id=$3`, groupID, userID.String(), mediaID)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+	_, err = srv.db.Exec(`UPDATE group_list set avatar=$1, updated_at=now(), avatar_thumbnail=$2 WHERE chat_id=$3`, mediaID, thumbnail, groupID)
+
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+func getGroupAvatar(srv *Server, groupID string) (string, error) {
+	rows, err := srv.db.Query(`SELECT avatar FROM group_list WHERE chat_id=$1`, groupID)
+	if err != nil {
+		log.Println(err)
+		return "", err
+	}
+
+	defer rows.Close()
+	var avatar sql.NullString
+	for rows.Next() {
+		if err := rows.Scan(&avatar); err != nil {
+			log.Println(err)
+			return "", err
+		}
+	}
+
+	return avatar.String, nil
+}
+
+func removeGroupMedia(srv *Server, groupID, mediaID string) error {
+	_, err := srv.db.Exec(`DELETE FROM media WHERE uploader=$1 and file_id=$2`, groupID, mediaID)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	return nil
 }
 
 func (req *GetMediaRequest) getMediaStream(srv *Server, userID uuid.UUID, stream Ngobrel_GetMediaServer) error {
 
 	log.Println("Get media")
-	rows, err := srv.db.Query(`SELECT uploader, file_id, is_encrypted, file_name, content_type, file_size FROM media where file_id=$1`, req.MediaID)
+	// Data exports can only be fetched by their owner
+	rows, err := srv.db.Query(`SELECT uploader, file_id, is_encrypted, file_name, content_type, file_size FROM media m where file_id=$1
+	AND NOT EXISTS (SELECT 1 FROM data_exports e WHERE e.media_id=m.file_id AND e.user_id<>$2)`, req.MediaID, userID.String())
 	if err != nil {
 		log.Println(err)
 		return err
@@ -1322,16 +2115,36 @@ func (req *PutMessageStateRequest) PutMessageState(srv *Server, userID uuid.UUID
 	contents, _ := json.Marshal(&ManagementMessage{
 		MessageType: "management",
 		Text:        "reception-receipt",
-		Command: ManagementReceptionStateMessage{
+		Command: &ManagementReceptionStateMessage{
 			Type:      req.Status,
 			MessageID: req.MessageID,
 		},
 	})
 
+	chatID, err := uuid.FromString(req.ChatID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	err = putManagementMessage(srv, userID, senderDeviceID, chatID, contents, now)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return &PutMessageStateResponse{
+		Success: true,
+	}, nil
+}
+
+// Sends a management message to a chat, retrying when the message ID collides
+// or the transaction can not be serialized
+func putManagementMessage(srv *Server, userID uuid.UUID, senderDeviceID uuid.UUID, chatID uuid.UUID, contents []byte, now float64) error {
 	for true {
 		outgoingMessageID := (time.Now().UnixNano() / 1000000) - 946659600000 // 2000-01-01T00:00:00
-		receipt := &PutMessageRequest{
-			RecipientID:      req.ChatID,
+		msg := &PutMessageRequest{
+			RecipientID:      chatID.String(),
 			MessageID:        outgoingMessageID,
 			MessageExcerpt:   "",
 			MessageEncrypted: false,
@@ -1339,13 +2152,7 @@ func (req *PutMessageStateRequest) PutMessageState(srv *Server, userID uuid.UUID
 			MessageType:      1, // management
 		}
 
-		chatID, err := uuid.FromString(req.ChatID)
-		if err != nil {
-			log.Println(err)
-			return nil, err
-		}
-
-		err = receipt.putMessageToUserIDCheckGroup(srv, userID, senderDeviceID, chatID, now)
+		err := msg.putMessageToUserIDCheckGroup(srv, userID, senderDeviceID, chatID, now, true)
 		if err != nil {
 			if strings.Contains(err.Error(), "duplicate key value violates unique constraint \"conversations_pkey\"") {
 				log.Println(err, " Try again")
@@ -1358,14 +2165,12 @@ func (req *PutMessageStateRequest) PutMessageState(srv *Server, userID uuid.UUID
 				continue
 			}
 			log.Println(err)
-			return nil, err
+			return err
 		}
 		break
 	}
 
-	return &PutMessageStateResponse{
-		Success: true,
-	}, nil
+	return nil
 }
 
 func (req *BlockContactRequest) BlockContact(srv *Server, userID uuid.UUID) (*BlockContactResponse, error) {
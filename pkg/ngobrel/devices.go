@@ -0,0 +1,294 @@
+package ngobrel
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/lib/pq"
+	uuid "github.com/satori/go.uuid"
+	"golang.org/x/net/context"
+)
+
+// Pending device links are kept in redis:
+//
+//   LINK-<code>  a hash of the device ID, name and platform, the hashed link secret and,
+//                once approved, the user ID
+//
+// A link code expires after DeviceLinkTTL.
+
+const linkCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+const linkCodeLength = 8
+
+func newLinkCode() (string, error) {
+	b := make([]byte, linkCodeLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	for i := range b {
+		b[i] = linkCodeAlphabet[int(b[i])%len(linkCodeAlphabet)]
+	}
+	return string(b), nil
+}
+
+func hashLinkSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+func (req *RequestDeviceLinkRequest) RequestDeviceLink(srv *Server, ctx context.Context) (*RequestDeviceLinkResponse, error) {
+	if _, err := uuid.FromString(req.DeviceID); err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	if ip := peerIP(ctx); ip != "" {
+		retryAfter, err := srv.hit("RL-LINK-IP-"+ip, DeviceLinksPerIPPerHour, time.Hour)
+		if err != nil {
+			return nil, err
+		}
+		if retryAfter > 0 {
+			return nil, rateLimited(ctx, "device-link-too-many-requests", retryAfter)
+		}
+	}
+
+	code, err := newLinkCode()
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	secret, err := newRandomToken()
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	created, err := srv.redisClient.HSetNX("LINK-"+code, "device", req.DeviceID).Result()
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	if created == false {
+		err := errors.New("device-link-code-collision")
+		log.Println(err)
+		return nil, err
+	}
+
+	err = srv.redisClient.HMSet("LINK-"+code, map[string]interface{}{
+		"secret":   hashLinkSecret(secret),
+		"name":     req.DeviceName,
+		"platform": req.Platform,
+	}).Err()
+	if err == nil {
+		err = srv.redisClient.Expire("LINK-"+code, DeviceLinkTTL).Err()
+	}
+	if err != nil {
+		srv.redisClient.Del("LINK-" + code)
+		log.Println(err)
+		return nil, err
+	}
+
+	return &RequestDeviceLinkResponse{
+		LinkCode:   code,
+		LinkSecret: secret,
+		QrPayload:  "ngobrel-link:" + code,
+		ExpiredAt:  time.Now().Add(DeviceLinkTTL).UnixNano() / 1000000,
+	}, nil
+}
+
+func (req *ApproveDeviceLinkRequest) ApproveDeviceLink(srv *Server, userID uuid.UUID) (*ApproveDeviceLinkResponse, error) {
+	data, err := srv.redisClient.HGetAll("LINK-" + req.LinkCode).Result()
+	deviceID := data["device"]
+	if err != nil || deviceID == "" {
+		log.Println(err)
+		return nil, errors.New("invalid-link-code")
+	}
+
+	approved, err := srv.redisClient.HSetNX("LINK-"+req.LinkCode, "user", userID.String()).Result()
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	if approved == false {
+		return nil, errors.New("device-link-already-approved")
+	}
+
+	_, err = srv.db.Exec(`INSERT INTO devices (user_id, device_id, updated_at, created_at, device_state, is_primary, device_name, platform) values ($1, $2, now(), now(), 1, false, $3, $4)
+	ON CONFLICT (user_id, device_id) DO UPDATE SET device_state=1, updated_at=now(), device_name=$3, platform=$4`,
+		userID.String(), deviceID, data["name"], data["platform"])
+	if err != nil {
+		srv.redisClient.HDel("LINK-"+req.LinkCode, "user")
+		log.Println(err)
+		return nil, err
+	}
+
+	log.Println("Device", deviceID, "linked to", userID.String())
+	return &ApproveDeviceLinkResponse{DeviceID: deviceID}, nil
+}
+
+func (req *CompleteDeviceLinkRequest) CompleteDeviceLink(srv *Server) (*CompleteDeviceLinkResponse, error) {
+	data, err := srv.redisClient.HGetAll("LINK-" + req.LinkCode).Result()
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	secret := hashLinkSecret(req.LinkSecret)
+	if data["device"] == "" || subtle.ConstantTimeCompare([]byte(secret), []byte(data["secret"])) != 1 {
+		return nil, errors.New("invalid-link-code")
+	}
+
+	userID := data["user"]
+	if userID == "" {
+		return nil, errors.New("device-link-pending")
+	}
+
+	deleted, err := srv.redisClient.Del("LINK-" + req.LinkCode).Result()
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	if deleted != 1 {
+		return nil, errors.New("invalid-link-code")
+	}
+
+	s, err := createSession(srv, userID, data["device"])
+	if err != nil {
+		return nil, err
+	}
+
+	return &CompleteDeviceLinkResponse{
+		UserID:       userID,
+		Token:        s.token,
+		RefreshToken: s.refreshToken,
+		ExpiredAt:    s.expiredAt.UnixNano() / 1000000,
+	}, nil
+}
+
+// Records when a device was last active, at most once per DeviceActivityInterval
+func (srv *Server) touchDevice(p *Principal) {
+	fresh, err := srv.redisClient.SetNX("SEEN-"+p.DeviceID.String(), "1", DeviceActivityInterval).Result()
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	if fresh == false {
+		return
+	}
+
+	go func() {
+		_, err := srv.db.Exec(`UPDATE devices SET last_active_at=now() WHERE user_id=$1 AND device_id=$2`, p.UserID.String(), p.DeviceID.String())
+		if err != nil {
+			log.Println(err)
+		}
+	}()
+}
+
+func (req *ListDevicesRequest) ListDevices(srv *Server, userID uuid.UUID, currentDeviceID uuid.UUID) (*ListDevicesResponse, error) {
+	rows, err := srv.db.Query(`SELECT device_id, device_name, platform, is_primary, created_at, last_active_at
+	FROM devices WHERE user_id=$1 AND device_state=1 ORDER BY created_at`, userID.String())
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*Device = []*Device{}
+	for rows.Next() {
+		var deviceID uuid.UUID
+		var name sql.NullString
+		var platform sql.NullString
+		var isPrimary bool
+		var createdAt time.Time
+		var lastActiveAt pq.NullTime
+		if err := rows.Scan(&deviceID, &name, &platform, &isPrimary, &createdAt, &lastActiveAt); err != nil {
+			log.Println(err)
+			return nil, err
+		}
+
+		item := &Device{
+			DeviceID:  deviceID.String(),
+			Name:      name.String,
+			Platform:  platform.String,
+			IsPrimary: isPrimary,
+			IsCurrent: uuid.Equal(deviceID, currentDeviceID),
+			CreatedAt: createdAt.UnixNano() / 1000000,
+		}
+		if lastActiveAt.Valid {
+			item.LastActiveAt = lastActiveAt.Time.UnixNano() / 1000000
+		}
+		list = append(list, item)
+	}
+
+	return &ListDevicesResponse{List: list}, nil
+}
+
+func (req *RenameDeviceRequest) RenameDevice(srv *Server, userID uuid.UUID) (*RenameDeviceResponse, error) {
+	result, err := srv.db.Exec(`UPDATE devices SET device_name=$1, updated_at=now() WHERE user_id=$2 AND device_id=$3 AND device_state=1`,
+		req.Name, userID.String(), req.DeviceID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return &RenameDeviceResponse{Success: count == 1}, nil
+}
+
+func (req *RemoveDeviceRequest) RemoveDevice(srv *Server, userID uuid.UUID) (*RemoveDeviceResponse, error) {
+	result, err := srv.db.Exec(`UPDATE devices SET device_state=0, is_primary=false, updated_at=now() WHERE user_id=$1 AND device_id=$2 AND device_state=1`,
+		userID.String(), req.DeviceID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	if count != 1 {
+		return nil, errors.New("device-not-found")
+	}
+
+	err = deactivateDevice(srv, userID.String(), req.DeviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RemoveDeviceResponse{Success: true}, nil
+}
+
+// Cleans up everything a device which is no longer active leaves behind:
+// its tokens, its push token and the messages waiting for it
+func deactivateDevice(srv *Server, userID, deviceID string) error {
+	err := revokeSessions(srv, userID, deviceID)
+	if err != nil {
+		return err
+	}
+
+	err = removeDevicePushTokens(srv, deviceID)
+	if err != nil {
+		return err
+	}
+
+	_, err = srv.db.Exec(`DELETE FROM conversations WHERE recipient_device_id=$1`, deviceID)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	return nil
+}
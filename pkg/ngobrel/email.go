@@ -0,0 +1,93 @@
+package ngobrel
+
+import (
+	"bytes"
+	"errors"
+	"log"
+	"mime"
+	"net"
+	"net/smtp"
+	"strconv"
+	"time"
+)
+
+// SmtpEmail sends messages as plain text emails. It is set up with SetValue:
+//
+//	addr     the host:port of the SMTP server, required
+//	from     the sender address, required
+//	subject  the subject, "Verification code" by default
+//
+// The account, if set, is used for PLAIN authentication, which needs STARTTLS unless the
+// server is on localhost.
+type SmtpEmail struct {
+	user     string
+	password string
+	addr     string
+	from     string
+	subject  string
+}
+
+func NewSmtpEmail() *SmtpEmail {
+	return &SmtpEmail{
+		subject: "Verification code",
+	}
+}
+
+func (t *SmtpEmail) SetAccount(userID string, tokenID string) error {
+	t.user = userID
+	t.password = tokenID
+	return nil
+}
+
+func (t *SmtpEmail) SetValue(key string, value string) error {
+	switch key {
+	case "addr":
+		t.addr = value
+	case "from":
+		t.from = value
+	case "subject":
+		t.subject = value
+	}
+	return nil
+}
+
+// The sender of the OTP message is a phone number, so the configured address is used instead
+func (t *SmtpEmail) SendMessage(from string, to string, message string) (string, error) {
+	if t.addr == "" || t.from == "" {
+		err := errors.New("smtp-account-not-yet-setup")
+		log.Println(err)
+		return "", err
+	}
+
+	host, _, err := net.SplitHostPort(t.addr)
+	if err != nil {
+		log.Println(err)
+		return "", err
+	}
+
+	var auth smtp.Auth
+	if t.user != "" {
+		auth = smtp.PlainAuth("", t.user, t.password, host)
+	}
+
+	messageID := "<" + strconv.FormatInt(time.Now().UnixNano(), 36) + "." + getRandomID() + "@" + host + ">"
+
+	var b bytes.Buffer
+	b.WriteString("From: " + t.from + "\r\n")
+	b.WriteString("To: " + to + "\r\n")
+	b.WriteString("Subject: " + mime.QEncoding.Encode("utf-8", t.subject) + "\r\n")
+	b.WriteString("Date: " + time.Now().Format(time.RFC1123Z) + "\r\n")
+	b.WriteString("Message-ID: " + messageID + "\r\n")
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(message + "\r\n")
+
+	err = smtp.SendMail(t.addr, auth, t.from, []string{to}, b.Bytes())
+	if err != nil {
+		log.Println("Error sending email", err)
+		return "", err
+	}
+
+	return messageID, nil
+}
@@ -0,0 +1,266 @@
+package ngobrel
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"errors"
+	"log"
+	"os"
+	"time"
+
+	"github.com/minio/minio-go"
+	uuid "github.com/satori/go.uuid"
+)
+
+// A data export is a ZIP archive of JSON files with everything the server keeps about a user.
+// It is put together in the background, written to the user's bucket and recorded in media,
+// so it can be downloaded with GetMedia. The state of an export is kept in data_exports.
+
+type dataExportFile struct {
+	name  string
+	query string
+}
+
+// Messages are only kept by the server until they are delivered, so only the pending ones are exported.
+// A group message has the group as its recipient, the ones received are found by the devices of the user.
+var dataExportFiles = []dataExportFile{
+	{"profile.json", `SELECT user_id, name, phone_number, email, user_name, custom_data, avatar, created_at, updated_at,
+	pin_hash IS NOT NULL as pin_set FROM profile WHERE user_id=$1`},
+	{"devices.json", `SELECT device_id, device_name, platform, device_state, is_primary, created_at, updated_at, last_active_at
+	FROM devices WHERE user_id=$1 ORDER BY created_at`},
+	{"contacts.json", `SELECT c.chat_id, c.name, p.phone_number, c.notification, c.created_at, c.updated_at
+	FROM contacts c LEFT JOIN profile p ON p.user_id=c.chat_id WHERE c.user_id=$1 ORDER BY c.name`},
+	{"chat_list.json", `SELECT chat_id, chat_type, excerpt, created_at, updated_at FROM chat_list WHERE user_id=$1 AND chat_type=0 ORDER BY updated_at`},
+	{"groups.json", `SELECT g.chat_id, g.title, g.description, g.topic, c.is_admin, g.creator_id=$1 as is_creator, c.created_at as joined_at
+	FROM chat_list c, group_list g WHERE c.user_id=$1 AND c.chat_id=g.chat_id ORDER BY c.created_at`},
+	{"media.json", `SELECT file_id, file_name, content_type, file_size, is_encrypted, created_at FROM media WHERE uploader=$1 ORDER BY created_at`},
+	{"messages.json", `SELECT DISTINCT ON (message_id, sender_id) message_id, sender_id, recipient_id, message_timestamp, message_encrypted, message_contents
+	FROM conversations WHERE sender_id=$1 OR recipient_id=$1
+	OR recipient_device_id IN (SELECT device_id FROM devices WHERE user_id=$1) ORDER BY message_id, sender_id`},
+}
+
+func (req *RequestDataExportRequest) RequestDataExport(srv *Server, userID uuid.UUID) (*RequestDataExportResponse, error) {
+	rows, err := srv.db.Query(`SELECT export_id FROM data_exports WHERE user_id=$1 AND
+	(status='pending' AND requested_at > now() - $2::float8 * interval '1 second' OR requested_at > now() - $3::float8 * interval '1 second')`,
+		userID.String(), DataExportTimeout.Seconds(), DataExportInterval.Seconds())
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	recent := rows.Next()
+	rows.Close()
+	if recent {
+		return nil, errors.New("data-export-too-many-requests")
+	}
+
+	exportID := uuid.Must(uuid.NewV4(), nil)
+	_, err = srv.db.Exec(`INSERT INTO data_exports (export_id, user_id, status, requested_at) values ($1, $2, 'pending', now())`,
+		exportID.String(), userID.String())
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	go srv.buildDataExport(userID, exportID)
+
+	return &RequestDataExportResponse{ExportID: exportID.String()}, nil
+}
+
+func (req *GetDataExportStatusRequest) GetDataExportStatus(srv *Server, userID uuid.UUID) (*GetDataExportStatusResponse, error) {
+	rows, err := srv.db.Query(`SELECT status, COALESCE(media_id, ''), requested_at FROM data_exports WHERE export_id=$1 AND user_id=$2`,
+		req.ExportID, userID.String())
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	defer rows.Close()
+	var status, mediaID string
+	var requestedAt time.Time
+	for rows.Next() {
+		if err := rows.Scan(&status, &mediaID, &requestedAt); err != nil {
+			log.Println(err)
+			return nil, err
+		}
+	}
+
+	if status == "" {
+		return nil, errors.New("data-export-not-found")
+	}
+
+	// The server may have been restarted while putting the export together
+	if status == "pending" && time.Since(requestedAt) > DataExportTimeout {
+		status = "failed"
+	}
+
+	return &GetDataExportStatusResponse{
+		Status:  status,
+		MediaID: mediaID,
+	}, nil
+}
+
+func (srv *Server) buildDataExport(userID uuid.UUID, exportID uuid.UUID) {
+	mediaID, err := srv.writeDataExport(userID)
+	if err != nil {
+		log.Println("Data export", exportID.String(), "failed", err)
+		_, err = srv.db.Exec(`UPDATE data_exports SET status='failed', completed_at=now() WHERE export_id=$1`, exportID.String())
+		if err != nil {
+			log.Println(err)
+		}
+		return
+	}
+
+	// Only the latest export is kept
+	rows, err := srv.db.Query(`SELECT media_id FROM data_exports WHERE user_id=$1 AND media_id IS NOT NULL`, userID.String())
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	var oldMediaIDs []string
+	for rows.Next() {
+		var oldMediaID string
+		if err := rows.Scan(&oldMediaID); err != nil {
+			log.Println(err)
+			break
+		}
+		oldMediaIDs = append(oldMediaIDs, oldMediaID)
+	}
+	rows.Close()
+
+	_, err = srv.db.Exec(`UPDATE data_exports SET status='ready', media_id=$1, completed_at=now() WHERE export_id=$2`, mediaID, exportID.String())
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	for _, oldMediaID := range oldMediaIDs {
+		if err := srv.minioClient.RemoveObject(userID.String(), oldMediaID); err != nil {
+			log.Println(err)
+		}
+		if _, err := srv.db.Exec(`DELETE FROM media WHERE uploader=$1 AND file_id=$2`, userID.String(), oldMediaID); err != nil {
+			log.Println(err)
+		}
+		if _, err := srv.db.Exec(`UPDATE data_exports SET status='expired', media_id=NULL WHERE media_id=$1`, oldMediaID); err != nil {
+			log.Println(err)
+		}
+	}
+
+	log.Println("Data export", exportID.String(), "is ready")
+}
+
+// Writes the ZIP archive to the user's bucket and returns its media ID
+func (srv *Server) writeDataExport(userID uuid.UUID) (string, error) {
+	mediaID := getRandomID()
+	tmpFileName := srv.tmpDir + "/export." + userID.String() + "-" + mediaID
+
+	f, err := os.Create(tmpFileName)
+	if err != nil {
+		log.Println(err)
+		return "", err
+	}
+	defer os.Remove(tmpFileName)
+	defer f.Close()
+
+	archive := zip.NewWriter(f)
+	for _, file := range dataExportFiles {
+		records, err := queryRecords(srv, file.query, userID.String())
+		if err != nil {
+			return "", err
+		}
+
+		w, err := archive.Create(file.name)
+		if err != nil {
+			log.Println(err)
+			return "", err
+		}
+
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(records); err != nil {
+			log.Println(err)
+			return "", err
+		}
+	}
+
+	if err := archive.Close(); err != nil {
+		log.Println(err)
+		return "", err
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		log.Println(err)
+		return "", err
+	}
+
+	exists, err := srv.minioClient.BucketExists(userID.String())
+	if err != nil {
+		log.Println(err)
+		return "", err
+	}
+
+	if exists == false {
+		err = srv.minioClient.MakeBucket(userID.String(), "us-east-1")
+		if err != nil {
+			log.Println(err)
+			return "", err
+		}
+	}
+
+	_, err = srv.minioClient.FPutObject(userID.String(), mediaID, tmpFileName, minio.PutObjectOptions{ContentType: "application/zip"})
+	if err != nil {
+		log.Println(err)
+		return "", err
+	}
+
+	fileName := "ngobrel-export-" + time.Now().Format("20060102") + ".zip"
+	err = uploadMedia(srv, userID, mediaID, false, fileName, "application/zip", int(info.Size()))
+	if err != nil {
+		return "", err
+	}
+
+	return mediaID, nil
+}
+
+// Runs a query and returns every row as a map of column names to values
+func queryRecords(srv *Server, query string, args ...interface{}) ([]map[string]interface{}, error) {
+	rows, err := srv.db.Query(query, args...)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	records := []map[string]interface{}{}
+	for rows.Next() {
+		values := make([]interface{}, len(columns))
+		pointers := make([]interface{}, len(columns))
+		for i := range values {
+			pointers[i] = &values[i]
+		}
+
+		if err := rows.Scan(pointers...); err != nil {
+			log.Println(err)
+			return nil, err
+		}
+
+		record := make(map[string]interface{}, len(columns))
+		for i, column := range columns {
+			// Text and UUID columns are scanned as bytes
+			if b, ok := values[i].([]byte); ok {
+				record[column] = string(b)
+			} else {
+				record[column] = values[i]
+			}
+		}
+		records = append(records, record)
+	}
+
+	return records, rows.Err()
+}
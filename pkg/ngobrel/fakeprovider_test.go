@@ -0,0 +1,95 @@
+package ngobrel
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+)
+
+// A local stand-in for the HTTP API of a provider. Requests are told apart by a key, e.g. the
+// token they are for, and answered with the responses queued for their key, then with the
+// default response.
+type fakeProvider struct {
+	*httptest.Server
+	mutex     sync.Mutex
+	keyOf     func(r *http.Request, body []byte) string
+	fallback  fakeResponse
+	responses map[string][]fakeResponse
+	requests  map[string][]fakeRequest
+}
+
+type fakeResponse struct {
+	statusCode int
+	header     map[string]string
+	body       string
+}
+
+type fakeRequest struct {
+	header http.Header
+	body   []byte
+}
+
+func newFakeProvider(keyOf func(r *http.Request, body []byte) string, fallback fakeResponse) *fakeProvider {
+	f := &fakeProvider{
+		keyOf:     keyOf,
+		fallback:  fallback,
+		responses: make(map[string][]fakeResponse),
+		requests:  make(map[string][]fakeRequest),
+	}
+	f.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		key := f.keyOf(r, body)
+
+		f.mutex.Lock()
+		f.requests[key] = append(f.requests[key], fakeRequest{header: r.Header, body: body})
+		resp := f.fallback
+		if queued := f.responses[key]; len(queued) > 0 {
+			resp = queued[0]
+			f.responses[key] = queued[1:]
+		}
+		f.mutex.Unlock()
+
+		for name, value := range resp.header {
+			w.Header().Set(name, value)
+		}
+		if resp.statusCode != 0 {
+			w.WriteHeader(resp.statusCode)
+		}
+		w.Write([]byte(resp.body))
+	}))
+	return f
+}
+
+// Queues the responses to the next requests of a key
+func (f *fakeProvider) respond(key string, responses ...fakeResponse) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.responses[key] = responses
+}
+
+// Changes the response given once nothing is queued
+func (f *fakeProvider) setFallback(resp fakeResponse) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.fallback = resp
+}
+
+func (f *fakeProvider) requestsOf(key string) []fakeRequest {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return f.requests[key]
+}
+
+// Records the waits of sendWithRetry instead of sleeping, until restore is called
+func fakePushSleep() (*[]time.Duration, func()) {
+	var waits []time.Duration
+	sleep := pushSleep
+	pushSleep = func(d time.Duration) {
+		waits = append(waits, d)
+	}
+	return &waits, func() {
+		pushSleep = sleep
+	}
+}
@@ -1,23 +1,33 @@
 package ngobrel
 
 import (
-	"encoding/json"
-	fmt "fmt"
+	"errors"
 	"io/ioutil"
 	"log"
+	"net/http"
+	"os"
 	"strings"
+	"time"
 
-	uuid "github.com/satori/go.uuid"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
 )
 
+// Sends notifications with Firebase Cloud Messaging
+type FCMAuth struct {
+	client     *http.Client
+	expired    time.Time
+	projectURL string
+}
+
 type FCMNotificationMessage struct {
 	Message messageBody `json:"message"`
 }
 
 type messageBody struct {
-	Token        string          `json:"token"`
-	Notification messageContents `json:"notification"`
-	Data         dataContents    `json:"data"`
+	Token        string            `json:"token"`
+	Notification *messageContents  `json:"notification,omitempty"`
+	Data         map[string]string `json:"data"`
 }
 
 type messageContents struct {
@@ -25,85 +35,63 @@ type messageContents struct {
 	Title string `json:"title"`
 }
 
-type dataContents struct {
-	ClickAction string `json:"click_action"`
-	ChatID      string `json:"chatID"`
-	GroupID     string `json:"groupID"`
-	RecipientID string `json:"recipientID"`
-	Timestamp   string `json:"timestamp"`
-}
+// Sets up FCM with FCM_PROJECT_URL, the messages:send URL of the project, and the service
+// account in FCM_CONFIG_PATH
+func newFCMFromEnv() (*FCMAuth, error) {
+	projectURL := os.Getenv("FCM_PROJECT_URL")
+	if projectURL == "" {
+		return nil, errors.New("FCM_PROJECT_URL is not set")
+	}
+
+	// FCM itself is only served over https, a plain http URL is a local fake FCM endpoint
+	// which needs no credentials
+	if strings.HasPrefix(projectURL, "http://") && os.Getenv("FCM_CONFIG_PATH") == "" {
+		fcmAuth := NewFCMAuth(http.DefaultClient, projectURL)
+		return &fcmAuth, nil
+	}
 
-func (srv *Server) sendFCM(chatID string, sender string, recipientChatID string, recipient string, excerpt string, now int64, isManagement bool) {
+	fcmConfigPath := os.Getenv("FCM_CONFIG_PATH")
+	if fcmConfigPath == "" {
+		return nil, errors.New("FCM_CONFIG_PATH is not set")
+	}
+
+	data, err := ioutil.ReadFile(fcmConfigPath)
+	if err != nil {
+		return nil, err
+	}
 
-	log.Println("sendFCM", sender, excerpt, isManagement)
-	fcmToken, err := srv.redisClient.Get("FCM-" + recipient).Result()
+	log.Println("Login to Google Firebase")
+	googleConfig, err := google.JWTConfigFromJSON(data, "https://www.googleapis.com/auth/firebase.messaging")
 	if err != nil {
-		log.Println("Error getting FCM token of ", recipient)
 		log.Println(err)
-		return
+		return nil, errors.New("Unable to login to Google Firebase")
 	}
-	if fcmToken != "" {
-		var msg *FCMNotificationMessage
-		if isManagement || excerpt == "" {
-			msg = &FCMNotificationMessage{
-				Message: messageBody{
-					Token: fcmToken,
-					Data: dataContents{
-						ChatID:      chatID,
-						RecipientID: recipient,
-						ClickAction: "FLUTTER_NOTIFICATION_CLICK",
-						Timestamp:   fmt.Sprintf("%s", now),
-					},
-				},
-			}
-		} else {
-			msg = &FCMNotificationMessage{
-				Message: messageBody{
-					Token: fcmToken,
-					Notification: messageContents{
-						Body:  excerpt,
-						Title: sender,
-					},
-					Data: dataContents{
-						ChatID:      chatID,
-						GroupID:     recipientChatID,
-						RecipientID: recipient,
-						ClickAction: "FLUTTER_NOTIFICATION_CLICK",
-						Timestamp:   fmt.Sprintf("%s", now),
-					},
-				},
-			}
-		}
 
-		str, err := json.Marshal(msg)
+	fcmAuth := NewFCMAuth(googleConfig.Client(oauth2.NoContext), projectURL)
+	log.Println("Login OK", fcmAuth.client)
+	return &fcmAuth, nil
+}
 
-		if err != nil {
-			log.Println(err)
-			return
-		}
-		log.Println("fcm", string(str))
-		msgx := strings.NewReader(string(str))
+func (a *FCMAuth) SendNotification(token string, notification *PushNotification) error {
+	data := map[string]string{
+		"click_action": "FLUTTER_NOTIFICATION_CLICK",
+	}
+	for k, v := range notification.Data {
+		data[k] = v
+	}
 
-		resp, err := srv.fcmAuth.client.Post(srv.fcmAuth.projectURL, "application/json", msgx)
-		if err != nil {
-			log.Println(err)
-			return
+	msg := &FCMNotificationMessage{
+		Message: messageBody{
+			Token: token,
+			Data:  data,
+		},
+	}
+	if notification.Title != "" || notification.Body != "" {
+		msg.Message.Notification = &messageContents{
+			Body:  notification.Body,
+			Title: notification.Title,
 		}
-		log.Println(resp)
-		bodyBytes, _ := ioutil.ReadAll(resp.Body)
-
-		log.Println(string(bodyBytes))
-
 	}
-}
-
-func (req *RegisterFCMRequest) RegisterFCM(srv *Server, userID uuid.UUID) (*RegisterFCMResponse, error) {
-	log.Println("Registering FCM for ", userID.String())
-	err := srv.redisClient.Set("FCM-"+userID.String(), req.FCMToken, 0).Err()
 
-	if err != nil {
-		log.Println("Error registering FCM token for user " + userID.String())
-		log.Println(err)
-	}
-	return &RegisterFCMResponse{Success: true}, nil
+	return a.SendWithRetry(msg)
 }
@@ -0,0 +1,157 @@
+package ngobrel
+
+import (
+	"bytes"
+	"encoding/json"
+	"expvar"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Counts the outcomes of the FCM requests:
+//
+//	sent              accepted by FCM
+//	unregistered      the token is no longer valid
+//	invalid_argument  the token or the message is malformed
+//	quota_exceeded    the sending rate is too high, retried
+//	server_error      FCM failed or is unavailable, retried
+//	network_error     FCM could not be reached, retried
+//	rejected          any other error, not retried
+//	retried           requests sent again after an error
+//	failed            messages given up on
+var fcmStats = expvar.NewMap("fcm")
+
+// The error codes of FCM v1, see
+// https://firebase.google.com/docs/reference/fcm/rest/v1/ErrorCode
+const (
+	FCMUnregistered    = "UNREGISTERED"
+	FCMInvalidArgument = "INVALID_ARGUMENT"
+	FCMQuotaExceeded   = "QUOTA_EXCEEDED"
+	FCMUnavailable     = "UNAVAILABLE"
+	FCMInternal        = "INTERNAL"
+)
+
+// An error response of FCM
+type FCMError struct {
+	StatusCode int
+	// The canonical status, e.g. NOT_FOUND
+	Status string
+	// The FCM error code, e.g. UNREGISTERED, or the status if FCM gives none
+	ErrorCode string
+	Message   string
+	// How long FCM asks to wait before trying again, if it does
+	RetryAfter time.Duration
+}
+
+type fcmErrorResponse struct {
+	Error struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+		Status  string `json:"status"`
+		Details []struct {
+			Type      string `json:"@type"`
+			ErrorCode string `json:"errorCode"`
+		} `json:"details"`
+	} `json:"error"`
+}
+
+func (e *FCMError) Error() string {
+	return fmt.Sprintf("fcm: %d %s: %s", e.StatusCode, e.ErrorCode, e.Message)
+}
+
+// Whether the token the message was sent to should be dropped
+func (e *FCMError) TokenInvalid() bool {
+	return e.ErrorCode == FCMUnregistered || e.ErrorCode == FCMInvalidArgument
+}
+
+// Whether the message may get through later
+func (e *FCMError) Retryable() bool {
+	return e.ErrorCode == FCMQuotaExceeded || e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= 500
+}
+
+func (e *FCMError) retryAfter() time.Duration {
+	return e.RetryAfter
+}
+
+func (e *FCMError) outcome() string {
+	switch {
+	case e.ErrorCode == FCMUnregistered:
+		return "unregistered"
+	case e.ErrorCode == FCMInvalidArgument:
+		return "invalid_argument"
+	case e.ErrorCode == FCMQuotaExceeded || e.StatusCode == http.StatusTooManyRequests:
+		return "quota_exceeded"
+	case e.StatusCode >= 500:
+		return "server_error"
+	}
+	return "rejected"
+}
+
+func parseFCMError(resp *http.Response, body []byte) *FCMError {
+	fcmErr := &FCMError{
+		StatusCode: resp.StatusCode,
+		Message:    resp.Status,
+	}
+
+	var data fcmErrorResponse
+	if err := json.Unmarshal(body, &data); err == nil {
+		fcmErr.Status = data.Error.Status
+		fcmErr.ErrorCode = data.Error.Status
+		if data.Error.Message != "" {
+			fcmErr.Message = data.Error.Message
+		}
+		for _, detail := range data.Error.Details {
+			if detail.ErrorCode != "" {
+				fcmErr.ErrorCode = detail.ErrorCode
+			}
+		}
+	}
+
+	if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds > 0 {
+		fcmErr.RetryAfter = time.Duration(seconds) * time.Second
+	}
+	return fcmErr
+}
+
+// Sets up FCM with an HTTP client which authenticates the requests, and the messages:send URL
+// of the project. A local fake FCM endpoint can be used with a plain client.
+func NewFCMAuth(client *http.Client, projectURL string) FCMAuth {
+	return FCMAuth{
+		client:     client,
+		expired:    time.Now().Add(1 * time.Hour),
+		projectURL: projectURL,
+	}
+}
+
+// Sends a message once. FCM errors are returned as *FCMError.
+func (a *FCMAuth) Send(msg *FCMNotificationMessage) error {
+	str, err := json.Marshal(msg)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+	log.Println("fcm", string(str))
+
+	resp, err := a.client.Post(a.projectURL, "application/json", bytes.NewReader(str))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	return parseFCMError(resp, body)
+}
+
+// Sends a message, trying again after quota and server errors
+func (a *FCMAuth) SendWithRetry(msg *FCMNotificationMessage) error {
+	return sendWithRetry(fcmStats, "FCM", func() error {
+		return a.Send(msg)
+	})
+}
@@ -0,0 +1,130 @@
+package ngobrel
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func fcmTokenOf(r *http.Request, body []byte) string {
+	var msg FCMNotificationMessage
+	json.Unmarshal(body, &msg)
+	return msg.Message.Token
+}
+
+// A local FCM v1 endpoint, answering each token with the responses queued for it, then success
+func newFakeFCM() *fakeProvider {
+	return newFakeProvider(fcmTokenOf, fakeResponse{
+		header: map[string]string{"Content-Type": "application/json"},
+		body:   `{"name": "projects/test/messages/1"}`,
+	})
+}
+
+// An error response of FCM v1
+func fcmError(statusCode int, errorCode string, retryAfter string) fakeResponse {
+	header := map[string]string{"Content-Type": "application/json"}
+	if retryAfter != "" {
+		header["Retry-After"] = retryAfter
+	}
+	return fakeResponse{statusCode, header, fmt.Sprintf(`{"error": {"code": %d, "message": "fake error", "status": "%s",
+		"details": [{"@type": "type.googleapis.com/google.firebase.fcm.v1.FcmError", "errorCode": "%s"}]}}`,
+		statusCode, http.StatusText(statusCode), errorCode)}
+}
+
+func fcmStat(name string) int64 {
+	if v, ok := fcmStats.Get(name).(interface{ Value() int64 }); ok {
+		return v.Value()
+	}
+	return 0
+}
+
+func TestFCMSendNotification(t *testing.T) {
+	fcm := newFakeFCM()
+	defer fcm.Close()
+	waits, restore := fakePushSleep()
+	defer restore()
+
+	fcmAuth := NewFCMAuth(fcm.Client(), fcm.URL)
+
+	fcm.respond("unregistered", fcmError(http.StatusNotFound, FCMUnregistered, ""))
+	fcm.respond("invalid", fcmError(http.StatusBadRequest, FCMInvalidArgument, ""))
+	fcm.respond("quota",
+		fcmError(http.StatusTooManyRequests, FCMQuotaExceeded, "3"),
+		fcmError(http.StatusServiceUnavailable, FCMUnavailable, ""))
+	fcm.respond("down",
+		fcmError(http.StatusInternalServerError, FCMInternal, ""),
+		fcmError(http.StatusInternalServerError, FCMInternal, ""),
+		fcmError(http.StatusInternalServerError, FCMInternal, ""),
+		fcmError(http.StatusInternalServerError, FCMInternal, ""))
+
+	before := make(map[string]int64)
+	stats := []string{"sent", "unregistered", "invalid_argument", "quota_exceeded", "server_error", "retried", "failed"}
+	for _, name := range stats {
+		before[name] = fcmStat(name)
+	}
+
+	// Tokens FCM rejects are to be dropped by sendToPushTokens, the others are kept
+	cases := []struct {
+		token    string
+		sent     bool
+		drop     bool
+		requests int
+	}{
+		{"unregistered", false, true, 1},
+		{"invalid", false, true, 1},
+		{"quota", true, false, 3},
+		{"down", false, false, PushMaxAttempts},
+		{"ok", true, false, 1},
+	}
+	for _, c := range cases {
+		err := fcmAuth.SendNotification(c.token, &PushNotification{Title: "Horas", Body: "Hi", Data: map[string]string{"chatID": "1"}})
+		if (err == nil) != c.sent {
+			t.Errorf("%s: got %v", c.token, err)
+		}
+		if err != nil {
+			if pushErr, ok := err.(pushError); ok == false || pushErr.TokenInvalid() != c.drop {
+				t.Errorf("%s: got %v, want TokenInvalid %v", c.token, err, c.drop)
+			}
+		}
+
+		// Quota and server errors are retried, the others are not
+		if got := len(fcm.requestsOf(c.token)); got != c.requests {
+			t.Errorf("%s: %d requests, want %d", c.token, got, c.requests)
+		}
+	}
+
+	// Retry-After is honoured when it is longer than the backoff
+	want := []time.Duration{3 * time.Second, 2 * time.Second, 1 * time.Second, 2 * time.Second, 4 * time.Second}
+	if fmt.Sprint(*waits) != fmt.Sprint(want) {
+		t.Errorf("waits: got %v, want %v", *waits, want)
+	}
+
+	for name, want := range map[string]int64{"sent": 2, "unregistered": 1, "invalid_argument": 1, "quota_exceeded": 1,
+		"server_error": 5, "retried": 5, "failed": 3} {
+		if got := fcmStat(name) - before[name]; got != want {
+			t.Errorf("%s: counted %d, want %d", name, got, want)
+		}
+	}
+}
+
+func TestFCMRetryAfterCap(t *testing.T) {
+	fcm := newFakeFCM()
+	defer fcm.Close()
+	waits, restore := fakePushSleep()
+	defer restore()
+
+	fcmAuth := NewFCMAuth(fcm.Client(), fcm.URL)
+	retryAfter := strconv.Itoa(int((PushRetryMax + time.Second) / time.Second))
+	fcm.respond("quota", fcmError(http.StatusTooManyRequests, FCMQuotaExceeded, retryAfter))
+
+	err := fcmAuth.SendNotification("quota", &PushNotification{})
+	if fcmErr, ok := err.(*FCMError); ok == false || fcmErr.ErrorCode != FCMQuotaExceeded {
+		t.Fatalf("got %v", err)
+	}
+	if len(fcm.requestsOf("quota")) != 1 || len(*waits) != 0 {
+		t.Errorf("a Retry-After over PushRetryMax was waited for: %d requests, waits %v", len(fcm.requestsOf("quota")), *waits)
+	}
+}
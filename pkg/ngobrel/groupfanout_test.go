@@ -0,0 +1,101 @@
+package ngobrel
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// Measures putting a message to a group, which should take one statement whatever its size.
+// It needs a migrated database in TEST_DB_URL, e.g.
+//
+//	TEST_DB_URL="postgres://postgres@localhost/ngobrel_test?sslmode=disable" go test -run XXX -bench GroupMember
+//
+// Everything is done in a transaction which is rolled back.
+func BenchmarkPutMessageToGroupMember(b *testing.B) {
+	connStr := os.Getenv("TEST_DB_URL")
+	if connStr == "" {
+		b.Skip("TEST_DB_URL is not set")
+	}
+
+	db, err := sql.Open("postgres", connStr)
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer db.Close()
+
+	for _, members := range []int{1000, 2000, 5000} {
+		b.Run(fmt.Sprintf("members=%d", members), func(b *testing.B) {
+			benchmarkGroupFanout(b, db, members)
+		})
+	}
+}
+
+func benchmarkGroupFanout(b *testing.B, db *sql.DB, members int) {
+	tx, err := db.Begin()
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer tx.Rollback()
+
+	groupID := uuid.NewV4()
+	prefix := groupID.String()
+
+	// Every member has two active devices
+	_, err = tx.Exec(`INSERT INTO chat_list (user_id, chat_id, created_at, updated_at, chat_type, excerpt, is_admin)
+	SELECT md5($1 || '-user-' || i)::uuid, $2, now(), now(), 0, '', 0 FROM generate_series(1, $3) i`,
+		prefix, groupID.String(), members)
+	if err != nil {
+		b.Fatal(err)
+	}
+	_, err = tx.Exec(`INSERT INTO devices (user_id, device_id, created_at, updated_at, device_state)
+	SELECT md5($1 || '-user-' || i)::uuid, md5($1 || '-device-' || i || '-' || d)::uuid, now(), now(), 1
+	FROM generate_series(1, $2) i, generate_series(1, 2) d`,
+		prefix, members)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	var senderID, senderDeviceID uuid.UUID
+	err = tx.QueryRow(`SELECT user_id, device_id FROM devices WHERE user_id=md5($1 || '-user-1')::uuid LIMIT 1`, prefix).
+		Scan(&senderID, &senderDeviceID)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	srv := &Server{db: db}
+	now := float64(time.Now().UnixNano()/1000) / 1000000
+
+	b.ResetTimer()
+	start := time.Now()
+	for i := 0; i < b.N; i++ {
+		if _, err := tx.Exec(`SAVEPOINT fanout`); err != nil {
+			b.Fatal(err)
+		}
+
+		req := &PutMessageRequest{
+			RecipientID:     groupID.String(),
+			MessageID:       int64(i + 1),
+			MessageExcerpt:  "benchmark",
+			MessageContents: "benchmark",
+		}
+		recipients, err := req.putMessageToGroupMember(srv, tx, senderID, senderDeviceID, groupID, now)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if len(recipients) != members {
+			b.Fatalf("%d members notified, expected %d", len(recipients), members)
+		}
+
+		if _, err := tx.Exec(`ROLLBACK TO SAVEPOINT fanout`); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+
+	b.ReportMetric(float64(2*members*b.N)/time.Since(start).Seconds(), "deliveries/s")
+}
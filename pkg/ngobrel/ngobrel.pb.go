@@ -46,7 +46,7 @@ func (x ConversationType) String() string {
 	return proto.EnumName(ConversationType_name, int32(x))
 }
 func (ConversationType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{0}
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{0}
 }
 
 type MessageState int32
@@ -69,7 +69,7 @@ func (x MessageState) String() string {
 	return proto.EnumName(MessageState_name, int32(x))
 }
 func (MessageState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{1}
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{1}
 }
 
 type MessageReceptionState int32
@@ -98,7 +98,7 @@ func (x MessageReceptionState) String() string {
 	return proto.EnumName(MessageReceptionState_name, int32(x))
 }
 func (MessageReceptionState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{2}
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{2}
 }
 
 type BlockContactRequest struct {
@@ -112,7 +112,7 @@ func (m *BlockContactRequest) Reset()         { *m = BlockContactRequest{} }
 func (m *BlockContactRequest) String() string { return proto.CompactTextString(m) }
 func (*BlockContactRequest) ProtoMessage()    {}
 func (*BlockContactRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{0}
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{0}
 }
 func (m *BlockContactRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockContactRequest.Unmarshal(m, b)
@@ -150,7 +150,7 @@ func (m *BlockContactResponse) Reset()         { *m = BlockContactResponse{} }
 func (m *BlockContactResponse) String() string { return proto.CompactTextString(m) }
 func (*BlockContactResponse) ProtoMessage()    {}
 func (*BlockContactResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{1}
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{1}
 }
 func (m *BlockContactResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockContactResponse.Unmarshal(m, b)
@@ -188,7 +188,7 @@ func (m *UnblockContactRequest) Reset()         { *m = UnblockContactRequest{} }
 func (m *UnblockContactRequest) String() string { return proto.CompactTextString(m) }
 func (*UnblockContactRequest) ProtoMessage()    {}
 func (*UnblockContactRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{2}
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{2}
 }
 func (m *UnblockContactRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UnblockContactRequest.Unmarshal(m, b)
@@ -226,7 +226,7 @@ func (m *UnblockContactResponse) Reset()         { *m = UnblockContactResponse{}
 func (m *UnblockContactResponse) String() string { return proto.CompactTextString(m) }
 func (*UnblockContactResponse) ProtoMessage()    {}
 func (*UnblockContactResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{3}
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{3}
 }
 func (m *UnblockContactResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UnblockContactResponse.Unmarshal(m, b)
@@ -264,7 +264,7 @@ func (m *GetProfilePictureRequest) Reset()         { *m = GetProfilePictureReque
 func (m *GetProfilePictureRequest) String() string { return proto.CompactTextString(m) }
 func (*GetProfilePictureRequest) ProtoMessage()    {}
 func (*GetProfilePictureRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{4}
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{4}
 }
 func (m *GetProfilePictureRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetProfilePictureRequest.Unmarshal(m, b)
@@ -302,7 +302,7 @@ func (m *GetProfilePictureResponse) Reset()         { *m = GetProfilePictureResp
 func (m *GetProfilePictureResponse) String() string { return proto.CompactTextString(m) }
 func (*GetProfilePictureResponse) ProtoMessage()    {}
 func (*GetProfilePictureResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{5}
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{5}
 }
 func (m *GetProfilePictureResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetProfilePictureResponse.Unmarshal(m, b)
@@ -340,7 +340,7 @@ func (m *EchoRequest) Reset()         { *m = EchoRequest{} }
 func (m *EchoRequest) String() string { return proto.CompactTextString(m) }
 func (*EchoRequest) ProtoMessage()    {}
 func (*EchoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{6}
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{6}
 }
 func (m *EchoRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EchoRequest.Unmarshal(m, b)
@@ -378,7 +378,7 @@ func (m *EchoResponse) Reset()         { *m = EchoResponse{} }
 func (m *EchoResponse) String() string { return proto.CompactTextString(m) }
 func (*EchoResponse) ProtoMessage()    {}
 func (*EchoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{7}
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{7}
 }
 func (m *EchoResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EchoResponse.Unmarshal(m, b)
@@ -417,7 +417,7 @@ func (m *RenameGroupRequest) Reset()         { *m = RenameGroupRequest{} }
 func (m *RenameGroupRequest) String() string { return proto.CompactTextString(m) }
 func (*RenameGroupRequest) ProtoMessage()    {}
 func (*RenameGroupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{8}
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{8}
 }
 func (m *RenameGroupRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RenameGroupRequest.Unmarshal(m, b)
@@ -462,7 +462,7 @@ func (m *RenameGroupResponse) Reset()         { *m = RenameGroupResponse{} }
 func (m *RenameGroupResponse) String() string { return proto.CompactTextString(m) }
 func (*RenameGroupResponse) ProtoMessage()    {}
 func (*RenameGroupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{9}
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{9}
 }
 func (m *RenameGroupResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RenameGroupResponse.Unmarshal(m, b)
@@ -489,6 +489,414 @@ func (m *RenameGroupResponse) GetSuccess() bool {
 	return false
 }
 
+type UpdateGroupAvatarRequest struct {
+	GroupID              string   `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	Avatar               string   `protobuf:"bytes,2,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateGroupAvatarRequest) Reset()         { *m = UpdateGroupAvatarRequest{} }
+func (m *UpdateGroupAvatarRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateGroupAvatarRequest) ProtoMessage()    {}
+func (*UpdateGroupAvatarRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{10}
+}
+func (m *UpdateGroupAvatarRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateGroupAvatarRequest.Unmarshal(m, b)
+}
+func (m *UpdateGroupAvatarRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateGroupAvatarRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpdateGroupAvatarRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateGroupAvatarRequest.Merge(dst, src)
+}
+func (m *UpdateGroupAvatarRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateGroupAvatarRequest.Size(m)
+}
+func (m *UpdateGroupAvatarRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateGroupAvatarRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateGroupAvatarRequest proto.InternalMessageInfo
+
+func (m *UpdateGroupAvatarRequest) GetGroupID() string {
+	if m != nil {
+		return m.GroupID
+	}
+	return ""
+}
+
+func (m *UpdateGroupAvatarRequest) GetAvatar() string {
+	if m != nil {
+		return m.Avatar
+	}
+	return ""
+}
+
+type UpdateGroupAvatarResponse struct {
+	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateGroupAvatarResponse) Reset()         { *m = UpdateGroupAvatarResponse{} }
+func (m *UpdateGroupAvatarResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateGroupAvatarResponse) ProtoMessage()    {}
+func (*UpdateGroupAvatarResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{11}
+}
+func (m *UpdateGroupAvatarResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateGroupAvatarResponse.Unmarshal(m, b)
+}
+func (m *UpdateGroupAvatarResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateGroupAvatarResponse.Marshal(b, m, deterministic)
+}
+func (dst *UpdateGroupAvatarResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateGroupAvatarResponse.Merge(dst, src)
+}
+func (m *UpdateGroupAvatarResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateGroupAvatarResponse.Size(m)
+}
+func (m *UpdateGroupAvatarResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateGroupAvatarResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateGroupAvatarResponse proto.InternalMessageInfo
+
+func (m *UpdateGroupAvatarResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+type DisbandGroupRequest struct {
+	GroupID              string   `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DisbandGroupRequest) Reset()         { *m = DisbandGroupRequest{} }
+func (m *DisbandGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*DisbandGroupRequest) ProtoMessage()    {}
+func (*DisbandGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{12}
+}
+func (m *DisbandGroupRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DisbandGroupRequest.Unmarshal(m, b)
+}
+func (m *DisbandGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DisbandGroupRequest.Marshal(b, m, deterministic)
+}
+func (dst *DisbandGroupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisbandGroupRequest.Merge(dst, src)
+}
+func (m *DisbandGroupRequest) XXX_Size() int {
+	return xxx_messageInfo_DisbandGroupRequest.Size(m)
+}
+func (m *DisbandGroupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisbandGroupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisbandGroupRequest proto.InternalMessageInfo
+
+func (m *DisbandGroupRequest) GetGroupID() string {
+	if m != nil {
+		return m.GroupID
+	}
+	return ""
+}
+
+type DisbandGroupResponse struct {
+	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DisbandGroupResponse) Reset()         { *m = DisbandGroupResponse{} }
+func (m *DisbandGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*DisbandGroupResponse) ProtoMessage()    {}
+func (*DisbandGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{13}
+}
+func (m *DisbandGroupResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DisbandGroupResponse.Unmarshal(m, b)
+}
+func (m *DisbandGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DisbandGroupResponse.Marshal(b, m, deterministic)
+}
+func (dst *DisbandGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisbandGroupResponse.Merge(dst, src)
+}
+func (m *DisbandGroupResponse) XXX_Size() int {
+	return xxx_messageInfo_DisbandGroupResponse.Size(m)
+}
+func (m *DisbandGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisbandGroupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisbandGroupResponse proto.InternalMessageInfo
+
+func (m *DisbandGroupResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+type EditGroupRequest struct {
+	GroupID              string   `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Topic                string   `protobuf:"bytes,3,opt,name=topic,proto3" json:"topic,omitempty"`
+	CustomData           string   `protobuf:"bytes,4,opt,name=customData,proto3" json:"customData,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EditGroupRequest) Reset()         { *m = EditGroupRequest{} }
+func (m *EditGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*EditGroupRequest) ProtoMessage()    {}
+func (*EditGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{14}
+}
+func (m *EditGroupRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EditGroupRequest.Unmarshal(m, b)
+}
+func (m *EditGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EditGroupRequest.Marshal(b, m, deterministic)
+}
+func (dst *EditGroupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EditGroupRequest.Merge(dst, src)
+}
+func (m *EditGroupRequest) XXX_Size() int {
+	return xxx_messageInfo_EditGroupRequest.Size(m)
+}
+func (m *EditGroupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EditGroupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EditGroupRequest proto.InternalMessageInfo
+
+func (m *EditGroupRequest) GetGroupID() string {
+	if m != nil {
+		return m.GroupID
+	}
+	return ""
+}
+
+func (m *EditGroupRequest) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *EditGroupRequest) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *EditGroupRequest) GetCustomData() string {
+	if m != nil {
+		return m.CustomData
+	}
+	return ""
+}
+
+type EditGroupResponse struct {
+	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message              string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EditGroupResponse) Reset()         { *m = EditGroupResponse{} }
+func (m *EditGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*EditGroupResponse) ProtoMessage()    {}
+func (*EditGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{15}
+}
+func (m *EditGroupResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EditGroupResponse.Unmarshal(m, b)
+}
+func (m *EditGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EditGroupResponse.Marshal(b, m, deterministic)
+}
+func (dst *EditGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EditGroupResponse.Merge(dst, src)
+}
+func (m *EditGroupResponse) XXX_Size() int {
+	return xxx_messageInfo_EditGroupResponse.Size(m)
+}
+func (m *EditGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EditGroupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EditGroupResponse proto.InternalMessageInfo
+
+func (m *EditGroupResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *EditGroupResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type GetGroupInfoRequest struct {
+	GroupID              string   `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetGroupInfoRequest) Reset()         { *m = GetGroupInfoRequest{} }
+func (m *GetGroupInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*GetGroupInfoRequest) ProtoMessage()    {}
+func (*GetGroupInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{16}
+}
+func (m *GetGroupInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetGroupInfoRequest.Unmarshal(m, b)
+}
+func (m *GetGroupInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetGroupInfoRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetGroupInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGroupInfoRequest.Merge(dst, src)
+}
+func (m *GetGroupInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_GetGroupInfoRequest.Size(m)
+}
+func (m *GetGroupInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGroupInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetGroupInfoRequest proto.InternalMessageInfo
+
+func (m *GetGroupInfoRequest) GetGroupID() string {
+	if m != nil {
+		return m.GroupID
+	}
+	return ""
+}
+
+type GetGroupInfoResponse struct {
+	GroupID              string   `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description          string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Topic                string   `protobuf:"bytes,4,opt,name=topic,proto3" json:"topic,omitempty"`
+	CustomData           string   `protobuf:"bytes,5,opt,name=customData,proto3" json:"customData,omitempty"`
+	CreatorID            string   `protobuf:"bytes,6,opt,name=creatorID,proto3" json:"creatorID,omitempty"`
+	Avatar               string   `protobuf:"bytes,7,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	AvatarThumbnail      []byte   `protobuf:"bytes,8,opt,name=avatarThumbnail,proto3" json:"avatarThumbnail,omitempty"`
+	CreatedAt            int64    `protobuf:"varint,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetGroupInfoResponse) Reset()         { *m = GetGroupInfoResponse{} }
+func (m *GetGroupInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*GetGroupInfoResponse) ProtoMessage()    {}
+func (*GetGroupInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{17}
+}
+func (m *GetGroupInfoResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetGroupInfoResponse.Unmarshal(m, b)
+}
+func (m *GetGroupInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetGroupInfoResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetGroupInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGroupInfoResponse.Merge(dst, src)
+}
+func (m *GetGroupInfoResponse) XXX_Size() int {
+	return xxx_messageInfo_GetGroupInfoResponse.Size(m)
+}
+func (m *GetGroupInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGroupInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetGroupInfoResponse proto.InternalMessageInfo
+
+func (m *GetGroupInfoResponse) GetGroupID() string {
+	if m != nil {
+		return m.GroupID
+	}
+	return ""
+}
+
+func (m *GetGroupInfoResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GetGroupInfoResponse) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *GetGroupInfoResponse) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *GetGroupInfoResponse) GetCustomData() string {
+	if m != nil {
+		return m.CustomData
+	}
+	return ""
+}
+
+func (m *GetGroupInfoResponse) GetCreatorID() string {
+	if m != nil {
+		return m.CreatorID
+	}
+	return ""
+}
+
+func (m *GetGroupInfoResponse) GetAvatar() string {
+	if m != nil {
+		return m.Avatar
+	}
+	return ""
+}
+
+func (m *GetGroupInfoResponse) GetAvatarThumbnail() []byte {
+	if m != nil {
+		return m.AvatarThumbnail
+	}
+	return nil
+}
+
+func (m *GetGroupInfoResponse) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
 type ExitFromGroupRequest struct {
 	GroupID              string   `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -500,7 +908,7 @@ func (m *ExitFromGroupRequest) Reset()         { *m = ExitFromGroupRequest{} }
 func (m *ExitFromGroupRequest) String() string { return proto.CompactTextString(m) }
 func (*ExitFromGroupRequest) ProtoMessage()    {}
 func (*ExitFromGroupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{10}
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{18}
 }
 func (m *ExitFromGroupRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ExitFromGroupRequest.Unmarshal(m, b)
@@ -538,7 +946,7 @@ func (m *ExitFromGroupResponse) Reset()         { *m = ExitFromGroupResponse{} }
 func (m *ExitFromGroupResponse) String() string { return proto.CompactTextString(m) }
 func (*ExitFromGroupResponse) ProtoMessage()    {}
 func (*ExitFromGroupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{11}
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{19}
 }
 func (m *ExitFromGroupResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ExitFromGroupResponse.Unmarshal(m, b)
@@ -577,7 +985,7 @@ func (m *RemoveAdminRoleRequest) Reset()         { *m = RemoveAdminRoleRequest{}
 func (m *RemoveAdminRoleRequest) String() string { return proto.CompactTextString(m) }
 func (*RemoveAdminRoleRequest) ProtoMessage()    {}
 func (*RemoveAdminRoleRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{12}
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{20}
 }
 func (m *RemoveAdminRoleRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RemoveAdminRoleRequest.Unmarshal(m, b)
@@ -622,7 +1030,7 @@ func (m *RemoveAdminRoleResponse) Reset()         { *m = RemoveAdminRoleResponse
 func (m *RemoveAdminRoleResponse) String() string { return proto.CompactTextString(m) }
 func (*RemoveAdminRoleResponse) ProtoMessage()    {}
 func (*RemoveAdminRoleResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{13}
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{21}
 }
 func (m *RemoveAdminRoleResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RemoveAdminRoleResponse.Unmarshal(m, b)
@@ -661,7 +1069,7 @@ func (m *RemoveFromGroupRequest) Reset()         { *m = RemoveFromGroupRequest{}
 func (m *RemoveFromGroupRequest) String() string { return proto.CompactTextString(m) }
 func (*RemoveFromGroupRequest) ProtoMessage()    {}
 func (*RemoveFromGroupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{14}
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{22}
 }
 func (m *RemoveFromGroupRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RemoveFromGroupRequest.Unmarshal(m, b)
@@ -706,7 +1114,7 @@ func (m *RemoveFromGroupResponse) Reset()         { *m = RemoveFromGroupResponse
 func (m *RemoveFromGroupResponse) String() string { return proto.CompactTextString(m) }
 func (*RemoveFromGroupResponse) ProtoMessage()    {}
 func (*RemoveFromGroupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{15}
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{23}
 }
 func (m *RemoveFromGroupResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RemoveFromGroupResponse.Unmarshal(m, b)
@@ -733,2824 +1141,5388 @@ func (m *RemoveFromGroupResponse) GetSuccess() bool {
 	return false
 }
 
-type ListGroupParticipantsRequest struct {
+type BanFromGroupRequest struct {
 	GroupID              string   `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	UserID               string   `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListGroupParticipantsRequest) Reset()         { *m = ListGroupParticipantsRequest{} }
-func (m *ListGroupParticipantsRequest) String() string { return proto.CompactTextString(m) }
-func (*ListGroupParticipantsRequest) ProtoMessage()    {}
-func (*ListGroupParticipantsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{16}
+func (m *BanFromGroupRequest) Reset()         { *m = BanFromGroupRequest{} }
+func (m *BanFromGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*BanFromGroupRequest) ProtoMessage()    {}
+func (*BanFromGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{24}
 }
-func (m *ListGroupParticipantsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListGroupParticipantsRequest.Unmarshal(m, b)
+func (m *BanFromGroupRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BanFromGroupRequest.Unmarshal(m, b)
 }
-func (m *ListGroupParticipantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListGroupParticipantsRequest.Marshal(b, m, deterministic)
+func (m *BanFromGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BanFromGroupRequest.Marshal(b, m, deterministic)
 }
-func (dst *ListGroupParticipantsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListGroupParticipantsRequest.Merge(dst, src)
+func (dst *BanFromGroupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BanFromGroupRequest.Merge(dst, src)
 }
-func (m *ListGroupParticipantsRequest) XXX_Size() int {
-	return xxx_messageInfo_ListGroupParticipantsRequest.Size(m)
+func (m *BanFromGroupRequest) XXX_Size() int {
+	return xxx_messageInfo_BanFromGroupRequest.Size(m)
 }
-func (m *ListGroupParticipantsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListGroupParticipantsRequest.DiscardUnknown(m)
+func (m *BanFromGroupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BanFromGroupRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListGroupParticipantsRequest proto.InternalMessageInfo
+var xxx_messageInfo_BanFromGroupRequest proto.InternalMessageInfo
 
-func (m *ListGroupParticipantsRequest) GetGroupID() string {
+func (m *BanFromGroupRequest) GetGroupID() string {
 	if m != nil {
 		return m.GroupID
 	}
 	return ""
 }
 
-type ListGroupParticipantsResponse struct {
-	Participants         []*GroupParticipant `protobuf:"bytes,1,rep,name=participants,proto3" json:"participants,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+func (m *BanFromGroupRequest) GetUserID() string {
+	if m != nil {
+		return m.UserID
+	}
+	return ""
 }
 
-func (m *ListGroupParticipantsResponse) Reset()         { *m = ListGroupParticipantsResponse{} }
-func (m *ListGroupParticipantsResponse) String() string { return proto.CompactTextString(m) }
-func (*ListGroupParticipantsResponse) ProtoMessage()    {}
-func (*ListGroupParticipantsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{17}
+type BanFromGroupResponse struct {
+	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *ListGroupParticipantsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListGroupParticipantsResponse.Unmarshal(m, b)
+
+func (m *BanFromGroupResponse) Reset()         { *m = BanFromGroupResponse{} }
+func (m *BanFromGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*BanFromGroupResponse) ProtoMessage()    {}
+func (*BanFromGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{25}
 }
-func (m *ListGroupParticipantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListGroupParticipantsResponse.Marshal(b, m, deterministic)
+func (m *BanFromGroupResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BanFromGroupResponse.Unmarshal(m, b)
 }
-func (dst *ListGroupParticipantsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListGroupParticipantsResponse.Merge(dst, src)
+func (m *BanFromGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BanFromGroupResponse.Marshal(b, m, deterministic)
 }
-func (m *ListGroupParticipantsResponse) XXX_Size() int {
-	return xxx_messageInfo_ListGroupParticipantsResponse.Size(m)
+func (dst *BanFromGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BanFromGroupResponse.Merge(dst, src)
 }
-func (m *ListGroupParticipantsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListGroupParticipantsResponse.DiscardUnknown(m)
+func (m *BanFromGroupResponse) XXX_Size() int {
+	return xxx_messageInfo_BanFromGroupResponse.Size(m)
+}
+func (m *BanFromGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BanFromGroupResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListGroupParticipantsResponse proto.InternalMessageInfo
+var xxx_messageInfo_BanFromGroupResponse proto.InternalMessageInfo
 
-func (m *ListGroupParticipantsResponse) GetParticipants() []*GroupParticipant {
+func (m *BanFromGroupResponse) GetSuccess() bool {
 	if m != nil {
-		return m.Participants
+		return m.Success
 	}
-	return nil
+	return false
 }
 
-type VerifyOTPRequest struct {
-	PhoneNumber          string   `protobuf:"bytes,1,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
-	OTP                  string   `protobuf:"bytes,2,opt,name=OTP,proto3" json:"OTP,omitempty"`
-	DeviceID             string   `protobuf:"bytes,3,opt,name=deviceID,proto3" json:"deviceID,omitempty"`
+type UnbanFromGroupRequest struct {
+	GroupID              string   `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	UserID               string   `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *VerifyOTPRequest) Reset()         { *m = VerifyOTPRequest{} }
-func (m *VerifyOTPRequest) String() string { return proto.CompactTextString(m) }
-func (*VerifyOTPRequest) ProtoMessage()    {}
-func (*VerifyOTPRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{18}
+func (m *UnbanFromGroupRequest) Reset()         { *m = UnbanFromGroupRequest{} }
+func (m *UnbanFromGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*UnbanFromGroupRequest) ProtoMessage()    {}
+func (*UnbanFromGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{26}
 }
-func (m *VerifyOTPRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_VerifyOTPRequest.Unmarshal(m, b)
+func (m *UnbanFromGroupRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnbanFromGroupRequest.Unmarshal(m, b)
 }
-func (m *VerifyOTPRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_VerifyOTPRequest.Marshal(b, m, deterministic)
+func (m *UnbanFromGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnbanFromGroupRequest.Marshal(b, m, deterministic)
 }
-func (dst *VerifyOTPRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VerifyOTPRequest.Merge(dst, src)
+func (dst *UnbanFromGroupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbanFromGroupRequest.Merge(dst, src)
 }
-func (m *VerifyOTPRequest) XXX_Size() int {
-	return xxx_messageInfo_VerifyOTPRequest.Size(m)
+func (m *UnbanFromGroupRequest) XXX_Size() int {
+	return xxx_messageInfo_UnbanFromGroupRequest.Size(m)
 }
-func (m *VerifyOTPRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_VerifyOTPRequest.DiscardUnknown(m)
+func (m *UnbanFromGroupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbanFromGroupRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_VerifyOTPRequest proto.InternalMessageInfo
-
-func (m *VerifyOTPRequest) GetPhoneNumber() string {
-	if m != nil {
-		return m.PhoneNumber
-	}
-	return ""
-}
+var xxx_messageInfo_UnbanFromGroupRequest proto.InternalMessageInfo
 
-func (m *VerifyOTPRequest) GetOTP() string {
+func (m *UnbanFromGroupRequest) GetGroupID() string {
 	if m != nil {
-		return m.OTP
+		return m.GroupID
 	}
 	return ""
 }
 
-func (m *VerifyOTPRequest) GetDeviceID() string {
+func (m *UnbanFromGroupRequest) GetUserID() string {
 	if m != nil {
-		return m.DeviceID
+		return m.UserID
 	}
 	return ""
 }
 
-type VerifyOTPResponse struct {
-	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+type UnbanFromGroupResponse struct {
+	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *VerifyOTPResponse) Reset()         { *m = VerifyOTPResponse{} }
-func (m *VerifyOTPResponse) String() string { return proto.CompactTextString(m) }
-func (*VerifyOTPResponse) ProtoMessage()    {}
-func (*VerifyOTPResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{19}
+func (m *UnbanFromGroupResponse) Reset()         { *m = UnbanFromGroupResponse{} }
+func (m *UnbanFromGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*UnbanFromGroupResponse) ProtoMessage()    {}
+func (*UnbanFromGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{27}
 }
-func (m *VerifyOTPResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_VerifyOTPResponse.Unmarshal(m, b)
+func (m *UnbanFromGroupResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnbanFromGroupResponse.Unmarshal(m, b)
 }
-func (m *VerifyOTPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_VerifyOTPResponse.Marshal(b, m, deterministic)
+func (m *UnbanFromGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnbanFromGroupResponse.Marshal(b, m, deterministic)
 }
-func (dst *VerifyOTPResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VerifyOTPResponse.Merge(dst, src)
+func (dst *UnbanFromGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbanFromGroupResponse.Merge(dst, src)
 }
-func (m *VerifyOTPResponse) XXX_Size() int {
-	return xxx_messageInfo_VerifyOTPResponse.Size(m)
+func (m *UnbanFromGroupResponse) XXX_Size() int {
+	return xxx_messageInfo_UnbanFromGroupResponse.Size(m)
 }
-func (m *VerifyOTPResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_VerifyOTPResponse.DiscardUnknown(m)
+func (m *UnbanFromGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbanFromGroupResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_VerifyOTPResponse proto.InternalMessageInfo
+var xxx_messageInfo_UnbanFromGroupResponse proto.InternalMessageInfo
 
-func (m *VerifyOTPResponse) GetToken() string {
+func (m *UnbanFromGroupResponse) GetSuccess() bool {
 	if m != nil {
-		return m.Token
+		return m.Success
 	}
-	return ""
+	return false
 }
 
-type CreateProfileRequest struct {
-	DeviceID             string   `protobuf:"bytes,1,opt,name=deviceID,proto3" json:"deviceID,omitempty"`
-	PhoneNumber          string   `protobuf:"bytes,2,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
+type ListGroupBansRequest struct {
+	GroupID              string   `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateProfileRequest) Reset()         { *m = CreateProfileRequest{} }
-func (m *CreateProfileRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateProfileRequest) ProtoMessage()    {}
-func (*CreateProfileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{20}
+func (m *ListGroupBansRequest) Reset()         { *m = ListGroupBansRequest{} }
+func (m *ListGroupBansRequest) String() string { return proto.CompactTextString(m) }
+func (*ListGroupBansRequest) ProtoMessage()    {}
+func (*ListGroupBansRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{28}
 }
-func (m *CreateProfileRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateProfileRequest.Unmarshal(m, b)
+func (m *ListGroupBansRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListGroupBansRequest.Unmarshal(m, b)
 }
-func (m *CreateProfileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateProfileRequest.Marshal(b, m, deterministic)
+func (m *ListGroupBansRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListGroupBansRequest.Marshal(b, m, deterministic)
 }
-func (dst *CreateProfileRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateProfileRequest.Merge(dst, src)
+func (dst *ListGroupBansRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListGroupBansRequest.Merge(dst, src)
 }
-func (m *CreateProfileRequest) XXX_Size() int {
-	return xxx_messageInfo_CreateProfileRequest.Size(m)
+func (m *ListGroupBansRequest) XXX_Size() int {
+	return xxx_messageInfo_ListGroupBansRequest.Size(m)
 }
-func (m *CreateProfileRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateProfileRequest.DiscardUnknown(m)
+func (m *ListGroupBansRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListGroupBansRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateProfileRequest proto.InternalMessageInfo
-
-func (m *CreateProfileRequest) GetDeviceID() string {
-	if m != nil {
-		return m.DeviceID
-	}
-	return ""
-}
+var xxx_messageInfo_ListGroupBansRequest proto.InternalMessageInfo
 
-func (m *CreateProfileRequest) GetPhoneNumber() string {
+func (m *ListGroupBansRequest) GetGroupID() string {
 	if m != nil {
-		return m.PhoneNumber
+		return m.GroupID
 	}
 	return ""
 }
 
-type CreateProfileResponse struct {
-	UserID               string   `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	OtpDebug             string   `protobuf:"bytes,2,opt,name=otpDebug,proto3" json:"otpDebug,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ListGroupBansResponse struct {
+	List                 []*GroupRestriction `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
-func (m *CreateProfileResponse) Reset()         { *m = CreateProfileResponse{} }
-func (m *CreateProfileResponse) String() string { return proto.CompactTextString(m) }
-func (*CreateProfileResponse) ProtoMessage()    {}
-func (*CreateProfileResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{21}
+func (m *ListGroupBansResponse) Reset()         { *m = ListGroupBansResponse{} }
+func (m *ListGroupBansResponse) String() string { return proto.CompactTextString(m) }
+func (*ListGroupBansResponse) ProtoMessage()    {}
+func (*ListGroupBansResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{29}
 }
-func (m *CreateProfileResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateProfileResponse.Unmarshal(m, b)
+func (m *ListGroupBansResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListGroupBansResponse.Unmarshal(m, b)
 }
-func (m *CreateProfileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateProfileResponse.Marshal(b, m, deterministic)
+func (m *ListGroupBansResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListGroupBansResponse.Marshal(b, m, deterministic)
 }
-func (dst *CreateProfileResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateProfileResponse.Merge(dst, src)
+func (dst *ListGroupBansResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListGroupBansResponse.Merge(dst, src)
 }
-func (m *CreateProfileResponse) XXX_Size() int {
-	return xxx_messageInfo_CreateProfileResponse.Size(m)
+func (m *ListGroupBansResponse) XXX_Size() int {
+	return xxx_messageInfo_ListGroupBansResponse.Size(m)
 }
-func (m *CreateProfileResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateProfileResponse.DiscardUnknown(m)
+func (m *ListGroupBansResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListGroupBansResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateProfileResponse proto.InternalMessageInfo
-
-func (m *CreateProfileResponse) GetUserID() string {
-	if m != nil {
-		return m.UserID
-	}
-	return ""
-}
+var xxx_messageInfo_ListGroupBansResponse proto.InternalMessageInfo
 
-func (m *CreateProfileResponse) GetOtpDebug() string {
+func (m *ListGroupBansResponse) GetList() []*GroupRestriction {
 	if m != nil {
-		return m.OtpDebug
+		return m.List
 	}
-	return ""
+	return nil
 }
 
-type EditProfileRequest struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	UserName             string   `protobuf:"bytes,2,opt,name=userName,proto3" json:"userName,omitempty"`
-	CustomData           string   `protobuf:"bytes,3,opt,name=customData,proto3" json:"customData,omitempty"`
-	AvatarMediaId        string   `protobuf:"bytes,4,opt,name=avatarMediaId,proto3" json:"avatarMediaId,omitempty"`
+type MuteGroupMemberRequest struct {
+	GroupID              string   `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	UserID               string   `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	Duration             int64    `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *EditProfileRequest) Reset()         { *m = EditProfileRequest{} }
-func (m *EditProfileRequest) String() string { return proto.CompactTextString(m) }
-func (*EditProfileRequest) ProtoMessage()    {}
-func (*EditProfileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{22}
+func (m *MuteGroupMemberRequest) Reset()         { *m = MuteGroupMemberRequest{} }
+func (m *MuteGroupMemberRequest) String() string { return proto.CompactTextString(m) }
+func (*MuteGroupMemberRequest) ProtoMessage()    {}
+func (*MuteGroupMemberRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{30}
 }
-func (m *EditProfileRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_EditProfileRequest.Unmarshal(m, b)
+func (m *MuteGroupMemberRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MuteGroupMemberRequest.Unmarshal(m, b)
 }
-func (m *EditProfileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_EditProfileRequest.Marshal(b, m, deterministic)
+func (m *MuteGroupMemberRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MuteGroupMemberRequest.Marshal(b, m, deterministic)
 }
-func (dst *EditProfileRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EditProfileRequest.Merge(dst, src)
+func (dst *MuteGroupMemberRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MuteGroupMemberRequest.Merge(dst, src)
 }
-func (m *EditProfileRequest) XXX_Size() int {
-	return xxx_messageInfo_EditProfileRequest.Size(m)
+func (m *MuteGroupMemberRequest) XXX_Size() int {
+	return xxx_messageInfo_MuteGroupMemberRequest.Size(m)
 }
-func (m *EditProfileRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_EditProfileRequest.DiscardUnknown(m)
+func (m *MuteGroupMemberRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MuteGroupMemberRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EditProfileRequest proto.InternalMessageInfo
-
-func (m *EditProfileRequest) GetName() string {
-	if m != nil {
-		return m.Name
-	}
-	return ""
-}
+var xxx_messageInfo_MuteGroupMemberRequest proto.InternalMessageInfo
 
-func (m *EditProfileRequest) GetUserName() string {
+func (m *MuteGroupMemberRequest) GetGroupID() string {
 	if m != nil {
-		return m.UserName
+		return m.GroupID
 	}
 	return ""
 }
 
-func (m *EditProfileRequest) GetCustomData() string {
+func (m *MuteGroupMemberRequest) GetUserID() string {
 	if m != nil {
-		return m.CustomData
+		return m.UserID
 	}
 	return ""
 }
 
-func (m *EditProfileRequest) GetAvatarMediaId() string {
+func (m *MuteGroupMemberRequest) GetDuration() int64 {
 	if m != nil {
-		return m.AvatarMediaId
+		return m.Duration
 	}
-	return ""
+	return 0
 }
 
-type EditProfileResponse struct {
+type MuteGroupMemberResponse struct {
 	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message              string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ExpiredAt            int64    `protobuf:"varint,2,opt,name=expiredAt,proto3" json:"expiredAt,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *EditProfileResponse) Reset()         { *m = EditProfileResponse{} }
-func (m *EditProfileResponse) String() string { return proto.CompactTextString(m) }
-func (*EditProfileResponse) ProtoMessage()    {}
-func (*EditProfileResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{23}
+func (m *MuteGroupMemberResponse) Reset()         { *m = MuteGroupMemberResponse{} }
+func (m *MuteGroupMemberResponse) String() string { return proto.CompactTextString(m) }
+func (*MuteGroupMemberResponse) ProtoMessage()    {}
+func (*MuteGroupMemberResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{31}
 }
-func (m *EditProfileResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_EditProfileResponse.Unmarshal(m, b)
+func (m *MuteGroupMemberResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MuteGroupMemberResponse.Unmarshal(m, b)
 }
-func (m *EditProfileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_EditProfileResponse.Marshal(b, m, deterministic)
+func (m *MuteGroupMemberResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MuteGroupMemberResponse.Marshal(b, m, deterministic)
 }
-func (dst *EditProfileResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EditProfileResponse.Merge(dst, src)
+func (dst *MuteGroupMemberResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MuteGroupMemberResponse.Merge(dst, src)
 }
-func (m *EditProfileResponse) XXX_Size() int {
-	return xxx_messageInfo_EditProfileResponse.Size(m)
+func (m *MuteGroupMemberResponse) XXX_Size() int {
+	return xxx_messageInfo_MuteGroupMemberResponse.Size(m)
 }
-func (m *EditProfileResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_EditProfileResponse.DiscardUnknown(m)
+func (m *MuteGroupMemberResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MuteGroupMemberResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EditProfileResponse proto.InternalMessageInfo
+var xxx_messageInfo_MuteGroupMemberResponse proto.InternalMessageInfo
 
-func (m *EditProfileResponse) GetSuccess() bool {
+func (m *MuteGroupMemberResponse) GetSuccess() bool {
 	if m != nil {
 		return m.Success
 	}
 	return false
 }
 
-func (m *EditProfileResponse) GetMessage() string {
+func (m *MuteGroupMemberResponse) GetExpiredAt() int64 {
 	if m != nil {
-		return m.Message
+		return m.ExpiredAt
 	}
-	return ""
+	return 0
 }
 
-type GetProfileRequest struct {
-	UserID               string   `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+type UnmuteGroupMemberRequest struct {
+	GroupID              string   `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	UserID               string   `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetProfileRequest) Reset()         { *m = GetProfileRequest{} }
-func (m *GetProfileRequest) String() string { return proto.CompactTextString(m) }
-func (*GetProfileRequest) ProtoMessage()    {}
-func (*GetProfileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{24}
+func (m *UnmuteGroupMemberRequest) Reset()         { *m = UnmuteGroupMemberRequest{} }
+func (m *UnmuteGroupMemberRequest) String() string { return proto.CompactTextString(m) }
+func (*UnmuteGroupMemberRequest) ProtoMessage()    {}
+func (*UnmuteGroupMemberRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{32}
 }
-func (m *GetProfileRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetProfileRequest.Unmarshal(m, b)
+func (m *UnmuteGroupMemberRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnmuteGroupMemberRequest.Unmarshal(m, b)
 }
-func (m *GetProfileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetProfileRequest.Marshal(b, m, deterministic)
+func (m *UnmuteGroupMemberRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnmuteGroupMemberRequest.Marshal(b, m, deterministic)
 }
-func (dst *GetProfileRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetProfileRequest.Merge(dst, src)
+func (dst *UnmuteGroupMemberRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnmuteGroupMemberRequest.Merge(dst, src)
 }
-func (m *GetProfileRequest) XXX_Size() int {
-	return xxx_messageInfo_GetProfileRequest.Size(m)
+func (m *UnmuteGroupMemberRequest) XXX_Size() int {
+	return xxx_messageInfo_UnmuteGroupMemberRequest.Size(m)
 }
-func (m *GetProfileRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetProfileRequest.DiscardUnknown(m)
+func (m *UnmuteGroupMemberRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnmuteGroupMemberRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetProfileRequest proto.InternalMessageInfo
+var xxx_messageInfo_UnmuteGroupMemberRequest proto.InternalMessageInfo
 
-func (m *GetProfileRequest) GetUserID() string {
+func (m *UnmuteGroupMemberRequest) GetGroupID() string {
+	if m != nil {
+		return m.GroupID
+	}
+	return ""
+}
+
+func (m *UnmuteGroupMemberRequest) GetUserID() string {
 	if m != nil {
 		return m.UserID
 	}
 	return ""
 }
 
-type GetProfileResponse struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	UserName             string   `protobuf:"bytes,2,opt,name=userName,proto3" json:"userName,omitempty"`
-	PhoneNumber          string   `protobuf:"bytes,3,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
-	CustomData           string   `protobuf:"bytes,4,opt,name=customData,proto3" json:"customData,omitempty"`
-	AvatarMediaId        string   `protobuf:"bytes,5,opt,name=avatarMediaId,proto3" json:"avatarMediaId,omitempty"`
+type UnmuteGroupMemberResponse struct {
+	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetProfileResponse) Reset()         { *m = GetProfileResponse{} }
-func (m *GetProfileResponse) String() string { return proto.CompactTextString(m) }
-func (*GetProfileResponse) ProtoMessage()    {}
-func (*GetProfileResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{25}
+func (m *UnmuteGroupMemberResponse) Reset()         { *m = UnmuteGroupMemberResponse{} }
+func (m *UnmuteGroupMemberResponse) String() string { return proto.CompactTextString(m) }
+func (*UnmuteGroupMemberResponse) ProtoMessage()    {}
+func (*UnmuteGroupMemberResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{33}
 }
-func (m *GetProfileResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetProfileResponse.Unmarshal(m, b)
-}
-func (m *GetProfileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetProfileResponse.Marshal(b, m, deterministic)
-}
-func (dst *GetProfileResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetProfileResponse.Merge(dst, src)
-}
-func (m *GetProfileResponse) XXX_Size() int {
-	return xxx_messageInfo_GetProfileResponse.Size(m)
+func (m *UnmuteGroupMemberResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnmuteGroupMemberResponse.Unmarshal(m, b)
 }
-func (m *GetProfileResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetProfileResponse.DiscardUnknown(m)
+func (m *UnmuteGroupMemberResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnmuteGroupMemberResponse.Marshal(b, m, deterministic)
 }
-
-var xxx_messageInfo_GetProfileResponse proto.InternalMessageInfo
-
-func (m *GetProfileResponse) GetName() string {
-	if m != nil {
-		return m.Name
-	}
-	return ""
+func (dst *UnmuteGroupMemberResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnmuteGroupMemberResponse.Merge(dst, src)
 }
-
-func (m *GetProfileResponse) GetUserName() string {
-	if m != nil {
-		return m.UserName
-	}
-	return ""
+func (m *UnmuteGroupMemberResponse) XXX_Size() int {
+	return xxx_messageInfo_UnmuteGroupMemberResponse.Size(m)
 }
-
-func (m *GetProfileResponse) GetPhoneNumber() string {
-	if m != nil {
-		return m.PhoneNumber
-	}
-	return ""
+func (m *UnmuteGroupMemberResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnmuteGroupMemberResponse.DiscardUnknown(m)
 }
 
-func (m *GetProfileResponse) GetCustomData() string {
-	if m != nil {
-		return m.CustomData
-	}
-	return ""
-}
+var xxx_messageInfo_UnmuteGroupMemberResponse proto.InternalMessageInfo
 
-func (m *GetProfileResponse) GetAvatarMediaId() string {
+func (m *UnmuteGroupMemberResponse) GetSuccess() bool {
 	if m != nil {
-		return m.AvatarMediaId
+		return m.Success
 	}
-	return ""
+	return false
 }
 
-type ListConversationsRequest struct {
+type ListGroupMutesRequest struct {
+	GroupID              string   `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListConversationsRequest) Reset()         { *m = ListConversationsRequest{} }
-func (m *ListConversationsRequest) String() string { return proto.CompactTextString(m) }
-func (*ListConversationsRequest) ProtoMessage()    {}
-func (*ListConversationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{26}
+func (m *ListGroupMutesRequest) Reset()         { *m = ListGroupMutesRequest{} }
+func (m *ListGroupMutesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListGroupMutesRequest) ProtoMessage()    {}
+func (*ListGroupMutesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{34}
 }
-func (m *ListConversationsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListConversationsRequest.Unmarshal(m, b)
+func (m *ListGroupMutesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListGroupMutesRequest.Unmarshal(m, b)
 }
-func (m *ListConversationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListConversationsRequest.Marshal(b, m, deterministic)
+func (m *ListGroupMutesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListGroupMutesRequest.Marshal(b, m, deterministic)
 }
-func (dst *ListConversationsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListConversationsRequest.Merge(dst, src)
+func (dst *ListGroupMutesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListGroupMutesRequest.Merge(dst, src)
 }
-func (m *ListConversationsRequest) XXX_Size() int {
-	return xxx_messageInfo_ListConversationsRequest.Size(m)
+func (m *ListGroupMutesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListGroupMutesRequest.Size(m)
 }
-func (m *ListConversationsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListConversationsRequest.DiscardUnknown(m)
+func (m *ListGroupMutesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListGroupMutesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListConversationsRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListGroupMutesRequest proto.InternalMessageInfo
 
-type ListConversationsResponse struct {
-	List                 []*Conversations `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+func (m *ListGroupMutesRequest) GetGroupID() string {
+	if m != nil {
+		return m.GroupID
+	}
+	return ""
 }
 
-func (m *ListConversationsResponse) Reset()         { *m = ListConversationsResponse{} }
-func (m *ListConversationsResponse) String() string { return proto.CompactTextString(m) }
-func (*ListConversationsResponse) ProtoMessage()    {}
-func (*ListConversationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{27}
+type ListGroupMutesResponse struct {
+	List                 []*GroupRestriction `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
-func (m *ListConversationsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListConversationsResponse.Unmarshal(m, b)
+
+func (m *ListGroupMutesResponse) Reset()         { *m = ListGroupMutesResponse{} }
+func (m *ListGroupMutesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListGroupMutesResponse) ProtoMessage()    {}
+func (*ListGroupMutesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{35}
 }
-func (m *ListConversationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListConversationsResponse.Marshal(b, m, deterministic)
+func (m *ListGroupMutesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListGroupMutesResponse.Unmarshal(m, b)
 }
-func (dst *ListConversationsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListConversationsResponse.Merge(dst, src)
+func (m *ListGroupMutesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListGroupMutesResponse.Marshal(b, m, deterministic)
 }
-func (m *ListConversationsResponse) XXX_Size() int {
-	return xxx_messageInfo_ListConversationsResponse.Size(m)
+func (dst *ListGroupMutesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListGroupMutesResponse.Merge(dst, src)
 }
-func (m *ListConversationsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListConversationsResponse.DiscardUnknown(m)
+func (m *ListGroupMutesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListGroupMutesResponse.Size(m)
+}
+func (m *ListGroupMutesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListGroupMutesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListConversationsResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListGroupMutesResponse proto.InternalMessageInfo
 
-func (m *ListConversationsResponse) GetList() []*Conversations {
+func (m *ListGroupMutesResponse) GetList() []*GroupRestriction {
 	if m != nil {
 		return m.List
 	}
 	return nil
 }
 
-type Conversations struct {
-	ChatID               string   `protobuf:"bytes,1,opt,name=chatID,proto3" json:"chatID,omitempty"`
-	ChatName             string   `protobuf:"bytes,2,opt,name=chatName,proto3" json:"chatName,omitempty"`
-	Excerpt              string   `protobuf:"bytes,3,opt,name=excerpt,proto3" json:"excerpt,omitempty"`
-	Timestamp            int64    `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Notification         int64    `protobuf:"varint,5,opt,name=notification,proto3" json:"notification,omitempty"`
-	ChatType             int32    `protobuf:"varint,6,opt,name=chatType,proto3" json:"chatType,omitempty"`
-	IsGroupAdmin         bool     `protobuf:"varint,7,opt,name=isGroupAdmin,proto3" json:"isGroupAdmin,omitempty"`
-	Avatar               string   `protobuf:"bytes,8,opt,name=avatar,proto3" json:"avatar,omitempty"`
-	AvatarThumbnail      []byte   `protobuf:"bytes,9,opt,name=avatarThumbnail,proto3" json:"avatarThumbnail,omitempty"`
-	PhoneNumber          string   `protobuf:"bytes,10,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
-	UserName             string   `protobuf:"bytes,11,opt,name=userName,proto3" json:"userName,omitempty"`
-	CustomData           string   `protobuf:"bytes,12,opt,name=customData,proto3" json:"customData,omitempty"`
+type GroupRestriction struct {
+	UserID               string   `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	ByUserID             string   `protobuf:"bytes,2,opt,name=byUserID,proto3" json:"byUserID,omitempty"`
+	CreatedAt            int64    `protobuf:"varint,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	ExpiredAt            int64    `protobuf:"varint,4,opt,name=expiredAt,proto3" json:"expiredAt,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Conversations) Reset()         { *m = Conversations{} }
-func (m *Conversations) String() string { return proto.CompactTextString(m) }
-func (*Conversations) ProtoMessage()    {}
-func (*Conversations) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{28}
+func (m *GroupRestriction) Reset()         { *m = GroupRestriction{} }
+func (m *GroupRestriction) String() string { return proto.CompactTextString(m) }
+func (*GroupRestriction) ProtoMessage()    {}
+func (*GroupRestriction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{36}
 }
-func (m *Conversations) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Conversations.Unmarshal(m, b)
+func (m *GroupRestriction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GroupRestriction.Unmarshal(m, b)
 }
-func (m *Conversations) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Conversations.Marshal(b, m, deterministic)
+func (m *GroupRestriction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GroupRestriction.Marshal(b, m, deterministic)
 }
-func (dst *Conversations) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Conversations.Merge(dst, src)
+func (dst *GroupRestriction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupRestriction.Merge(dst, src)
 }
-func (m *Conversations) XXX_Size() int {
-	return xxx_messageInfo_Conversations.Size(m)
+func (m *GroupRestriction) XXX_Size() int {
+	return xxx_messageInfo_GroupRestriction.Size(m)
 }
-func (m *Conversations) XXX_DiscardUnknown() {
-	xxx_messageInfo_Conversations.DiscardUnknown(m)
+func (m *GroupRestriction) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupRestriction.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Conversations proto.InternalMessageInfo
-
-func (m *Conversations) GetChatID() string {
-	if m != nil {
-		return m.ChatID
-	}
-	return ""
-}
+var xxx_messageInfo_GroupRestriction proto.InternalMessageInfo
 
-func (m *Conversations) GetChatName() string {
+func (m *GroupRestriction) GetUserID() string {
 	if m != nil {
-		return m.ChatName
+		return m.UserID
 	}
 	return ""
 }
 
-func (m *Conversations) GetExcerpt() string {
+func (m *GroupRestriction) GetByUserID() string {
 	if m != nil {
-		return m.Excerpt
+		return m.ByUserID
 	}
 	return ""
 }
 
-func (m *Conversations) GetTimestamp() int64 {
+func (m *GroupRestriction) GetCreatedAt() int64 {
 	if m != nil {
-		return m.Timestamp
+		return m.CreatedAt
 	}
 	return 0
 }
 
-func (m *Conversations) GetNotification() int64 {
+func (m *GroupRestriction) GetExpiredAt() int64 {
 	if m != nil {
-		return m.Notification
+		return m.ExpiredAt
 	}
 	return 0
 }
 
-func (m *Conversations) GetChatType() int32 {
-	if m != nil {
-		return m.ChatType
-	}
-	return 0
+type ListGroupParticipantsRequest struct {
+	GroupID              string   `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Conversations) GetIsGroupAdmin() bool {
-	if m != nil {
-		return m.IsGroupAdmin
-	}
-	return false
+func (m *ListGroupParticipantsRequest) Reset()         { *m = ListGroupParticipantsRequest{} }
+func (m *ListGroupParticipantsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListGroupParticipantsRequest) ProtoMessage()    {}
+func (*ListGroupParticipantsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{37}
+}
+func (m *ListGroupParticipantsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListGroupParticipantsRequest.Unmarshal(m, b)
+}
+func (m *ListGroupParticipantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListGroupParticipantsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListGroupParticipantsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListGroupParticipantsRequest.Merge(dst, src)
+}
+func (m *ListGroupParticipantsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListGroupParticipantsRequest.Size(m)
+}
+func (m *ListGroupParticipantsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListGroupParticipantsRequest.DiscardUnknown(m)
 }
 
-func (m *Conversations) GetAvatar() string {
+var xxx_messageInfo_ListGroupParticipantsRequest proto.InternalMessageInfo
+
+func (m *ListGroupParticipantsRequest) GetGroupID() string {
 	if m != nil {
-		return m.Avatar
+		return m.GroupID
 	}
 	return ""
 }
 
-func (m *Conversations) GetAvatarThumbnail() []byte {
+type ListGroupParticipantsResponse struct {
+	Participants         []*GroupParticipant `protobuf:"bytes,1,rep,name=participants,proto3" json:"participants,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ListGroupParticipantsResponse) Reset()         { *m = ListGroupParticipantsResponse{} }
+func (m *ListGroupParticipantsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListGroupParticipantsResponse) ProtoMessage()    {}
+func (*ListGroupParticipantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{38}
+}
+func (m *ListGroupParticipantsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListGroupParticipantsResponse.Unmarshal(m, b)
+}
+func (m *ListGroupParticipantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListGroupParticipantsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListGroupParticipantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListGroupParticipantsResponse.Merge(dst, src)
+}
+func (m *ListGroupParticipantsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListGroupParticipantsResponse.Size(m)
+}
+func (m *ListGroupParticipantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListGroupParticipantsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListGroupParticipantsResponse proto.InternalMessageInfo
+
+func (m *ListGroupParticipantsResponse) GetParticipants() []*GroupParticipant {
 	if m != nil {
-		return m.AvatarThumbnail
+		return m.Participants
 	}
 	return nil
 }
 
-func (m *Conversations) GetPhoneNumber() string {
+type VerifyOTPRequest struct {
+	PhoneNumber          string   `protobuf:"bytes,1,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
+	OTP                  string   `protobuf:"bytes,2,opt,name=OTP,proto3" json:"OTP,omitempty"`
+	DeviceID             string   `protobuf:"bytes,3,opt,name=deviceID,proto3" json:"deviceID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyOTPRequest) Reset()         { *m = VerifyOTPRequest{} }
+func (m *VerifyOTPRequest) String() string { return proto.CompactTextString(m) }
+func (*VerifyOTPRequest) ProtoMessage()    {}
+func (*VerifyOTPRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{39}
+}
+func (m *VerifyOTPRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyOTPRequest.Unmarshal(m, b)
+}
+func (m *VerifyOTPRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyOTPRequest.Marshal(b, m, deterministic)
+}
+func (dst *VerifyOTPRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyOTPRequest.Merge(dst, src)
+}
+func (m *VerifyOTPRequest) XXX_Size() int {
+	return xxx_messageInfo_VerifyOTPRequest.Size(m)
+}
+func (m *VerifyOTPRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyOTPRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyOTPRequest proto.InternalMessageInfo
+
+func (m *VerifyOTPRequest) GetPhoneNumber() string {
 	if m != nil {
 		return m.PhoneNumber
 	}
 	return ""
 }
 
-func (m *Conversations) GetUserName() string {
+func (m *VerifyOTPRequest) GetOTP() string {
 	if m != nil {
-		return m.UserName
+		return m.OTP
 	}
 	return ""
 }
 
-func (m *Conversations) GetCustomData() string {
+func (m *VerifyOTPRequest) GetDeviceID() string {
 	if m != nil {
-		return m.CustomData
+		return m.DeviceID
 	}
 	return ""
 }
 
-type UpdateConversationRequest struct {
-	ChatID               string   `protobuf:"bytes,1,opt,name=chatID,proto3" json:"chatID,omitempty"`
-	Excerpt              string   `protobuf:"bytes,2,opt,name=excerpt,proto3" json:"excerpt,omitempty"`
-	Timestamp            int64    `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+type VerifyOTPResponse struct {
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken         string   `protobuf:"bytes,2,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	ExpiredAt            int64    `protobuf:"varint,3,opt,name=expiredAt,proto3" json:"expiredAt,omitempty"`
+	PinRequired          bool     `protobuf:"varint,4,opt,name=pinRequired,proto3" json:"pinRequired,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpdateConversationRequest) Reset()         { *m = UpdateConversationRequest{} }
-func (m *UpdateConversationRequest) String() string { return proto.CompactTextString(m) }
-func (*UpdateConversationRequest) ProtoMessage()    {}
-func (*UpdateConversationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{29}
+func (m *VerifyOTPResponse) Reset()         { *m = VerifyOTPResponse{} }
+func (m *VerifyOTPResponse) String() string { return proto.CompactTextString(m) }
+func (*VerifyOTPResponse) ProtoMessage()    {}
+func (*VerifyOTPResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{40}
 }
-func (m *UpdateConversationRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdateConversationRequest.Unmarshal(m, b)
+func (m *VerifyOTPResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyOTPResponse.Unmarshal(m, b)
 }
-func (m *UpdateConversationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdateConversationRequest.Marshal(b, m, deterministic)
+func (m *VerifyOTPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyOTPResponse.Marshal(b, m, deterministic)
 }
-func (dst *UpdateConversationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateConversationRequest.Merge(dst, src)
+func (dst *VerifyOTPResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyOTPResponse.Merge(dst, src)
 }
-func (m *UpdateConversationRequest) XXX_Size() int {
-	return xxx_messageInfo_UpdateConversationRequest.Size(m)
+func (m *VerifyOTPResponse) XXX_Size() int {
+	return xxx_messageInfo_VerifyOTPResponse.Size(m)
 }
-func (m *UpdateConversationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateConversationRequest.DiscardUnknown(m)
+func (m *VerifyOTPResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyOTPResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateConversationRequest proto.InternalMessageInfo
+var xxx_messageInfo_VerifyOTPResponse proto.InternalMessageInfo
 
-func (m *UpdateConversationRequest) GetChatID() string {
+func (m *VerifyOTPResponse) GetToken() string {
 	if m != nil {
-		return m.ChatID
+		return m.Token
 	}
 	return ""
 }
 
-func (m *UpdateConversationRequest) GetExcerpt() string {
+func (m *VerifyOTPResponse) GetRefreshToken() string {
 	if m != nil {
-		return m.Excerpt
+		return m.RefreshToken
 	}
 	return ""
 }
 
-func (m *UpdateConversationRequest) GetTimestamp() int64 {
+func (m *VerifyOTPResponse) GetExpiredAt() int64 {
 	if m != nil {
-		return m.Timestamp
+		return m.ExpiredAt
 	}
 	return 0
 }
 
-type UpdateConversationResponse struct {
-	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message              string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+func (m *VerifyOTPResponse) GetPinRequired() bool {
+	if m != nil {
+		return m.PinRequired
+	}
+	return false
+}
+
+type RequestDeviceLinkRequest struct {
+	DeviceID             string   `protobuf:"bytes,1,opt,name=deviceID,proto3" json:"deviceID,omitempty"`
+	DeviceName           string   `protobuf:"bytes,2,opt,name=deviceName,proto3" json:"deviceName,omitempty"`
+	Platform             string   `protobuf:"bytes,3,opt,name=platform,proto3" json:"platform,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpdateConversationResponse) Reset()         { *m = UpdateConversationResponse{} }
-func (m *UpdateConversationResponse) String() string { return proto.CompactTextString(m) }
-func (*UpdateConversationResponse) ProtoMessage()    {}
-func (*UpdateConversationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{30}
+func (m *RequestDeviceLinkRequest) Reset()         { *m = RequestDeviceLinkRequest{} }
+func (m *RequestDeviceLinkRequest) String() string { return proto.CompactTextString(m) }
+func (*RequestDeviceLinkRequest) ProtoMessage()    {}
+func (*RequestDeviceLinkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{41}
 }
-func (m *UpdateConversationResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdateConversationResponse.Unmarshal(m, b)
+func (m *RequestDeviceLinkRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RequestDeviceLinkRequest.Unmarshal(m, b)
 }
-func (m *UpdateConversationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdateConversationResponse.Marshal(b, m, deterministic)
+func (m *RequestDeviceLinkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RequestDeviceLinkRequest.Marshal(b, m, deterministic)
 }
-func (dst *UpdateConversationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateConversationResponse.Merge(dst, src)
+func (dst *RequestDeviceLinkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestDeviceLinkRequest.Merge(dst, src)
 }
-func (m *UpdateConversationResponse) XXX_Size() int {
-	return xxx_messageInfo_UpdateConversationResponse.Size(m)
+func (m *RequestDeviceLinkRequest) XXX_Size() int {
+	return xxx_messageInfo_RequestDeviceLinkRequest.Size(m)
 }
-func (m *UpdateConversationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateConversationResponse.DiscardUnknown(m)
+func (m *RequestDeviceLinkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestDeviceLinkRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateConversationResponse proto.InternalMessageInfo
+var xxx_messageInfo_RequestDeviceLinkRequest proto.InternalMessageInfo
 
-func (m *UpdateConversationResponse) GetSuccess() bool {
+func (m *RequestDeviceLinkRequest) GetDeviceID() string {
 	if m != nil {
-		return m.Success
+		return m.DeviceID
 	}
-	return false
+	return ""
 }
 
-func (m *UpdateConversationResponse) GetMessage() string {
+func (m *RequestDeviceLinkRequest) GetDeviceName() string {
 	if m != nil {
-		return m.Message
+		return m.DeviceName
 	}
 	return ""
 }
 
-type DeleteContactRequest struct {
-	// userID @ metadata
-	UserID               string   `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+func (m *RequestDeviceLinkRequest) GetPlatform() string {
+	if m != nil {
+		return m.Platform
+	}
+	return ""
+}
+
+type RequestDeviceLinkResponse struct {
+	LinkCode             string   `protobuf:"bytes,1,opt,name=linkCode,proto3" json:"linkCode,omitempty"`
+	LinkSecret           string   `protobuf:"bytes,2,opt,name=linkSecret,proto3" json:"linkSecret,omitempty"`
+	QrPayload            string   `protobuf:"bytes,3,opt,name=qrPayload,proto3" json:"qrPayload,omitempty"`
+	ExpiredAt            int64    `protobuf:"varint,4,opt,name=expiredAt,proto3" json:"expiredAt,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteContactRequest) Reset()         { *m = DeleteContactRequest{} }
-func (m *DeleteContactRequest) String() string { return proto.CompactTextString(m) }
-func (*DeleteContactRequest) ProtoMessage()    {}
-func (*DeleteContactRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{31}
+func (m *RequestDeviceLinkResponse) Reset()         { *m = RequestDeviceLinkResponse{} }
+func (m *RequestDeviceLinkResponse) String() string { return proto.CompactTextString(m) }
+func (*RequestDeviceLinkResponse) ProtoMessage()    {}
+func (*RequestDeviceLinkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{42}
 }
-func (m *DeleteContactRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteContactRequest.Unmarshal(m, b)
+func (m *RequestDeviceLinkResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RequestDeviceLinkResponse.Unmarshal(m, b)
 }
-func (m *DeleteContactRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteContactRequest.Marshal(b, m, deterministic)
+func (m *RequestDeviceLinkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RequestDeviceLinkResponse.Marshal(b, m, deterministic)
 }
-func (dst *DeleteContactRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteContactRequest.Merge(dst, src)
+func (dst *RequestDeviceLinkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestDeviceLinkResponse.Merge(dst, src)
 }
-func (m *DeleteContactRequest) XXX_Size() int {
-	return xxx_messageInfo_DeleteContactRequest.Size(m)
+func (m *RequestDeviceLinkResponse) XXX_Size() int {
+	return xxx_messageInfo_RequestDeviceLinkResponse.Size(m)
 }
-func (m *DeleteContactRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteContactRequest.DiscardUnknown(m)
+func (m *RequestDeviceLinkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestDeviceLinkResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteContactRequest proto.InternalMessageInfo
+var xxx_messageInfo_RequestDeviceLinkResponse proto.InternalMessageInfo
 
-func (m *DeleteContactRequest) GetUserID() string {
+func (m *RequestDeviceLinkResponse) GetLinkCode() string {
 	if m != nil {
-		return m.UserID
+		return m.LinkCode
 	}
 	return ""
 }
 
-type DeleteContactResponse struct {
-	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message              string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *DeleteContactResponse) Reset()         { *m = DeleteContactResponse{} }
-func (m *DeleteContactResponse) String() string { return proto.CompactTextString(m) }
-func (*DeleteContactResponse) ProtoMessage()    {}
-func (*DeleteContactResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{32}
-}
-func (m *DeleteContactResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteContactResponse.Unmarshal(m, b)
-}
-func (m *DeleteContactResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteContactResponse.Marshal(b, m, deterministic)
-}
-func (dst *DeleteContactResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteContactResponse.Merge(dst, src)
-}
-func (m *DeleteContactResponse) XXX_Size() int {
-	return xxx_messageInfo_DeleteContactResponse.Size(m)
-}
-func (m *DeleteContactResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteContactResponse.DiscardUnknown(m)
+func (m *RequestDeviceLinkResponse) GetLinkSecret() string {
+	if m != nil {
+		return m.LinkSecret
+	}
+	return ""
 }
 
-var xxx_messageInfo_DeleteContactResponse proto.InternalMessageInfo
-
-func (m *DeleteContactResponse) GetSuccess() bool {
+func (m *RequestDeviceLinkResponse) GetQrPayload() string {
 	if m != nil {
-		return m.Success
+		return m.QrPayload
 	}
-	return false
+	return ""
 }
 
-func (m *DeleteContactResponse) GetMessage() string {
+func (m *RequestDeviceLinkResponse) GetExpiredAt() int64 {
 	if m != nil {
-		return m.Message
+		return m.ExpiredAt
 	}
-	return ""
+	return 0
 }
 
-type GetContactsRequest struct {
+type ApproveDeviceLinkRequest struct {
+	LinkCode             string   `protobuf:"bytes,1,opt,name=linkCode,proto3" json:"linkCode,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetContactsRequest) Reset()         { *m = GetContactsRequest{} }
-func (m *GetContactsRequest) String() string { return proto.CompactTextString(m) }
-func (*GetContactsRequest) ProtoMessage()    {}
-func (*GetContactsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{33}
+func (m *ApproveDeviceLinkRequest) Reset()         { *m = ApproveDeviceLinkRequest{} }
+func (m *ApproveDeviceLinkRequest) String() string { return proto.CompactTextString(m) }
+func (*ApproveDeviceLinkRequest) ProtoMessage()    {}
+func (*ApproveDeviceLinkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{43}
 }
-func (m *GetContactsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetContactsRequest.Unmarshal(m, b)
+func (m *ApproveDeviceLinkRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApproveDeviceLinkRequest.Unmarshal(m, b)
 }
-func (m *GetContactsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetContactsRequest.Marshal(b, m, deterministic)
+func (m *ApproveDeviceLinkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApproveDeviceLinkRequest.Marshal(b, m, deterministic)
 }
-func (dst *GetContactsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetContactsRequest.Merge(dst, src)
+func (dst *ApproveDeviceLinkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApproveDeviceLinkRequest.Merge(dst, src)
 }
-func (m *GetContactsRequest) XXX_Size() int {
-	return xxx_messageInfo_GetContactsRequest.Size(m)
+func (m *ApproveDeviceLinkRequest) XXX_Size() int {
+	return xxx_messageInfo_ApproveDeviceLinkRequest.Size(m)
 }
-func (m *GetContactsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetContactsRequest.DiscardUnknown(m)
+func (m *ApproveDeviceLinkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApproveDeviceLinkRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetContactsRequest proto.InternalMessageInfo
+var xxx_messageInfo_ApproveDeviceLinkRequest proto.InternalMessageInfo
 
-type GetContactsResponse struct {
-	List                 []*Contacts `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+func (m *ApproveDeviceLinkRequest) GetLinkCode() string {
+	if m != nil {
+		return m.LinkCode
+	}
+	return ""
 }
 
-func (m *GetContactsResponse) Reset()         { *m = GetContactsResponse{} }
-func (m *GetContactsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetContactsResponse) ProtoMessage()    {}
-func (*GetContactsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{34}
+type ApproveDeviceLinkResponse struct {
+	DeviceID             string   `protobuf:"bytes,1,opt,name=deviceID,proto3" json:"deviceID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *GetContactsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetContactsResponse.Unmarshal(m, b)
+
+func (m *ApproveDeviceLinkResponse) Reset()         { *m = ApproveDeviceLinkResponse{} }
+func (m *ApproveDeviceLinkResponse) String() string { return proto.CompactTextString(m) }
+func (*ApproveDeviceLinkResponse) ProtoMessage()    {}
+func (*ApproveDeviceLinkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{44}
 }
-func (m *GetContactsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetContactsResponse.Marshal(b, m, deterministic)
+func (m *ApproveDeviceLinkResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApproveDeviceLinkResponse.Unmarshal(m, b)
 }
-func (dst *GetContactsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetContactsResponse.Merge(dst, src)
+func (m *ApproveDeviceLinkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApproveDeviceLinkResponse.Marshal(b, m, deterministic)
 }
-func (m *GetContactsResponse) XXX_Size() int {
-	return xxx_messageInfo_GetContactsResponse.Size(m)
+func (dst *ApproveDeviceLinkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApproveDeviceLinkResponse.Merge(dst, src)
 }
-func (m *GetContactsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetContactsResponse.DiscardUnknown(m)
+func (m *ApproveDeviceLinkResponse) XXX_Size() int {
+	return xxx_messageInfo_ApproveDeviceLinkResponse.Size(m)
+}
+func (m *ApproveDeviceLinkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApproveDeviceLinkResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetContactsResponse proto.InternalMessageInfo
+var xxx_messageInfo_ApproveDeviceLinkResponse proto.InternalMessageInfo
 
-func (m *GetContactsResponse) GetList() []*Contacts {
+func (m *ApproveDeviceLinkResponse) GetDeviceID() string {
 	if m != nil {
-		return m.List
+		return m.DeviceID
 	}
-	return nil
+	return ""
 }
 
-type Contacts struct {
-	PeerID               string   `protobuf:"bytes,1,opt,name=peerID,proto3" json:"peerID,omitempty"`
-	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Notification         int64    `protobuf:"varint,3,opt,name=notification,proto3" json:"notification,omitempty"`
-	AvatarThumbnail      []byte   `protobuf:"bytes,4,opt,name=avatar_thumbnail,json=avatarThumbnail,proto3" json:"avatar_thumbnail,omitempty"`
-	PhoneNumber          string   `protobuf:"bytes,5,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
-	UserName             string   `protobuf:"bytes,6,opt,name=userName,proto3" json:"userName,omitempty"`
-	CustomData           string   `protobuf:"bytes,7,opt,name=customData,proto3" json:"customData,omitempty"`
+type CompleteDeviceLinkRequest struct {
+	LinkCode             string   `protobuf:"bytes,1,opt,name=linkCode,proto3" json:"linkCode,omitempty"`
+	LinkSecret           string   `protobuf:"bytes,2,opt,name=linkSecret,proto3" json:"linkSecret,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Contacts) Reset()         { *m = Contacts{} }
-func (m *Contacts) String() string { return proto.CompactTextString(m) }
-func (*Contacts) ProtoMessage()    {}
-func (*Contacts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{35}
+func (m *CompleteDeviceLinkRequest) Reset()         { *m = CompleteDeviceLinkRequest{} }
+func (m *CompleteDeviceLinkRequest) String() string { return proto.CompactTextString(m) }
+func (*CompleteDeviceLinkRequest) ProtoMessage()    {}
+func (*CompleteDeviceLinkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{45}
 }
-func (m *Contacts) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Contacts.Unmarshal(m, b)
+func (m *CompleteDeviceLinkRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompleteDeviceLinkRequest.Unmarshal(m, b)
 }
-func (m *Contacts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Contacts.Marshal(b, m, deterministic)
+func (m *CompleteDeviceLinkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompleteDeviceLinkRequest.Marshal(b, m, deterministic)
 }
-func (dst *Contacts) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Contacts.Merge(dst, src)
+func (dst *CompleteDeviceLinkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompleteDeviceLinkRequest.Merge(dst, src)
 }
-func (m *Contacts) XXX_Size() int {
-	return xxx_messageInfo_Contacts.Size(m)
+func (m *CompleteDeviceLinkRequest) XXX_Size() int {
+	return xxx_messageInfo_CompleteDeviceLinkRequest.Size(m)
 }
-func (m *Contacts) XXX_DiscardUnknown() {
-	xxx_messageInfo_Contacts.DiscardUnknown(m)
+func (m *CompleteDeviceLinkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompleteDeviceLinkRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Contacts proto.InternalMessageInfo
+var xxx_messageInfo_CompleteDeviceLinkRequest proto.InternalMessageInfo
 
-func (m *Contacts) GetPeerID() string {
+func (m *CompleteDeviceLinkRequest) GetLinkCode() string {
 	if m != nil {
-		return m.PeerID
+		return m.LinkCode
 	}
 	return ""
 }
 
-func (m *Contacts) GetName() string {
+func (m *CompleteDeviceLinkRequest) GetLinkSecret() string {
 	if m != nil {
-		return m.Name
+		return m.LinkSecret
 	}
 	return ""
 }
 
-func (m *Contacts) GetNotification() int64 {
-	if m != nil {
-		return m.Notification
-	}
-	return 0
+type CompleteDeviceLinkResponse struct {
+	UserID               string   `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Token                string   `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken         string   `protobuf:"bytes,3,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	ExpiredAt            int64    `protobuf:"varint,4,opt,name=expiredAt,proto3" json:"expiredAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Contacts) GetAvatarThumbnail() []byte {
+func (m *CompleteDeviceLinkResponse) Reset()         { *m = CompleteDeviceLinkResponse{} }
+func (m *CompleteDeviceLinkResponse) String() string { return proto.CompactTextString(m) }
+func (*CompleteDeviceLinkResponse) ProtoMessage()    {}
+func (*CompleteDeviceLinkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{46}
+}
+func (m *CompleteDeviceLinkResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompleteDeviceLinkResponse.Unmarshal(m, b)
+}
+func (m *CompleteDeviceLinkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompleteDeviceLinkResponse.Marshal(b, m, deterministic)
+}
+func (dst *CompleteDeviceLinkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompleteDeviceLinkResponse.Merge(dst, src)
+}
+func (m *CompleteDeviceLinkResponse) XXX_Size() int {
+	return xxx_messageInfo_CompleteDeviceLinkResponse.Size(m)
+}
+func (m *CompleteDeviceLinkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompleteDeviceLinkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompleteDeviceLinkResponse proto.InternalMessageInfo
+
+func (m *CompleteDeviceLinkResponse) GetUserID() string {
 	if m != nil {
-		return m.AvatarThumbnail
+		return m.UserID
 	}
-	return nil
+	return ""
 }
 
-func (m *Contacts) GetPhoneNumber() string {
+func (m *CompleteDeviceLinkResponse) GetToken() string {
 	if m != nil {
-		return m.PhoneNumber
+		return m.Token
 	}
 	return ""
 }
 
-func (m *Contacts) GetUserName() string {
+func (m *CompleteDeviceLinkResponse) GetRefreshToken() string {
 	if m != nil {
-		return m.UserName
+		return m.RefreshToken
 	}
 	return ""
 }
 
-func (m *Contacts) GetCustomData() string {
+func (m *CompleteDeviceLinkResponse) GetExpiredAt() int64 {
 	if m != nil {
-		return m.CustomData
+		return m.ExpiredAt
 	}
-	return ""
+	return 0
 }
 
-type PutContactRequest struct {
-	PhoneNumber          string    `protobuf:"bytes,1,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
-	ContactData          *Contacts `protobuf:"bytes,2,opt,name=contactData,proto3" json:"contactData,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+type ListDevicesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PutContactRequest) Reset()         { *m = PutContactRequest{} }
-func (m *PutContactRequest) String() string { return proto.CompactTextString(m) }
-func (*PutContactRequest) ProtoMessage()    {}
-func (*PutContactRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{36}
+func (m *ListDevicesRequest) Reset()         { *m = ListDevicesRequest{} }
+func (m *ListDevicesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDevicesRequest) ProtoMessage()    {}
+func (*ListDevicesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{47}
 }
-func (m *PutContactRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PutContactRequest.Unmarshal(m, b)
+func (m *ListDevicesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDevicesRequest.Unmarshal(m, b)
 }
-func (m *PutContactRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PutContactRequest.Marshal(b, m, deterministic)
+func (m *ListDevicesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDevicesRequest.Marshal(b, m, deterministic)
 }
-func (dst *PutContactRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PutContactRequest.Merge(dst, src)
+func (dst *ListDevicesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDevicesRequest.Merge(dst, src)
 }
-func (m *PutContactRequest) XXX_Size() int {
-	return xxx_messageInfo_PutContactRequest.Size(m)
+func (m *ListDevicesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListDevicesRequest.Size(m)
 }
-func (m *PutContactRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PutContactRequest.DiscardUnknown(m)
+func (m *ListDevicesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDevicesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PutContactRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListDevicesRequest proto.InternalMessageInfo
 
-func (m *PutContactRequest) GetPhoneNumber() string {
-	if m != nil {
-		return m.PhoneNumber
-	}
-	return ""
+type ListDevicesResponse struct {
+	List                 []*Device `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-func (m *PutContactRequest) GetContactData() *Contacts {
+func (m *ListDevicesResponse) Reset()         { *m = ListDevicesResponse{} }
+func (m *ListDevicesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListDevicesResponse) ProtoMessage()    {}
+func (*ListDevicesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{48}
+}
+func (m *ListDevicesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDevicesResponse.Unmarshal(m, b)
+}
+func (m *ListDevicesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDevicesResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListDevicesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDevicesResponse.Merge(dst, src)
+}
+func (m *ListDevicesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListDevicesResponse.Size(m)
+}
+func (m *ListDevicesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDevicesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDevicesResponse proto.InternalMessageInfo
+
+func (m *ListDevicesResponse) GetList() []*Device {
 	if m != nil {
-		return m.ContactData
+		return m.List
 	}
 	return nil
 }
 
-type PutContactResponse struct {
-	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message              string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+type Device struct {
+	DeviceID             string   `protobuf:"bytes,1,opt,name=deviceID,proto3" json:"deviceID,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Platform             string   `protobuf:"bytes,3,opt,name=platform,proto3" json:"platform,omitempty"`
+	IsPrimary            bool     `protobuf:"varint,4,opt,name=isPrimary,proto3" json:"isPrimary,omitempty"`
+	IsCurrent            bool     `protobuf:"varint,5,opt,name=isCurrent,proto3" json:"isCurrent,omitempty"`
+	CreatedAt            int64    `protobuf:"varint,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	LastActiveAt         int64    `protobuf:"varint,7,opt,name=lastActiveAt,proto3" json:"lastActiveAt,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PutContactResponse) Reset()         { *m = PutContactResponse{} }
-func (m *PutContactResponse) String() string { return proto.CompactTextString(m) }
-func (*PutContactResponse) ProtoMessage()    {}
-func (*PutContactResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{37}
+func (m *Device) Reset()         { *m = Device{} }
+func (m *Device) String() string { return proto.CompactTextString(m) }
+func (*Device) ProtoMessage()    {}
+func (*Device) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{49}
 }
-func (m *PutContactResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PutContactResponse.Unmarshal(m, b)
+func (m *Device) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Device.Unmarshal(m, b)
 }
-func (m *PutContactResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PutContactResponse.Marshal(b, m, deterministic)
+func (m *Device) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Device.Marshal(b, m, deterministic)
 }
-func (dst *PutContactResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PutContactResponse.Merge(dst, src)
+func (dst *Device) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Device.Merge(dst, src)
 }
-func (m *PutContactResponse) XXX_Size() int {
-	return xxx_messageInfo_PutContactResponse.Size(m)
+func (m *Device) XXX_Size() int {
+	return xxx_messageInfo_Device.Size(m)
 }
-func (m *PutContactResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PutContactResponse.DiscardUnknown(m)
+func (m *Device) XXX_DiscardUnknown() {
+	xxx_messageInfo_Device.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PutContactResponse proto.InternalMessageInfo
+var xxx_messageInfo_Device proto.InternalMessageInfo
 
-func (m *PutContactResponse) GetSuccess() bool {
+func (m *Device) GetDeviceID() string {
 	if m != nil {
-		return m.Success
+		return m.DeviceID
 	}
-	return false
+	return ""
 }
 
-func (m *PutContactResponse) GetMessage() string {
+func (m *Device) GetName() string {
 	if m != nil {
-		return m.Message
+		return m.Name
 	}
 	return ""
 }
 
-type GetMessageReceptionStateRequest struct {
-	UserID               string   `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	MessageID            string   `protobuf:"bytes,2,opt,name=messageID,proto3" json:"messageID,omitempty"`
+func (m *Device) GetPlatform() string {
+	if m != nil {
+		return m.Platform
+	}
+	return ""
+}
+
+func (m *Device) GetIsPrimary() bool {
+	if m != nil {
+		return m.IsPrimary
+	}
+	return false
+}
+
+func (m *Device) GetIsCurrent() bool {
+	if m != nil {
+		return m.IsCurrent
+	}
+	return false
+}
+
+func (m *Device) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *Device) GetLastActiveAt() int64 {
+	if m != nil {
+		return m.LastActiveAt
+	}
+	return 0
+}
+
+type RenameDeviceRequest struct {
+	DeviceID             string   `protobuf:"bytes,1,opt,name=deviceID,proto3" json:"deviceID,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetMessageReceptionStateRequest) Reset()         { *m = GetMessageReceptionStateRequest{} }
-func (m *GetMessageReceptionStateRequest) String() string { return proto.CompactTextString(m) }
-func (*GetMessageReceptionStateRequest) ProtoMessage()    {}
-func (*GetMessageReceptionStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{38}
+func (m *RenameDeviceRequest) Reset()         { *m = RenameDeviceRequest{} }
+func (m *RenameDeviceRequest) String() string { return proto.CompactTextString(m) }
+func (*RenameDeviceRequest) ProtoMessage()    {}
+func (*RenameDeviceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{50}
 }
-func (m *GetMessageReceptionStateRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetMessageReceptionStateRequest.Unmarshal(m, b)
+func (m *RenameDeviceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RenameDeviceRequest.Unmarshal(m, b)
 }
-func (m *GetMessageReceptionStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetMessageReceptionStateRequest.Marshal(b, m, deterministic)
+func (m *RenameDeviceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RenameDeviceRequest.Marshal(b, m, deterministic)
 }
-func (dst *GetMessageReceptionStateRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetMessageReceptionStateRequest.Merge(dst, src)
+func (dst *RenameDeviceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenameDeviceRequest.Merge(dst, src)
 }
-func (m *GetMessageReceptionStateRequest) XXX_Size() int {
-	return xxx_messageInfo_GetMessageReceptionStateRequest.Size(m)
+func (m *RenameDeviceRequest) XXX_Size() int {
+	return xxx_messageInfo_RenameDeviceRequest.Size(m)
 }
-func (m *GetMessageReceptionStateRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetMessageReceptionStateRequest.DiscardUnknown(m)
+func (m *RenameDeviceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenameDeviceRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetMessageReceptionStateRequest proto.InternalMessageInfo
+var xxx_messageInfo_RenameDeviceRequest proto.InternalMessageInfo
 
-func (m *GetMessageReceptionStateRequest) GetUserID() string {
+func (m *RenameDeviceRequest) GetDeviceID() string {
 	if m != nil {
-		return m.UserID
+		return m.DeviceID
 	}
 	return ""
 }
 
-func (m *GetMessageReceptionStateRequest) GetMessageID() string {
+func (m *RenameDeviceRequest) GetName() string {
 	if m != nil {
-		return m.MessageID
+		return m.Name
 	}
 	return ""
 }
 
-type GetMessageReceptionStateResponse struct {
-	Status               MessageReceptionState `protobuf:"varint,1,opt,name=status,proto3,enum=MessageReceptionState" json:"status,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+type RenameDeviceResponse struct {
+	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetMessageReceptionStateResponse) Reset()         { *m = GetMessageReceptionStateResponse{} }
-func (m *GetMessageReceptionStateResponse) String() string { return proto.CompactTextString(m) }
-func (*GetMessageReceptionStateResponse) ProtoMessage()    {}
-func (*GetMessageReceptionStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{39}
+func (m *RenameDeviceResponse) Reset()         { *m = RenameDeviceResponse{} }
+func (m *RenameDeviceResponse) String() string { return proto.CompactTextString(m) }
+func (*RenameDeviceResponse) ProtoMessage()    {}
+func (*RenameDeviceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{51}
 }
-func (m *GetMessageReceptionStateResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetMessageReceptionStateResponse.Unmarshal(m, b)
+func (m *RenameDeviceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RenameDeviceResponse.Unmarshal(m, b)
 }
-func (m *GetMessageReceptionStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetMessageReceptionStateResponse.Marshal(b, m, deterministic)
+func (m *RenameDeviceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RenameDeviceResponse.Marshal(b, m, deterministic)
 }
-func (dst *GetMessageReceptionStateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetMessageReceptionStateResponse.Merge(dst, src)
+func (dst *RenameDeviceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenameDeviceResponse.Merge(dst, src)
 }
-func (m *GetMessageReceptionStateResponse) XXX_Size() int {
-	return xxx_messageInfo_GetMessageReceptionStateResponse.Size(m)
+func (m *RenameDeviceResponse) XXX_Size() int {
+	return xxx_messageInfo_RenameDeviceResponse.Size(m)
 }
-func (m *GetMessageReceptionStateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetMessageReceptionStateResponse.DiscardUnknown(m)
+func (m *RenameDeviceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenameDeviceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetMessageReceptionStateResponse proto.InternalMessageInfo
+var xxx_messageInfo_RenameDeviceResponse proto.InternalMessageInfo
 
-func (m *GetMessageReceptionStateResponse) GetStatus() MessageReceptionState {
+func (m *RenameDeviceResponse) GetSuccess() bool {
 	if m != nil {
-		return m.Status
+		return m.Success
 	}
-	return MessageReceptionState_Draft
+	return false
 }
 
-type GetMessageStateRequest struct {
-	UserID               string   `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	MessageID            string   `protobuf:"bytes,2,opt,name=messageID,proto3" json:"messageID,omitempty"`
+type RemoveDeviceRequest struct {
+	DeviceID             string   `protobuf:"bytes,1,opt,name=deviceID,proto3" json:"deviceID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetMessageStateRequest) Reset()         { *m = GetMessageStateRequest{} }
-func (m *GetMessageStateRequest) String() string { return proto.CompactTextString(m) }
-func (*GetMessageStateRequest) ProtoMessage()    {}
-func (*GetMessageStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{40}
+func (m *RemoveDeviceRequest) Reset()         { *m = RemoveDeviceRequest{} }
+func (m *RemoveDeviceRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveDeviceRequest) ProtoMessage()    {}
+func (*RemoveDeviceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{52}
 }
-func (m *GetMessageStateRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetMessageStateRequest.Unmarshal(m, b)
+func (m *RemoveDeviceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveDeviceRequest.Unmarshal(m, b)
 }
-func (m *GetMessageStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetMessageStateRequest.Marshal(b, m, deterministic)
+func (m *RemoveDeviceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveDeviceRequest.Marshal(b, m, deterministic)
 }
-func (dst *GetMessageStateRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetMessageStateRequest.Merge(dst, src)
+func (dst *RemoveDeviceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveDeviceRequest.Merge(dst, src)
 }
-func (m *GetMessageStateRequest) XXX_Size() int {
-	return xxx_messageInfo_GetMessageStateRequest.Size(m)
+func (m *RemoveDeviceRequest) XXX_Size() int {
+	return xxx_messageInfo_RemoveDeviceRequest.Size(m)
 }
-func (m *GetMessageStateRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetMessageStateRequest.DiscardUnknown(m)
+func (m *RemoveDeviceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveDeviceRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetMessageStateRequest proto.InternalMessageInfo
+var xxx_messageInfo_RemoveDeviceRequest proto.InternalMessageInfo
 
-func (m *GetMessageStateRequest) GetUserID() string {
+func (m *RemoveDeviceRequest) GetDeviceID() string {
 	if m != nil {
-		return m.UserID
+		return m.DeviceID
 	}
 	return ""
 }
 
-func (m *GetMessageStateRequest) GetMessageID() string {
+type RemoveDeviceResponse struct {
+	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveDeviceResponse) Reset()         { *m = RemoveDeviceResponse{} }
+func (m *RemoveDeviceResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveDeviceResponse) ProtoMessage()    {}
+func (*RemoveDeviceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{53}
+}
+func (m *RemoveDeviceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveDeviceResponse.Unmarshal(m, b)
+}
+func (m *RemoveDeviceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveDeviceResponse.Marshal(b, m, deterministic)
+}
+func (dst *RemoveDeviceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveDeviceResponse.Merge(dst, src)
+}
+func (m *RemoveDeviceResponse) XXX_Size() int {
+	return xxx_messageInfo_RemoveDeviceResponse.Size(m)
+}
+func (m *RemoveDeviceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveDeviceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveDeviceResponse proto.InternalMessageInfo
+
+func (m *RemoveDeviceResponse) GetSuccess() bool {
 	if m != nil {
-		return m.MessageID
+		return m.Success
 	}
-	return ""
+	return false
 }
 
-type GetMessageStateResponse struct {
-	State                MessageState `protobuf:"varint,1,opt,name=state,proto3,enum=MessageState" json:"state,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+type RefreshTokenRequest struct {
+	RefreshToken         string   `protobuf:"bytes,1,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetMessageStateResponse) Reset()         { *m = GetMessageStateResponse{} }
-func (m *GetMessageStateResponse) String() string { return proto.CompactTextString(m) }
-func (*GetMessageStateResponse) ProtoMessage()    {}
-func (*GetMessageStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{41}
+func (m *RefreshTokenRequest) Reset()         { *m = RefreshTokenRequest{} }
+func (m *RefreshTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*RefreshTokenRequest) ProtoMessage()    {}
+func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{54}
 }
-func (m *GetMessageStateResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetMessageStateResponse.Unmarshal(m, b)
+func (m *RefreshTokenRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RefreshTokenRequest.Unmarshal(m, b)
 }
-func (m *GetMessageStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetMessageStateResponse.Marshal(b, m, deterministic)
+func (m *RefreshTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RefreshTokenRequest.Marshal(b, m, deterministic)
 }
-func (dst *GetMessageStateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetMessageStateResponse.Merge(dst, src)
+func (dst *RefreshTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshTokenRequest.Merge(dst, src)
 }
-func (m *GetMessageStateResponse) XXX_Size() int {
-	return xxx_messageInfo_GetMessageStateResponse.Size(m)
+func (m *RefreshTokenRequest) XXX_Size() int {
+	return xxx_messageInfo_RefreshTokenRequest.Size(m)
 }
-func (m *GetMessageStateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetMessageStateResponse.DiscardUnknown(m)
+func (m *RefreshTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshTokenRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetMessageStateResponse proto.InternalMessageInfo
+var xxx_messageInfo_RefreshTokenRequest proto.InternalMessageInfo
 
-func (m *GetMessageStateResponse) GetState() MessageState {
+func (m *RefreshTokenRequest) GetRefreshToken() string {
 	if m != nil {
-		return m.State
+		return m.RefreshToken
 	}
-	return MessageState_Normal
+	return ""
 }
 
-type PutMessageStateRequest struct {
-	MessageID            int64                 `protobuf:"varint,1,opt,name=messageID,proto3" json:"messageID,omitempty"`
-	Status               MessageReceptionState `protobuf:"varint,2,opt,name=status,proto3,enum=MessageReceptionState" json:"status,omitempty"`
-	ChatID               string                `protobuf:"bytes,3,opt,name=chatID,proto3" json:"chatID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+type RefreshTokenResponse struct {
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken         string   `protobuf:"bytes,2,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	ExpiredAt            int64    `protobuf:"varint,3,opt,name=expiredAt,proto3" json:"expiredAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PutMessageStateRequest) Reset()         { *m = PutMessageStateRequest{} }
-func (m *PutMessageStateRequest) String() string { return proto.CompactTextString(m) }
-func (*PutMessageStateRequest) ProtoMessage()    {}
-func (*PutMessageStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{42}
+func (m *RefreshTokenResponse) Reset()         { *m = RefreshTokenResponse{} }
+func (m *RefreshTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*RefreshTokenResponse) ProtoMessage()    {}
+func (*RefreshTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{55}
 }
-func (m *PutMessageStateRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PutMessageStateRequest.Unmarshal(m, b)
+func (m *RefreshTokenResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RefreshTokenResponse.Unmarshal(m, b)
 }
-func (m *PutMessageStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PutMessageStateRequest.Marshal(b, m, deterministic)
+func (m *RefreshTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RefreshTokenResponse.Marshal(b, m, deterministic)
 }
-func (dst *PutMessageStateRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PutMessageStateRequest.Merge(dst, src)
+func (dst *RefreshTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshTokenResponse.Merge(dst, src)
 }
-func (m *PutMessageStateRequest) XXX_Size() int {
-	return xxx_messageInfo_PutMessageStateRequest.Size(m)
+func (m *RefreshTokenResponse) XXX_Size() int {
+	return xxx_messageInfo_RefreshTokenResponse.Size(m)
 }
-func (m *PutMessageStateRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PutMessageStateRequest.DiscardUnknown(m)
+func (m *RefreshTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshTokenResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PutMessageStateRequest proto.InternalMessageInfo
+var xxx_messageInfo_RefreshTokenResponse proto.InternalMessageInfo
 
-func (m *PutMessageStateRequest) GetMessageID() int64 {
+func (m *RefreshTokenResponse) GetToken() string {
 	if m != nil {
-		return m.MessageID
+		return m.Token
 	}
-	return 0
+	return ""
 }
 
-func (m *PutMessageStateRequest) GetStatus() MessageReceptionState {
+func (m *RefreshTokenResponse) GetRefreshToken() string {
 	if m != nil {
-		return m.Status
+		return m.RefreshToken
 	}
-	return MessageReceptionState_Draft
+	return ""
 }
 
-func (m *PutMessageStateRequest) GetChatID() string {
+func (m *RefreshTokenResponse) GetExpiredAt() int64 {
 	if m != nil {
-		return m.ChatID
+		return m.ExpiredAt
 	}
-	return ""
+	return 0
 }
 
-type PutMessageStateResponse struct {
-	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+type LogoutRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PutMessageStateResponse) Reset()         { *m = PutMessageStateResponse{} }
-func (m *PutMessageStateResponse) String() string { return proto.CompactTextString(m) }
-func (*PutMessageStateResponse) ProtoMessage()    {}
-func (*PutMessageStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{43}
+func (m *LogoutRequest) Reset()         { *m = LogoutRequest{} }
+func (m *LogoutRequest) String() string { return proto.CompactTextString(m) }
+func (*LogoutRequest) ProtoMessage()    {}
+func (*LogoutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{56}
 }
-func (m *PutMessageStateResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PutMessageStateResponse.Unmarshal(m, b)
+func (m *LogoutRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogoutRequest.Unmarshal(m, b)
 }
-func (m *PutMessageStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PutMessageStateResponse.Marshal(b, m, deterministic)
+func (m *LogoutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogoutRequest.Marshal(b, m, deterministic)
 }
-func (dst *PutMessageStateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PutMessageStateResponse.Merge(dst, src)
+func (dst *LogoutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogoutRequest.Merge(dst, src)
 }
-func (m *PutMessageStateResponse) XXX_Size() int {
-	return xxx_messageInfo_PutMessageStateResponse.Size(m)
+func (m *LogoutRequest) XXX_Size() int {
+	return xxx_messageInfo_LogoutRequest.Size(m)
 }
-func (m *PutMessageStateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PutMessageStateResponse.DiscardUnknown(m)
+func (m *LogoutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogoutRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PutMessageStateResponse proto.InternalMessageInfo
-
-func (m *PutMessageStateResponse) GetSuccess() bool {
-	if m != nil {
-		return m.Success
-	}
-	return false
-}
+var xxx_messageInfo_LogoutRequest proto.InternalMessageInfo
 
-type PutMessageReceptionStateRequest struct {
-	// userID is fetched from metadata
-	MessageID            string                `protobuf:"bytes,1,opt,name=messageID,proto3" json:"messageID,omitempty"`
-	Status               MessageReceptionState `protobuf:"varint,2,opt,name=status,proto3,enum=MessageReceptionState" json:"status,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+type LogoutResponse struct {
+	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PutMessageReceptionStateRequest) Reset()         { *m = PutMessageReceptionStateRequest{} }
-func (m *PutMessageReceptionStateRequest) String() string { return proto.CompactTextString(m) }
-func (*PutMessageReceptionStateRequest) ProtoMessage()    {}
-func (*PutMessageReceptionStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{44}
+func (m *LogoutResponse) Reset()         { *m = LogoutResponse{} }
+func (m *LogoutResponse) String() string { return proto.CompactTextString(m) }
+func (*LogoutResponse) ProtoMessage()    {}
+func (*LogoutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{57}
 }
-func (m *PutMessageReceptionStateRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PutMessageReceptionStateRequest.Unmarshal(m, b)
+func (m *LogoutResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogoutResponse.Unmarshal(m, b)
 }
-func (m *PutMessageReceptionStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PutMessageReceptionStateRequest.Marshal(b, m, deterministic)
+func (m *LogoutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogoutResponse.Marshal(b, m, deterministic)
 }
-func (dst *PutMessageReceptionStateRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PutMessageReceptionStateRequest.Merge(dst, src)
+func (dst *LogoutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogoutResponse.Merge(dst, src)
 }
-func (m *PutMessageReceptionStateRequest) XXX_Size() int {
-	return xxx_messageInfo_PutMessageReceptionStateRequest.Size(m)
+func (m *LogoutResponse) XXX_Size() int {
+	return xxx_messageInfo_LogoutResponse.Size(m)
 }
-func (m *PutMessageReceptionStateRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PutMessageReceptionStateRequest.DiscardUnknown(m)
+func (m *LogoutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogoutResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PutMessageReceptionStateRequest proto.InternalMessageInfo
+var xxx_messageInfo_LogoutResponse proto.InternalMessageInfo
 
-func (m *PutMessageReceptionStateRequest) GetMessageID() string {
+func (m *LogoutResponse) GetSuccess() bool {
 	if m != nil {
-		return m.MessageID
+		return m.Success
 	}
-	return ""
+	return false
 }
 
-func (m *PutMessageReceptionStateRequest) GetStatus() MessageReceptionState {
-	if m != nil {
-		return m.Status
-	}
-	return MessageReceptionState_Draft
+type LogoutAllDevicesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type PutMessageReceptionStateResponse struct {
+func (m *LogoutAllDevicesRequest) Reset()         { *m = LogoutAllDevicesRequest{} }
+func (m *LogoutAllDevicesRequest) String() string { return proto.CompactTextString(m) }
+func (*LogoutAllDevicesRequest) ProtoMessage()    {}
+func (*LogoutAllDevicesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{58}
+}
+func (m *LogoutAllDevicesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogoutAllDevicesRequest.Unmarshal(m, b)
+}
+func (m *LogoutAllDevicesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogoutAllDevicesRequest.Marshal(b, m, deterministic)
+}
+func (dst *LogoutAllDevicesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogoutAllDevicesRequest.Merge(dst, src)
+}
+func (m *LogoutAllDevicesRequest) XXX_Size() int {
+	return xxx_messageInfo_LogoutAllDevicesRequest.Size(m)
+}
+func (m *LogoutAllDevicesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogoutAllDevicesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogoutAllDevicesRequest proto.InternalMessageInfo
+
+type LogoutAllDevicesResponse struct {
 	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PutMessageReceptionStateResponse) Reset()         { *m = PutMessageReceptionStateResponse{} }
-func (m *PutMessageReceptionStateResponse) String() string { return proto.CompactTextString(m) }
-func (*PutMessageReceptionStateResponse) ProtoMessage()    {}
-func (*PutMessageReceptionStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{45}
+func (m *LogoutAllDevicesResponse) Reset()         { *m = LogoutAllDevicesResponse{} }
+func (m *LogoutAllDevicesResponse) String() string { return proto.CompactTextString(m) }
+func (*LogoutAllDevicesResponse) ProtoMessage()    {}
+func (*LogoutAllDevicesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{59}
 }
-func (m *PutMessageReceptionStateResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PutMessageReceptionStateResponse.Unmarshal(m, b)
+func (m *LogoutAllDevicesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogoutAllDevicesResponse.Unmarshal(m, b)
 }
-func (m *PutMessageReceptionStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PutMessageReceptionStateResponse.Marshal(b, m, deterministic)
+func (m *LogoutAllDevicesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogoutAllDevicesResponse.Marshal(b, m, deterministic)
 }
-func (dst *PutMessageReceptionStateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PutMessageReceptionStateResponse.Merge(dst, src)
+func (dst *LogoutAllDevicesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogoutAllDevicesResponse.Merge(dst, src)
 }
-func (m *PutMessageReceptionStateResponse) XXX_Size() int {
-	return xxx_messageInfo_PutMessageReceptionStateResponse.Size(m)
+func (m *LogoutAllDevicesResponse) XXX_Size() int {
+	return xxx_messageInfo_LogoutAllDevicesResponse.Size(m)
 }
-func (m *PutMessageReceptionStateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PutMessageReceptionStateResponse.DiscardUnknown(m)
+func (m *LogoutAllDevicesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogoutAllDevicesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PutMessageReceptionStateResponse proto.InternalMessageInfo
+var xxx_messageInfo_LogoutAllDevicesResponse proto.InternalMessageInfo
 
-func (m *PutMessageReceptionStateResponse) GetSuccess() bool {
+func (m *LogoutAllDevicesResponse) GetSuccess() bool {
 	if m != nil {
 		return m.Success
 	}
 	return false
 }
 
-type CreateConversationRequest struct {
-	UserID               string           `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	ChatID               string           `protobuf:"bytes,2,opt,name=chatID,proto3" json:"chatID,omitempty"`
-	Type                 ConversationType `protobuf:"varint,3,opt,name=type,proto3,enum=ConversationType" json:"type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+type RequestPhoneNumberChangeRequest struct {
+	PhoneNumber          string   `protobuf:"bytes,1,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
+	Locale               string   `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	Brand                string   `protobuf:"bytes,3,opt,name=brand,proto3" json:"brand,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateConversationRequest) Reset()         { *m = CreateConversationRequest{} }
-func (m *CreateConversationRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateConversationRequest) ProtoMessage()    {}
-func (*CreateConversationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{46}
+func (m *RequestPhoneNumberChangeRequest) Reset()         { *m = RequestPhoneNumberChangeRequest{} }
+func (m *RequestPhoneNumberChangeRequest) String() string { return proto.CompactTextString(m) }
+func (*RequestPhoneNumberChangeRequest) ProtoMessage()    {}
+func (*RequestPhoneNumberChangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{60}
 }
-func (m *CreateConversationRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateConversationRequest.Unmarshal(m, b)
+func (m *RequestPhoneNumberChangeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RequestPhoneNumberChangeRequest.Unmarshal(m, b)
 }
-func (m *CreateConversationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateConversationRequest.Marshal(b, m, deterministic)
+func (m *RequestPhoneNumberChangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RequestPhoneNumberChangeRequest.Marshal(b, m, deterministic)
 }
-func (dst *CreateConversationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateConversationRequest.Merge(dst, src)
+func (dst *RequestPhoneNumberChangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestPhoneNumberChangeRequest.Merge(dst, src)
 }
-func (m *CreateConversationRequest) XXX_Size() int {
-	return xxx_messageInfo_CreateConversationRequest.Size(m)
+func (m *RequestPhoneNumberChangeRequest) XXX_Size() int {
+	return xxx_messageInfo_RequestPhoneNumberChangeRequest.Size(m)
 }
-func (m *CreateConversationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateConversationRequest.DiscardUnknown(m)
+func (m *RequestPhoneNumberChangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestPhoneNumberChangeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateConversationRequest proto.InternalMessageInfo
+var xxx_messageInfo_RequestPhoneNumberChangeRequest proto.InternalMessageInfo
 
-func (m *CreateConversationRequest) GetUserID() string {
+func (m *RequestPhoneNumberChangeRequest) GetPhoneNumber() string {
 	if m != nil {
-		return m.UserID
+		return m.PhoneNumber
 	}
 	return ""
 }
 
-func (m *CreateConversationRequest) GetChatID() string {
+func (m *RequestPhoneNumberChangeRequest) GetLocale() string {
 	if m != nil {
-		return m.ChatID
+		return m.Locale
 	}
 	return ""
 }
 
-func (m *CreateConversationRequest) GetType() ConversationType {
+func (m *RequestPhoneNumberChangeRequest) GetBrand() string {
 	if m != nil {
-		return m.Type
+		return m.Brand
 	}
-	return ConversationType_PeerToPeer
+	return ""
 }
 
-type CreateConversationResponse struct {
-	ChatID               string   `protobuf:"bytes,1,opt,name=chatID,proto3" json:"chatID,omitempty"`
-	Message              string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+type RequestPhoneNumberChangeResponse struct {
+	OtpDebug             string   `protobuf:"bytes,1,opt,name=otpDebug,proto3" json:"otpDebug,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateConversationResponse) Reset()         { *m = CreateConversationResponse{} }
-func (m *CreateConversationResponse) String() string { return proto.CompactTextString(m) }
-func (*CreateConversationResponse) ProtoMessage()    {}
-func (*CreateConversationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{47}
+func (m *RequestPhoneNumberChangeResponse) Reset()         { *m = RequestPhoneNumberChangeResponse{} }
+func (m *RequestPhoneNumberChangeResponse) String() string { return proto.CompactTextString(m) }
+func (*RequestPhoneNumberChangeResponse) ProtoMessage()    {}
+func (*RequestPhoneNumberChangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{61}
 }
-func (m *CreateConversationResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateConversationResponse.Unmarshal(m, b)
+func (m *RequestPhoneNumberChangeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RequestPhoneNumberChangeResponse.Unmarshal(m, b)
 }
-func (m *CreateConversationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateConversationResponse.Marshal(b, m, deterministic)
+func (m *RequestPhoneNumberChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RequestPhoneNumberChangeResponse.Marshal(b, m, deterministic)
 }
-func (dst *CreateConversationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateConversationResponse.Merge(dst, src)
+func (dst *RequestPhoneNumberChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestPhoneNumberChangeResponse.Merge(dst, src)
 }
-func (m *CreateConversationResponse) XXX_Size() int {
-	return xxx_messageInfo_CreateConversationResponse.Size(m)
+func (m *RequestPhoneNumberChangeResponse) XXX_Size() int {
+	return xxx_messageInfo_RequestPhoneNumberChangeResponse.Size(m)
 }
-func (m *CreateConversationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateConversationResponse.DiscardUnknown(m)
+func (m *RequestPhoneNumberChangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestPhoneNumberChangeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateConversationResponse proto.InternalMessageInfo
-
-func (m *CreateConversationResponse) GetChatID() string {
-	if m != nil {
-		return m.ChatID
-	}
-	return ""
-}
+var xxx_messageInfo_RequestPhoneNumberChangeResponse proto.InternalMessageInfo
 
-func (m *CreateConversationResponse) GetMessage() string {
+func (m *RequestPhoneNumberChangeResponse) GetOtpDebug() string {
 	if m != nil {
-		return m.Message
+		return m.OtpDebug
 	}
 	return ""
 }
 
-type DeleteConversationRequest struct {
-	ChatID               string   `protobuf:"bytes,1,opt,name=chatID,proto3" json:"chatID,omitempty"`
+type ChangePhoneNumberRequest struct {
+	PhoneNumber          string   `protobuf:"bytes,1,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
+	OTP                  string   `protobuf:"bytes,2,opt,name=OTP,proto3" json:"OTP,omitempty"`
+	NotifyContacts       bool     `protobuf:"varint,3,opt,name=notifyContacts,proto3" json:"notifyContacts,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteConversationRequest) Reset()         { *m = DeleteConversationRequest{} }
-func (m *DeleteConversationRequest) String() string { return proto.CompactTextString(m) }
-func (*DeleteConversationRequest) ProtoMessage()    {}
-func (*DeleteConversationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{48}
+func (m *ChangePhoneNumberRequest) Reset()         { *m = ChangePhoneNumberRequest{} }
+func (m *ChangePhoneNumberRequest) String() string { return proto.CompactTextString(m) }
+func (*ChangePhoneNumberRequest) ProtoMessage()    {}
+func (*ChangePhoneNumberRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{62}
 }
-func (m *DeleteConversationRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteConversationRequest.Unmarshal(m, b)
+func (m *ChangePhoneNumberRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChangePhoneNumberRequest.Unmarshal(m, b)
 }
-func (m *DeleteConversationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteConversationRequest.Marshal(b, m, deterministic)
+func (m *ChangePhoneNumberRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChangePhoneNumberRequest.Marshal(b, m, deterministic)
 }
-func (dst *DeleteConversationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteConversationRequest.Merge(dst, src)
+func (dst *ChangePhoneNumberRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangePhoneNumberRequest.Merge(dst, src)
 }
-func (m *DeleteConversationRequest) XXX_Size() int {
-	return xxx_messageInfo_DeleteConversationRequest.Size(m)
+func (m *ChangePhoneNumberRequest) XXX_Size() int {
+	return xxx_messageInfo_ChangePhoneNumberRequest.Size(m)
 }
-func (m *DeleteConversationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteConversationRequest.DiscardUnknown(m)
+func (m *ChangePhoneNumberRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangePhoneNumberRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteConversationRequest proto.InternalMessageInfo
+var xxx_messageInfo_ChangePhoneNumberRequest proto.InternalMessageInfo
 
-func (m *DeleteConversationRequest) GetChatID() string {
+func (m *ChangePhoneNumberRequest) GetPhoneNumber() string {
 	if m != nil {
-		return m.ChatID
+		return m.PhoneNumber
 	}
 	return ""
 }
 
-type DeleteConversationResponse struct {
+func (m *ChangePhoneNumberRequest) GetOTP() string {
+	if m != nil {
+		return m.OTP
+	}
+	return ""
+}
+
+func (m *ChangePhoneNumberRequest) GetNotifyContacts() bool {
+	if m != nil {
+		return m.NotifyContacts
+	}
+	return false
+}
+
+type ChangePhoneNumberResponse struct {
 	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message              string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	PhoneNumber          string   `protobuf:"bytes,2,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteConversationResponse) Reset()         { *m = DeleteConversationResponse{} }
-func (m *DeleteConversationResponse) String() string { return proto.CompactTextString(m) }
-func (*DeleteConversationResponse) ProtoMessage()    {}
-func (*DeleteConversationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{49}
+func (m *ChangePhoneNumberResponse) Reset()         { *m = ChangePhoneNumberResponse{} }
+func (m *ChangePhoneNumberResponse) String() string { return proto.CompactTextString(m) }
+func (*ChangePhoneNumberResponse) ProtoMessage()    {}
+func (*ChangePhoneNumberResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{63}
 }
-func (m *DeleteConversationResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteConversationResponse.Unmarshal(m, b)
+func (m *ChangePhoneNumberResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChangePhoneNumberResponse.Unmarshal(m, b)
 }
-func (m *DeleteConversationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteConversationResponse.Marshal(b, m, deterministic)
+func (m *ChangePhoneNumberResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChangePhoneNumberResponse.Marshal(b, m, deterministic)
 }
-func (dst *DeleteConversationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteConversationResponse.Merge(dst, src)
+func (dst *ChangePhoneNumberResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangePhoneNumberResponse.Merge(dst, src)
 }
-func (m *DeleteConversationResponse) XXX_Size() int {
-	return xxx_messageInfo_DeleteConversationResponse.Size(m)
+func (m *ChangePhoneNumberResponse) XXX_Size() int {
+	return xxx_messageInfo_ChangePhoneNumberResponse.Size(m)
 }
-func (m *DeleteConversationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteConversationResponse.DiscardUnknown(m)
+func (m *ChangePhoneNumberResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangePhoneNumberResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteConversationResponse proto.InternalMessageInfo
+var xxx_messageInfo_ChangePhoneNumberResponse proto.InternalMessageInfo
 
-func (m *DeleteConversationResponse) GetSuccess() bool {
+func (m *ChangePhoneNumberResponse) GetSuccess() bool {
 	if m != nil {
 		return m.Success
 	}
 	return false
 }
 
-func (m *DeleteConversationResponse) GetMessage() string {
+func (m *ChangePhoneNumberResponse) GetPhoneNumber() string {
 	if m != nil {
-		return m.Message
+		return m.PhoneNumber
 	}
 	return ""
 }
 
-type AddToGroupRequest struct {
-	GroupID              string              `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID,omitempty"`
-	Participants         []*GroupParticipant `protobuf:"bytes,2,rep,name=participants,proto3" json:"participants,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+type DeleteAccountRequest struct {
+	CurrentPIN           string   `protobuf:"bytes,1,opt,name=currentPIN,proto3" json:"currentPIN,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AddToGroupRequest) Reset()         { *m = AddToGroupRequest{} }
-func (m *AddToGroupRequest) String() string { return proto.CompactTextString(m) }
-func (*AddToGroupRequest) ProtoMessage()    {}
-func (*AddToGroupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{50}
+func (m *DeleteAccountRequest) Reset()         { *m = DeleteAccountRequest{} }
+func (m *DeleteAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteAccountRequest) ProtoMessage()    {}
+func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{64}
 }
-func (m *AddToGroupRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AddToGroupRequest.Unmarshal(m, b)
+func (m *DeleteAccountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteAccountRequest.Unmarshal(m, b)
 }
-func (m *AddToGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AddToGroupRequest.Marshal(b, m, deterministic)
+func (m *DeleteAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteAccountRequest.Marshal(b, m, deterministic)
 }
-func (dst *AddToGroupRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AddToGroupRequest.Merge(dst, src)
+func (dst *DeleteAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteAccountRequest.Merge(dst, src)
 }
-func (m *AddToGroupRequest) XXX_Size() int {
-	return xxx_messageInfo_AddToGroupRequest.Size(m)
+func (m *DeleteAccountRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteAccountRequest.Size(m)
 }
-func (m *AddToGroupRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AddToGroupRequest.DiscardUnknown(m)
+func (m *DeleteAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteAccountRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AddToGroupRequest proto.InternalMessageInfo
+var xxx_messageInfo_DeleteAccountRequest proto.InternalMessageInfo
 
-func (m *AddToGroupRequest) GetGroupID() string {
+func (m *DeleteAccountRequest) GetCurrentPIN() string {
 	if m != nil {
-		return m.GroupID
+		return m.CurrentPIN
 	}
 	return ""
 }
 
-func (m *AddToGroupRequest) GetParticipants() []*GroupParticipant {
-	if m != nil {
-		return m.Participants
-	}
-	return nil
-}
-
-type AddToGroupResponse struct {
-	GroupID              string   `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID,omitempty"`
+type DeleteAccountResponse struct {
+	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AddToGroupResponse) Reset()         { *m = AddToGroupResponse{} }
-func (m *AddToGroupResponse) String() string { return proto.CompactTextString(m) }
-func (*AddToGroupResponse) ProtoMessage()    {}
-func (*AddToGroupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{51}
+func (m *DeleteAccountResponse) Reset()         { *m = DeleteAccountResponse{} }
+func (m *DeleteAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteAccountResponse) ProtoMessage()    {}
+func (*DeleteAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{65}
 }
-func (m *AddToGroupResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AddToGroupResponse.Unmarshal(m, b)
+func (m *DeleteAccountResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteAccountResponse.Unmarshal(m, b)
 }
-func (m *AddToGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AddToGroupResponse.Marshal(b, m, deterministic)
+func (m *DeleteAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteAccountResponse.Marshal(b, m, deterministic)
 }
-func (dst *AddToGroupResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AddToGroupResponse.Merge(dst, src)
+func (dst *DeleteAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteAccountResponse.Merge(dst, src)
 }
-func (m *AddToGroupResponse) XXX_Size() int {
-	return xxx_messageInfo_AddToGroupResponse.Size(m)
+func (m *DeleteAccountResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteAccountResponse.Size(m)
 }
-func (m *AddToGroupResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_AddToGroupResponse.DiscardUnknown(m)
+func (m *DeleteAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteAccountResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AddToGroupResponse proto.InternalMessageInfo
+var xxx_messageInfo_DeleteAccountResponse proto.InternalMessageInfo
 
-func (m *AddToGroupResponse) GetGroupID() string {
+func (m *DeleteAccountResponse) GetSuccess() bool {
 	if m != nil {
-		return m.GroupID
+		return m.Success
 	}
-	return ""
+	return false
 }
 
-type UploadMediaRequest struct {
-	FileName             string   `protobuf:"bytes,1,opt,name=fileName,proto3" json:"fileName,omitempty"`
-	ContentType          string   `protobuf:"bytes,2,opt,name=contentType,proto3" json:"contentType,omitempty"`
-	IsEncrypted          bool     `protobuf:"varint,3,opt,name=isEncrypted,proto3" json:"isEncrypted,omitempty"`
-	Contents             []byte   `protobuf:"bytes,4,opt,name=contents,proto3" json:"contents,omitempty"`
+type RequestDataExportRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UploadMediaRequest) Reset()         { *m = UploadMediaRequest{} }
-func (m *UploadMediaRequest) String() string { return proto.CompactTextString(m) }
-func (*UploadMediaRequest) ProtoMessage()    {}
-func (*UploadMediaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{52}
+func (m *RequestDataExportRequest) Reset()         { *m = RequestDataExportRequest{} }
+func (m *RequestDataExportRequest) String() string { return proto.CompactTextString(m) }
+func (*RequestDataExportRequest) ProtoMessage()    {}
+func (*RequestDataExportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{66}
 }
-func (m *UploadMediaRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UploadMediaRequest.Unmarshal(m, b)
+func (m *RequestDataExportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RequestDataExportRequest.Unmarshal(m, b)
 }
-func (m *UploadMediaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UploadMediaRequest.Marshal(b, m, deterministic)
+func (m *RequestDataExportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RequestDataExportRequest.Marshal(b, m, deterministic)
 }
-func (dst *UploadMediaRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UploadMediaRequest.Merge(dst, src)
+func (dst *RequestDataExportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestDataExportRequest.Merge(dst, src)
 }
-func (m *UploadMediaRequest) XXX_Size() int {
-	return xxx_messageInfo_UploadMediaRequest.Size(m)
+func (m *RequestDataExportRequest) XXX_Size() int {
+	return xxx_messageInfo_RequestDataExportRequest.Size(m)
 }
-func (m *UploadMediaRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_UploadMediaRequest.DiscardUnknown(m)
+func (m *RequestDataExportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestDataExportRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UploadMediaRequest proto.InternalMessageInfo
+var xxx_messageInfo_RequestDataExportRequest proto.InternalMessageInfo
 
-func (m *UploadMediaRequest) GetFileName() string {
-	if m != nil {
-		return m.FileName
-	}
-	return ""
+type RequestDataExportResponse struct {
+	ExportID             string   `protobuf:"bytes,1,opt,name=exportID,proto3" json:"exportID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UploadMediaRequest) GetContentType() string {
-	if m != nil {
-		return m.ContentType
-	}
-	return ""
+func (m *RequestDataExportResponse) Reset()         { *m = RequestDataExportResponse{} }
+func (m *RequestDataExportResponse) String() string { return proto.CompactTextString(m) }
+func (*RequestDataExportResponse) ProtoMessage()    {}
+func (*RequestDataExportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{67}
 }
-
-func (m *UploadMediaRequest) GetIsEncrypted() bool {
-	if m != nil {
-		return m.IsEncrypted
-	}
-	return false
+func (m *RequestDataExportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RequestDataExportResponse.Unmarshal(m, b)
+}
+func (m *RequestDataExportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RequestDataExportResponse.Marshal(b, m, deterministic)
+}
+func (dst *RequestDataExportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestDataExportResponse.Merge(dst, src)
+}
+func (m *RequestDataExportResponse) XXX_Size() int {
+	return xxx_messageInfo_RequestDataExportResponse.Size(m)
+}
+func (m *RequestDataExportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestDataExportResponse.DiscardUnknown(m)
 }
 
-func (m *UploadMediaRequest) GetContents() []byte {
+var xxx_messageInfo_RequestDataExportResponse proto.InternalMessageInfo
+
+func (m *RequestDataExportResponse) GetExportID() string {
 	if m != nil {
-		return m.Contents
+		return m.ExportID
 	}
-	return nil
+	return ""
 }
 
-type UploadMediaResponse struct {
-	MediaID              string   `protobuf:"bytes,1,opt,name=mediaID,proto3" json:"mediaID,omitempty"`
+type GetDataExportStatusRequest struct {
+	ExportID             string   `protobuf:"bytes,1,opt,name=exportID,proto3" json:"exportID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UploadMediaResponse) Reset()         { *m = UploadMediaResponse{} }
-func (m *UploadMediaResponse) String() string { return proto.CompactTextString(m) }
-func (*UploadMediaResponse) ProtoMessage()    {}
-func (*UploadMediaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{53}
+func (m *GetDataExportStatusRequest) Reset()         { *m = GetDataExportStatusRequest{} }
+func (m *GetDataExportStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDataExportStatusRequest) ProtoMessage()    {}
+func (*GetDataExportStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{68}
 }
-func (m *UploadMediaResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UploadMediaResponse.Unmarshal(m, b)
+func (m *GetDataExportStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDataExportStatusRequest.Unmarshal(m, b)
 }
-func (m *UploadMediaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UploadMediaResponse.Marshal(b, m, deterministic)
+func (m *GetDataExportStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDataExportStatusRequest.Marshal(b, m, deterministic)
 }
-func (dst *UploadMediaResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UploadMediaResponse.Merge(dst, src)
+func (dst *GetDataExportStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDataExportStatusRequest.Merge(dst, src)
 }
-func (m *UploadMediaResponse) XXX_Size() int {
-	return xxx_messageInfo_UploadMediaResponse.Size(m)
+func (m *GetDataExportStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDataExportStatusRequest.Size(m)
 }
-func (m *UploadMediaResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_UploadMediaResponse.DiscardUnknown(m)
+func (m *GetDataExportStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDataExportStatusRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UploadMediaResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetDataExportStatusRequest proto.InternalMessageInfo
 
-func (m *UploadMediaResponse) GetMediaID() string {
+func (m *GetDataExportStatusRequest) GetExportID() string {
 	if m != nil {
-		return m.MediaID
+		return m.ExportID
 	}
 	return ""
 }
 
-type UploadProfilePictureRequest struct {
-	Contents             []byte   `protobuf:"bytes,1,opt,name=contents,proto3" json:"contents,omitempty"`
+type GetDataExportStatusResponse struct {
+	Status               string   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	MediaID              string   `protobuf:"bytes,2,opt,name=mediaID,proto3" json:"mediaID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UploadProfilePictureRequest) Reset()         { *m = UploadProfilePictureRequest{} }
-func (m *UploadProfilePictureRequest) String() string { return proto.CompactTextString(m) }
-func (*UploadProfilePictureRequest) ProtoMessage()    {}
-func (*UploadProfilePictureRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{54}
+func (m *GetDataExportStatusResponse) Reset()         { *m = GetDataExportStatusResponse{} }
+func (m *GetDataExportStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDataExportStatusResponse) ProtoMessage()    {}
+func (*GetDataExportStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{69}
 }
-func (m *UploadProfilePictureRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UploadProfilePictureRequest.Unmarshal(m, b)
+func (m *GetDataExportStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDataExportStatusResponse.Unmarshal(m, b)
 }
-func (m *UploadProfilePictureRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UploadProfilePictureRequest.Marshal(b, m, deterministic)
+func (m *GetDataExportStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDataExportStatusResponse.Marshal(b, m, deterministic)
 }
-func (dst *UploadProfilePictureRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UploadProfilePictureRequest.Merge(dst, src)
+func (dst *GetDataExportStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDataExportStatusResponse.Merge(dst, src)
 }
-func (m *UploadProfilePictureRequest) XXX_Size() int {
-	return xxx_messageInfo_UploadProfilePictureRequest.Size(m)
+func (m *GetDataExportStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_GetDataExportStatusResponse.Size(m)
 }
-func (m *UploadProfilePictureRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_UploadProfilePictureRequest.DiscardUnknown(m)
+func (m *GetDataExportStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDataExportStatusResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UploadProfilePictureRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetDataExportStatusResponse proto.InternalMessageInfo
 
-func (m *UploadProfilePictureRequest) GetContents() []byte {
+func (m *GetDataExportStatusResponse) GetStatus() string {
 	if m != nil {
-		return m.Contents
+		return m.Status
 	}
-	return nil
+	return ""
 }
 
-type UploadProfilePictureResponse struct {
-	MediaID              string   `protobuf:"bytes,1,opt,name=mediaID,proto3" json:"mediaID,omitempty"`
+func (m *GetDataExportStatusResponse) GetMediaID() string {
+	if m != nil {
+		return m.MediaID
+	}
+	return ""
+}
+
+type SetPINRequest struct {
+	Pin                  string   `protobuf:"bytes,1,opt,name=pin,proto3" json:"pin,omitempty"`
+	CurrentPIN           string   `protobuf:"bytes,2,opt,name=currentPIN,proto3" json:"currentPIN,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UploadProfilePictureResponse) Reset()         { *m = UploadProfilePictureResponse{} }
-func (m *UploadProfilePictureResponse) String() string { return proto.CompactTextString(m) }
-func (*UploadProfilePictureResponse) ProtoMessage()    {}
-func (*UploadProfilePictureResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{55}
+func (m *SetPINRequest) Reset()         { *m = SetPINRequest{} }
+func (m *SetPINRequest) String() string { return proto.CompactTextString(m) }
+func (*SetPINRequest) ProtoMessage()    {}
+func (*SetPINRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{70}
 }
-func (m *UploadProfilePictureResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UploadProfilePictureResponse.Unmarshal(m, b)
+func (m *SetPINRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetPINRequest.Unmarshal(m, b)
 }
-func (m *UploadProfilePictureResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UploadProfilePictureResponse.Marshal(b, m, deterministic)
+func (m *SetPINRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetPINRequest.Marshal(b, m, deterministic)
 }
-func (dst *UploadProfilePictureResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UploadProfilePictureResponse.Merge(dst, src)
+func (dst *SetPINRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetPINRequest.Merge(dst, src)
 }
-func (m *UploadProfilePictureResponse) XXX_Size() int {
-	return xxx_messageInfo_UploadProfilePictureResponse.Size(m)
+func (m *SetPINRequest) XXX_Size() int {
+	return xxx_messageInfo_SetPINRequest.Size(m)
 }
-func (m *UploadProfilePictureResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_UploadProfilePictureResponse.DiscardUnknown(m)
+func (m *SetPINRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetPINRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UploadProfilePictureResponse proto.InternalMessageInfo
+var xxx_messageInfo_SetPINRequest proto.InternalMessageInfo
 
-func (m *UploadProfilePictureResponse) GetMediaID() string {
+func (m *SetPINRequest) GetPin() string {
 	if m != nil {
-		return m.MediaID
+		return m.Pin
 	}
 	return ""
 }
 
-type GetMediaRequest struct {
-	MediaID              string   `protobuf:"bytes,1,opt,name=mediaID,proto3" json:"mediaID,omitempty"`
+func (m *SetPINRequest) GetCurrentPIN() string {
+	if m != nil {
+		return m.CurrentPIN
+	}
+	return ""
+}
+
+type SetPINResponse struct {
+	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetMediaRequest) Reset()         { *m = GetMediaRequest{} }
-func (m *GetMediaRequest) String() string { return proto.CompactTextString(m) }
-func (*GetMediaRequest) ProtoMessage()    {}
-func (*GetMediaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{56}
+func (m *SetPINResponse) Reset()         { *m = SetPINResponse{} }
+func (m *SetPINResponse) String() string { return proto.CompactTextString(m) }
+func (*SetPINResponse) ProtoMessage()    {}
+func (*SetPINResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{71}
 }
-func (m *GetMediaRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetMediaRequest.Unmarshal(m, b)
+func (m *SetPINResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetPINResponse.Unmarshal(m, b)
 }
-func (m *GetMediaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetMediaRequest.Marshal(b, m, deterministic)
+func (m *SetPINResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetPINResponse.Marshal(b, m, deterministic)
 }
-func (dst *GetMediaRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetMediaRequest.Merge(dst, src)
+func (dst *SetPINResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetPINResponse.Merge(dst, src)
 }
-func (m *GetMediaRequest) XXX_Size() int {
-	return xxx_messageInfo_GetMediaRequest.Size(m)
+func (m *SetPINResponse) XXX_Size() int {
+	return xxx_messageInfo_SetPINResponse.Size(m)
 }
-func (m *GetMediaRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetMediaRequest.DiscardUnknown(m)
+func (m *SetPINResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetPINResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetMediaRequest proto.InternalMessageInfo
+var xxx_messageInfo_SetPINResponse proto.InternalMessageInfo
 
-func (m *GetMediaRequest) GetMediaID() string {
+func (m *SetPINResponse) GetSuccess() bool {
 	if m != nil {
-		return m.MediaID
+		return m.Success
 	}
-	return ""
+	return false
 }
 
-type GetMediaResponse struct {
-	Contents             []byte   `protobuf:"bytes,1,opt,name=contents,proto3" json:"contents,omitempty"`
+type RemovePINRequest struct {
+	CurrentPIN           string   `protobuf:"bytes,1,opt,name=currentPIN,proto3" json:"currentPIN,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetMediaResponse) Reset()         { *m = GetMediaResponse{} }
-func (m *GetMediaResponse) String() string { return proto.CompactTextString(m) }
-func (*GetMediaResponse) ProtoMessage()    {}
-func (*GetMediaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{57}
+func (m *RemovePINRequest) Reset()         { *m = RemovePINRequest{} }
+func (m *RemovePINRequest) String() string { return proto.CompactTextString(m) }
+func (*RemovePINRequest) ProtoMessage()    {}
+func (*RemovePINRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{72}
 }
-func (m *GetMediaResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetMediaResponse.Unmarshal(m, b)
+func (m *RemovePINRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemovePINRequest.Unmarshal(m, b)
 }
-func (m *GetMediaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetMediaResponse.Marshal(b, m, deterministic)
+func (m *RemovePINRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemovePINRequest.Marshal(b, m, deterministic)
 }
-func (dst *GetMediaResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetMediaResponse.Merge(dst, src)
+func (dst *RemovePINRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemovePINRequest.Merge(dst, src)
 }
-func (m *GetMediaResponse) XXX_Size() int {
-	return xxx_messageInfo_GetMediaResponse.Size(m)
+func (m *RemovePINRequest) XXX_Size() int {
+	return xxx_messageInfo_RemovePINRequest.Size(m)
 }
-func (m *GetMediaResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetMediaResponse.DiscardUnknown(m)
+func (m *RemovePINRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemovePINRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetMediaResponse proto.InternalMessageInfo
+var xxx_messageInfo_RemovePINRequest proto.InternalMessageInfo
 
-func (m *GetMediaResponse) GetContents() []byte {
+func (m *RemovePINRequest) GetCurrentPIN() string {
 	if m != nil {
-		return m.Contents
+		return m.CurrentPIN
 	}
-	return nil
+	return ""
 }
 
-type GetMessagesRequest struct {
+type RemovePINResponse struct {
+	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetMessagesRequest) Reset()         { *m = GetMessagesRequest{} }
-func (m *GetMessagesRequest) String() string { return proto.CompactTextString(m) }
-func (*GetMessagesRequest) ProtoMessage()    {}
-func (*GetMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{58}
+func (m *RemovePINResponse) Reset()         { *m = RemovePINResponse{} }
+func (m *RemovePINResponse) String() string { return proto.CompactTextString(m) }
+func (*RemovePINResponse) ProtoMessage()    {}
+func (*RemovePINResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{73}
 }
-func (m *GetMessagesRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetMessagesRequest.Unmarshal(m, b)
+func (m *RemovePINResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemovePINResponse.Unmarshal(m, b)
 }
-func (m *GetMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetMessagesRequest.Marshal(b, m, deterministic)
+func (m *RemovePINResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemovePINResponse.Marshal(b, m, deterministic)
 }
-func (dst *GetMessagesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetMessagesRequest.Merge(dst, src)
+func (dst *RemovePINResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemovePINResponse.Merge(dst, src)
 }
-func (m *GetMessagesRequest) XXX_Size() int {
-	return xxx_messageInfo_GetMessagesRequest.Size(m)
+func (m *RemovePINResponse) XXX_Size() int {
+	return xxx_messageInfo_RemovePINResponse.Size(m)
 }
-func (m *GetMessagesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetMessagesRequest.DiscardUnknown(m)
+func (m *RemovePINResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemovePINResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetMessagesRequest proto.InternalMessageInfo
+var xxx_messageInfo_RemovePINResponse proto.InternalMessageInfo
 
-type GetMessageNotificationStream struct {
-	Timestamp            int64    `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Sender               string   `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
-	Recipient            string   `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+func (m *RemovePINResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+type VerifyPINRequest struct {
+	Pin                  string   `protobuf:"bytes,1,opt,name=pin,proto3" json:"pin,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetMessageNotificationStream) Reset()         { *m = GetMessageNotificationStream{} }
-func (m *GetMessageNotificationStream) String() string { return proto.CompactTextString(m) }
-func (*GetMessageNotificationStream) ProtoMessage()    {}
-func (*GetMessageNotificationStream) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{59}
-}
-func (m *GetMessageNotificationStream) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetMessageNotificationStream.Unmarshal(m, b)
+func (m *VerifyPINRequest) Reset()         { *m = VerifyPINRequest{} }
+func (m *VerifyPINRequest) String() string { return proto.CompactTextString(m) }
+func (*VerifyPINRequest) ProtoMessage()    {}
+func (*VerifyPINRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{74}
 }
-func (m *GetMessageNotificationStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetMessageNotificationStream.Marshal(b, m, deterministic)
+func (m *VerifyPINRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyPINRequest.Unmarshal(m, b)
 }
-func (dst *GetMessageNotificationStream) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetMessageNotificationStream.Merge(dst, src)
+func (m *VerifyPINRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyPINRequest.Marshal(b, m, deterministic)
 }
-func (m *GetMessageNotificationStream) XXX_Size() int {
-	return xxx_messageInfo_GetMessageNotificationStream.Size(m)
+func (dst *VerifyPINRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyPINRequest.Merge(dst, src)
 }
-func (m *GetMessageNotificationStream) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetMessageNotificationStream.DiscardUnknown(m)
+func (m *VerifyPINRequest) XXX_Size() int {
+	return xxx_messageInfo_VerifyPINRequest.Size(m)
 }
-
-var xxx_messageInfo_GetMessageNotificationStream proto.InternalMessageInfo
-
-func (m *GetMessageNotificationStream) GetTimestamp() int64 {
-	if m != nil {
-		return m.Timestamp
-	}
-	return 0
+func (m *VerifyPINRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyPINRequest.DiscardUnknown(m)
 }
 
-func (m *GetMessageNotificationStream) GetSender() string {
-	if m != nil {
-		return m.Sender
-	}
-	return ""
-}
+var xxx_messageInfo_VerifyPINRequest proto.InternalMessageInfo
 
-func (m *GetMessageNotificationStream) GetRecipient() string {
+func (m *VerifyPINRequest) GetPin() string {
 	if m != nil {
-		return m.Recipient
+		return m.Pin
 	}
 	return ""
 }
 
-type AckMessageNotificationStreamRequest struct {
-	Timestamp            int64    `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Sender               string   `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
-	Recipient            string   `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+type VerifyPINResponse struct {
+	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Token                string   `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken         string   `protobuf:"bytes,3,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	ExpiredAt            int64    `protobuf:"varint,4,opt,name=expiredAt,proto3" json:"expiredAt,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AckMessageNotificationStreamRequest) Reset()         { *m = AckMessageNotificationStreamRequest{} }
-func (m *AckMessageNotificationStreamRequest) String() string { return proto.CompactTextString(m) }
-func (*AckMessageNotificationStreamRequest) ProtoMessage()    {}
-func (*AckMessageNotificationStreamRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{60}
+func (m *VerifyPINResponse) Reset()         { *m = VerifyPINResponse{} }
+func (m *VerifyPINResponse) String() string { return proto.CompactTextString(m) }
+func (*VerifyPINResponse) ProtoMessage()    {}
+func (*VerifyPINResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{75}
 }
-func (m *AckMessageNotificationStreamRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AckMessageNotificationStreamRequest.Unmarshal(m, b)
+func (m *VerifyPINResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyPINResponse.Unmarshal(m, b)
 }
-func (m *AckMessageNotificationStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AckMessageNotificationStreamRequest.Marshal(b, m, deterministic)
+func (m *VerifyPINResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyPINResponse.Marshal(b, m, deterministic)
 }
-func (dst *AckMessageNotificationStreamRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AckMessageNotificationStreamRequest.Merge(dst, src)
+func (dst *VerifyPINResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyPINResponse.Merge(dst, src)
 }
-func (m *AckMessageNotificationStreamRequest) XXX_Size() int {
-	return xxx_messageInfo_AckMessageNotificationStreamRequest.Size(m)
+func (m *VerifyPINResponse) XXX_Size() int {
+	return xxx_messageInfo_VerifyPINResponse.Size(m)
 }
-func (m *AckMessageNotificationStreamRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AckMessageNotificationStreamRequest.DiscardUnknown(m)
+func (m *VerifyPINResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyPINResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AckMessageNotificationStreamRequest proto.InternalMessageInfo
+var xxx_messageInfo_VerifyPINResponse proto.InternalMessageInfo
 
-func (m *AckMessageNotificationStreamRequest) GetTimestamp() int64 {
+func (m *VerifyPINResponse) GetSuccess() bool {
 	if m != nil {
-		return m.Timestamp
+		return m.Success
 	}
-	return 0
+	return false
 }
 
-func (m *AckMessageNotificationStreamRequest) GetSender() string {
+func (m *VerifyPINResponse) GetToken() string {
 	if m != nil {
-		return m.Sender
+		return m.Token
 	}
 	return ""
 }
 
-func (m *AckMessageNotificationStreamRequest) GetRecipient() string {
+func (m *VerifyPINResponse) GetRefreshToken() string {
 	if m != nil {
-		return m.Recipient
+		return m.RefreshToken
 	}
 	return ""
 }
 
-type AckMessageNotificationStreamResponse struct {
-	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+func (m *VerifyPINResponse) GetExpiredAt() int64 {
+	if m != nil {
+		return m.ExpiredAt
+	}
+	return 0
+}
+
+type GetPINStatusRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AckMessageNotificationStreamResponse) Reset()         { *m = AckMessageNotificationStreamResponse{} }
-func (m *AckMessageNotificationStreamResponse) String() string { return proto.CompactTextString(m) }
-func (*AckMessageNotificationStreamResponse) ProtoMessage()    {}
-func (*AckMessageNotificationStreamResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{61}
+func (m *GetPINStatusRequest) Reset()         { *m = GetPINStatusRequest{} }
+func (m *GetPINStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPINStatusRequest) ProtoMessage()    {}
+func (*GetPINStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{76}
 }
-func (m *AckMessageNotificationStreamResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AckMessageNotificationStreamResponse.Unmarshal(m, b)
+func (m *GetPINStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPINStatusRequest.Unmarshal(m, b)
 }
-func (m *AckMessageNotificationStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AckMessageNotificationStreamResponse.Marshal(b, m, deterministic)
+func (m *GetPINStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPINStatusRequest.Marshal(b, m, deterministic)
 }
-func (dst *AckMessageNotificationStreamResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AckMessageNotificationStreamResponse.Merge(dst, src)
+func (dst *GetPINStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPINStatusRequest.Merge(dst, src)
 }
-func (m *AckMessageNotificationStreamResponse) XXX_Size() int {
-	return xxx_messageInfo_AckMessageNotificationStreamResponse.Size(m)
+func (m *GetPINStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPINStatusRequest.Size(m)
 }
-func (m *AckMessageNotificationStreamResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_AckMessageNotificationStreamResponse.DiscardUnknown(m)
+func (m *GetPINStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPINStatusRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AckMessageNotificationStreamResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetPINStatusRequest proto.InternalMessageInfo
 
-func (m *AckMessageNotificationStreamResponse) GetSuccess() bool {
+type GetPINStatusResponse struct {
+	IsSet                bool     `protobuf:"varint,1,opt,name=isSet,proto3" json:"isSet,omitempty"`
+	ReminderDue          bool     `protobuf:"varint,2,opt,name=reminderDue,proto3" json:"reminderDue,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPINStatusResponse) Reset()         { *m = GetPINStatusResponse{} }
+func (m *GetPINStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPINStatusResponse) ProtoMessage()    {}
+func (*GetPINStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{77}
+}
+func (m *GetPINStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPINStatusResponse.Unmarshal(m, b)
+}
+func (m *GetPINStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPINStatusResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetPINStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPINStatusResponse.Merge(dst, src)
+}
+func (m *GetPINStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPINStatusResponse.Size(m)
+}
+func (m *GetPINStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPINStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPINStatusResponse proto.InternalMessageInfo
+
+func (m *GetPINStatusResponse) GetIsSet() bool {
 	if m != nil {
-		return m.Success
+		return m.IsSet
 	}
 	return false
 }
 
-type GetMessagesResponseItem struct {
-	RecipientID          string   `protobuf:"bytes,1,opt,name=recipientID,proto3" json:"recipientID,omitempty"`
-	SenderID             string   `protobuf:"bytes,2,opt,name=senderID,proto3" json:"senderID,omitempty"`
-	SenderDeviceID       string   `protobuf:"bytes,3,opt,name=senderDeviceID,proto3" json:"senderDeviceID,omitempty"`
-	MessageID            int64    `protobuf:"varint,4,opt,name=messageID,proto3" json:"messageID,omitempty"`
-	MessageTimestamp     int64    `protobuf:"varint,5,opt,name=messageTimestamp,proto3" json:"messageTimestamp,omitempty"`
-	MessageContents      string   `protobuf:"bytes,6,opt,name=messageContents,proto3" json:"messageContents,omitempty"`
-	MessageEncrypted     bool     `protobuf:"varint,7,opt,name=messageEncrypted,proto3" json:"messageEncrypted,omitempty"`
+func (m *GetPINStatusResponse) GetReminderDue() bool {
+	if m != nil {
+		return m.ReminderDue
+	}
+	return false
+}
+
+type RequestEmailVerificationRequest struct {
+	Email                string   `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Locale               string   `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	Brand                string   `protobuf:"bytes,3,opt,name=brand,proto3" json:"brand,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetMessagesResponseItem) Reset()         { *m = GetMessagesResponseItem{} }
-func (m *GetMessagesResponseItem) String() string { return proto.CompactTextString(m) }
-func (*GetMessagesResponseItem) ProtoMessage()    {}
-func (*GetMessagesResponseItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{62}
+func (m *RequestEmailVerificationRequest) Reset()         { *m = RequestEmailVerificationRequest{} }
+func (m *RequestEmailVerificationRequest) String() string { return proto.CompactTextString(m) }
+func (*RequestEmailVerificationRequest) ProtoMessage()    {}
+func (*RequestEmailVerificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{78}
 }
-func (m *GetMessagesResponseItem) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetMessagesResponseItem.Unmarshal(m, b)
+func (m *RequestEmailVerificationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RequestEmailVerificationRequest.Unmarshal(m, b)
 }
-func (m *GetMessagesResponseItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetMessagesResponseItem.Marshal(b, m, deterministic)
+func (m *RequestEmailVerificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RequestEmailVerificationRequest.Marshal(b, m, deterministic)
 }
-func (dst *GetMessagesResponseItem) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetMessagesResponseItem.Merge(dst, src)
+func (dst *RequestEmailVerificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestEmailVerificationRequest.Merge(dst, src)
 }
-func (m *GetMessagesResponseItem) XXX_Size() int {
-	return xxx_messageInfo_GetMessagesResponseItem.Size(m)
+func (m *RequestEmailVerificationRequest) XXX_Size() int {
+	return xxx_messageInfo_RequestEmailVerificationRequest.Size(m)
 }
-func (m *GetMessagesResponseItem) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetMessagesResponseItem.DiscardUnknown(m)
+func (m *RequestEmailVerificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestEmailVerificationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetMessagesResponseItem proto.InternalMessageInfo
+var xxx_messageInfo_RequestEmailVerificationRequest proto.InternalMessageInfo
 
-func (m *GetMessagesResponseItem) GetRecipientID() string {
+func (m *RequestEmailVerificationRequest) GetEmail() string {
 	if m != nil {
-		return m.RecipientID
+		return m.Email
 	}
 	return ""
 }
 
-func (m *GetMessagesResponseItem) GetSenderID() string {
+func (m *RequestEmailVerificationRequest) GetLocale() string {
 	if m != nil {
-		return m.SenderID
+		return m.Locale
 	}
 	return ""
 }
 
-func (m *GetMessagesResponseItem) GetSenderDeviceID() string {
+func (m *RequestEmailVerificationRequest) GetBrand() string {
 	if m != nil {
-		return m.SenderDeviceID
+		return m.Brand
 	}
 	return ""
 }
 
-func (m *GetMessagesResponseItem) GetMessageID() int64 {
-	if m != nil {
-		return m.MessageID
-	}
-	return 0
+type RequestEmailVerificationResponse struct {
+	CodeDebug            string   `protobuf:"bytes,1,opt,name=codeDebug,proto3" json:"codeDebug,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetMessagesResponseItem) GetMessageTimestamp() int64 {
+func (m *RequestEmailVerificationResponse) Reset()         { *m = RequestEmailVerificationResponse{} }
+func (m *RequestEmailVerificationResponse) String() string { return proto.CompactTextString(m) }
+func (*RequestEmailVerificationResponse) ProtoMessage()    {}
+func (*RequestEmailVerificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{79}
+}
+func (m *RequestEmailVerificationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RequestEmailVerificationResponse.Unmarshal(m, b)
+}
+func (m *RequestEmailVerificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RequestEmailVerificationResponse.Marshal(b, m, deterministic)
+}
+func (dst *RequestEmailVerificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestEmailVerificationResponse.Merge(dst, src)
+}
+func (m *RequestEmailVerificationResponse) XXX_Size() int {
+	return xxx_messageInfo_RequestEmailVerificationResponse.Size(m)
+}
+func (m *RequestEmailVerificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestEmailVerificationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestEmailVerificationResponse proto.InternalMessageInfo
+
+func (m *RequestEmailVerificationResponse) GetCodeDebug() string {
 	if m != nil {
-		return m.MessageTimestamp
+		return m.CodeDebug
 	}
-	return 0
+	return ""
 }
 
-func (m *GetMessagesResponseItem) GetMessageContents() string {
+type VerifyEmailRequest struct {
+	Email                string   `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Code                 string   `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyEmailRequest) Reset()         { *m = VerifyEmailRequest{} }
+func (m *VerifyEmailRequest) String() string { return proto.CompactTextString(m) }
+func (*VerifyEmailRequest) ProtoMessage()    {}
+func (*VerifyEmailRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{80}
+}
+func (m *VerifyEmailRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyEmailRequest.Unmarshal(m, b)
+}
+func (m *VerifyEmailRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyEmailRequest.Marshal(b, m, deterministic)
+}
+func (dst *VerifyEmailRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyEmailRequest.Merge(dst, src)
+}
+func (m *VerifyEmailRequest) XXX_Size() int {
+	return xxx_messageInfo_VerifyEmailRequest.Size(m)
+}
+func (m *VerifyEmailRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyEmailRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyEmailRequest proto.InternalMessageInfo
+
+func (m *VerifyEmailRequest) GetEmail() string {
 	if m != nil {
-		return m.MessageContents
+		return m.Email
 	}
 	return ""
 }
 
-func (m *GetMessagesResponseItem) GetMessageEncrypted() bool {
+func (m *VerifyEmailRequest) GetCode() string {
 	if m != nil {
-		return m.MessageEncrypted
+		return m.Code
 	}
-	return false
+	return ""
 }
 
-type PutMessageResponse struct {
-	MessageID            int64    `protobuf:"varint,1,opt,name=messageID,proto3" json:"messageID,omitempty"`
-	MessageTimestamp     int64    `protobuf:"varint,2,opt,name=messageTimestamp,proto3" json:"messageTimestamp,omitempty"`
+type VerifyEmailResponse struct {
+	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PutMessageResponse) Reset()         { *m = PutMessageResponse{} }
-func (m *PutMessageResponse) String() string { return proto.CompactTextString(m) }
-func (*PutMessageResponse) ProtoMessage()    {}
-func (*PutMessageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{63}
+func (m *VerifyEmailResponse) Reset()         { *m = VerifyEmailResponse{} }
+func (m *VerifyEmailResponse) String() string { return proto.CompactTextString(m) }
+func (*VerifyEmailResponse) ProtoMessage()    {}
+func (*VerifyEmailResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{81}
 }
-func (m *PutMessageResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PutMessageResponse.Unmarshal(m, b)
+func (m *VerifyEmailResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyEmailResponse.Unmarshal(m, b)
 }
-func (m *PutMessageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PutMessageResponse.Marshal(b, m, deterministic)
+func (m *VerifyEmailResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyEmailResponse.Marshal(b, m, deterministic)
 }
-func (dst *PutMessageResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PutMessageResponse.Merge(dst, src)
+func (dst *VerifyEmailResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyEmailResponse.Merge(dst, src)
 }
-func (m *PutMessageResponse) XXX_Size() int {
-	return xxx_messageInfo_PutMessageResponse.Size(m)
+func (m *VerifyEmailResponse) XXX_Size() int {
+	return xxx_messageInfo_VerifyEmailResponse.Size(m)
 }
-func (m *PutMessageResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PutMessageResponse.DiscardUnknown(m)
+func (m *VerifyEmailResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyEmailResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PutMessageResponse proto.InternalMessageInfo
-
-func (m *PutMessageResponse) GetMessageID() int64 {
-	if m != nil {
-		return m.MessageID
-	}
-	return 0
-}
+var xxx_messageInfo_VerifyEmailResponse proto.InternalMessageInfo
 
-func (m *PutMessageResponse) GetMessageTimestamp() int64 {
+func (m *VerifyEmailResponse) GetSuccess() bool {
 	if m != nil {
-		return m.MessageTimestamp
+		return m.Success
 	}
-	return 0
+	return false
 }
 
-type PutMessageRequest struct {
-	RecipientID          string   `protobuf:"bytes,1,opt,name=recipientID,proto3" json:"recipientID,omitempty"`
-	MessageID            int64    `protobuf:"varint,2,opt,name=messageID,proto3" json:"messageID,omitempty"`
-	MessageTimestamp     int64    `protobuf:"varint,3,opt,name=messageTimestamp,proto3" json:"messageTimestamp,omitempty"`
-	MessageContents      string   `protobuf:"bytes,4,opt,name=messageContents,proto3" json:"messageContents,omitempty"`
-	MessageEncrypted     bool     `protobuf:"varint,5,opt,name=messageEncrypted,proto3" json:"messageEncrypted,omitempty"`
-	MessageExcerpt       string   `protobuf:"bytes,6,opt,name=messageExcerpt,proto3" json:"messageExcerpt,omitempty"`
-	MessageType          int64    `protobuf:"varint,7,opt,name=messageType,proto3" json:"messageType,omitempty"`
+type CreateProfileRequest struct {
+	DeviceID             string   `protobuf:"bytes,1,opt,name=deviceID,proto3" json:"deviceID,omitempty"`
+	PhoneNumber          string   `protobuf:"bytes,2,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
+	DeviceName           string   `protobuf:"bytes,3,opt,name=deviceName,proto3" json:"deviceName,omitempty"`
+	Platform             string   `protobuf:"bytes,4,opt,name=platform,proto3" json:"platform,omitempty"`
+	Locale               string   `protobuf:"bytes,5,opt,name=locale,proto3" json:"locale,omitempty"`
+	Brand                string   `protobuf:"bytes,6,opt,name=brand,proto3" json:"brand,omitempty"`
+	Channel              string   `protobuf:"bytes,7,opt,name=channel,proto3" json:"channel,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PutMessageRequest) Reset()         { *m = PutMessageRequest{} }
-func (m *PutMessageRequest) String() string { return proto.CompactTextString(m) }
-func (*PutMessageRequest) ProtoMessage()    {}
-func (*PutMessageRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{64}
+func (m *CreateProfileRequest) Reset()         { *m = CreateProfileRequest{} }
+func (m *CreateProfileRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateProfileRequest) ProtoMessage()    {}
+func (*CreateProfileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{82}
 }
-func (m *PutMessageRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PutMessageRequest.Unmarshal(m, b)
+func (m *CreateProfileRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateProfileRequest.Unmarshal(m, b)
 }
-func (m *PutMessageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PutMessageRequest.Marshal(b, m, deterministic)
+func (m *CreateProfileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateProfileRequest.Marshal(b, m, deterministic)
 }
-func (dst *PutMessageRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PutMessageRequest.Merge(dst, src)
+func (dst *CreateProfileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateProfileRequest.Merge(dst, src)
 }
-func (m *PutMessageRequest) XXX_Size() int {
-	return xxx_messageInfo_PutMessageRequest.Size(m)
+func (m *CreateProfileRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateProfileRequest.Size(m)
 }
-func (m *PutMessageRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PutMessageRequest.DiscardUnknown(m)
+func (m *CreateProfileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateProfileRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PutMessageRequest proto.InternalMessageInfo
+var xxx_messageInfo_CreateProfileRequest proto.InternalMessageInfo
 
-func (m *PutMessageRequest) GetRecipientID() string {
+func (m *CreateProfileRequest) GetDeviceID() string {
 	if m != nil {
-		return m.RecipientID
+		return m.DeviceID
 	}
 	return ""
 }
 
-func (m *PutMessageRequest) GetMessageID() int64 {
+func (m *CreateProfileRequest) GetPhoneNumber() string {
 	if m != nil {
-		return m.MessageID
+		return m.PhoneNumber
 	}
-	return 0
+	return ""
 }
 
-func (m *PutMessageRequest) GetMessageTimestamp() int64 {
+func (m *CreateProfileRequest) GetDeviceName() string {
 	if m != nil {
-		return m.MessageTimestamp
+		return m.DeviceName
 	}
-	return 0
+	return ""
 }
 
-func (m *PutMessageRequest) GetMessageContents() string {
+func (m *CreateProfileRequest) GetPlatform() string {
 	if m != nil {
-		return m.MessageContents
+		return m.Platform
 	}
 	return ""
 }
 
-func (m *PutMessageRequest) GetMessageEncrypted() bool {
+func (m *CreateProfileRequest) GetLocale() string {
 	if m != nil {
-		return m.MessageEncrypted
+		return m.Locale
 	}
-	return false
+	return ""
 }
 
-func (m *PutMessageRequest) GetMessageExcerpt() string {
+func (m *CreateProfileRequest) GetBrand() string {
 	if m != nil {
-		return m.MessageExcerpt
+		return m.Brand
 	}
 	return ""
 }
 
-func (m *PutMessageRequest) GetMessageType() int64 {
+func (m *CreateProfileRequest) GetChannel() string {
 	if m != nil {
-		return m.MessageType
+		return m.Channel
 	}
-	return 0
+	return ""
 }
 
-type PublicGetKeysRequest struct {
+type CreateProfileResponse struct {
 	UserID               string   `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	OtpDebug             string   `protobuf:"bytes,2,opt,name=otpDebug,proto3" json:"otpDebug,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PublicGetKeysRequest) Reset()         { *m = PublicGetKeysRequest{} }
-func (m *PublicGetKeysRequest) String() string { return proto.CompactTextString(m) }
-func (*PublicGetKeysRequest) ProtoMessage()    {}
-func (*PublicGetKeysRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{65}
+func (m *CreateProfileResponse) Reset()         { *m = CreateProfileResponse{} }
+func (m *CreateProfileResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateProfileResponse) ProtoMessage()    {}
+func (*CreateProfileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{83}
 }
-func (m *PublicGetKeysRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PublicGetKeysRequest.Unmarshal(m, b)
+func (m *CreateProfileResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateProfileResponse.Unmarshal(m, b)
 }
-func (m *PublicGetKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PublicGetKeysRequest.Marshal(b, m, deterministic)
+func (m *CreateProfileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateProfileResponse.Marshal(b, m, deterministic)
 }
-func (dst *PublicGetKeysRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PublicGetKeysRequest.Merge(dst, src)
+func (dst *CreateProfileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateProfileResponse.Merge(dst, src)
 }
-func (m *PublicGetKeysRequest) XXX_Size() int {
-	return xxx_messageInfo_PublicGetKeysRequest.Size(m)
+func (m *CreateProfileResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateProfileResponse.Size(m)
 }
-func (m *PublicGetKeysRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PublicGetKeysRequest.DiscardUnknown(m)
+func (m *CreateProfileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateProfileResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PublicGetKeysRequest proto.InternalMessageInfo
+var xxx_messageInfo_CreateProfileResponse proto.InternalMessageInfo
 
-func (m *PublicGetKeysRequest) GetUserID() string {
+func (m *CreateProfileResponse) GetUserID() string {
 	if m != nil {
 		return m.UserID
 	}
 	return ""
 }
 
-type PublicGetKeysResponse struct {
-	Keys                 []byte   `protobuf:"bytes,1,opt,name=keys,proto3" json:"keys,omitempty"`
+func (m *CreateProfileResponse) GetOtpDebug() string {
+	if m != nil {
+		return m.OtpDebug
+	}
+	return ""
+}
+
+type EditProfileRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	UserName             string   `protobuf:"bytes,2,opt,name=userName,proto3" json:"userName,omitempty"`
+	CustomData           string   `protobuf:"bytes,3,opt,name=customData,proto3" json:"customData,omitempty"`
+	AvatarMediaId        string   `protobuf:"bytes,4,opt,name=avatarMediaId,proto3" json:"avatarMediaId,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PublicGetKeysResponse) Reset()         { *m = PublicGetKeysResponse{} }
-func (m *PublicGetKeysResponse) String() string { return proto.CompactTextString(m) }
-func (*PublicGetKeysResponse) ProtoMessage()    {}
-func (*PublicGetKeysResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{66}
+func (m *EditProfileRequest) Reset()         { *m = EditProfileRequest{} }
+func (m *EditProfileRequest) String() string { return proto.CompactTextString(m) }
+func (*EditProfileRequest) ProtoMessage()    {}
+func (*EditProfileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{84}
 }
-func (m *PublicGetKeysResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PublicGetKeysResponse.Unmarshal(m, b)
+func (m *EditProfileRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EditProfileRequest.Unmarshal(m, b)
 }
-func (m *PublicGetKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PublicGetKeysResponse.Marshal(b, m, deterministic)
+func (m *EditProfileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EditProfileRequest.Marshal(b, m, deterministic)
 }
-func (dst *PublicGetKeysResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PublicGetKeysResponse.Merge(dst, src)
+func (dst *EditProfileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EditProfileRequest.Merge(dst, src)
 }
-func (m *PublicGetKeysResponse) XXX_Size() int {
-	return xxx_messageInfo_PublicGetKeysResponse.Size(m)
+func (m *EditProfileRequest) XXX_Size() int {
+	return xxx_messageInfo_EditProfileRequest.Size(m)
 }
-func (m *PublicGetKeysResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PublicGetKeysResponse.DiscardUnknown(m)
+func (m *EditProfileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EditProfileRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PublicGetKeysResponse proto.InternalMessageInfo
+var xxx_messageInfo_EditProfileRequest proto.InternalMessageInfo
 
-func (m *PublicGetKeysResponse) GetKeys() []byte {
+func (m *EditProfileRequest) GetName() string {
 	if m != nil {
-		return m.Keys
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-type PutKeysRequest struct {
-	UserID               string   `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	Keys                 []byte   `protobuf:"bytes,2,opt,name=keys,proto3" json:"keys,omitempty"`
+func (m *EditProfileRequest) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+func (m *EditProfileRequest) GetCustomData() string {
+	if m != nil {
+		return m.CustomData
+	}
+	return ""
+}
+
+func (m *EditProfileRequest) GetAvatarMediaId() string {
+	if m != nil {
+		return m.AvatarMediaId
+	}
+	return ""
+}
+
+type EditProfileResponse struct {
+	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message              string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PutKeysRequest) Reset()         { *m = PutKeysRequest{} }
-func (m *PutKeysRequest) String() string { return proto.CompactTextString(m) }
-func (*PutKeysRequest) ProtoMessage()    {}
-func (*PutKeysRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{67}
+func (m *EditProfileResponse) Reset()         { *m = EditProfileResponse{} }
+func (m *EditProfileResponse) String() string { return proto.CompactTextString(m) }
+func (*EditProfileResponse) ProtoMessage()    {}
+func (*EditProfileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{85}
 }
-func (m *PutKeysRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PutKeysRequest.Unmarshal(m, b)
+func (m *EditProfileResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EditProfileResponse.Unmarshal(m, b)
 }
-func (m *PutKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PutKeysRequest.Marshal(b, m, deterministic)
+func (m *EditProfileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EditProfileResponse.Marshal(b, m, deterministic)
 }
-func (dst *PutKeysRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PutKeysRequest.Merge(dst, src)
+func (dst *EditProfileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EditProfileResponse.Merge(dst, src)
 }
-func (m *PutKeysRequest) XXX_Size() int {
-	return xxx_messageInfo_PutKeysRequest.Size(m)
+func (m *EditProfileResponse) XXX_Size() int {
+	return xxx_messageInfo_EditProfileResponse.Size(m)
 }
-func (m *PutKeysRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PutKeysRequest.DiscardUnknown(m)
+func (m *EditProfileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EditProfileResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PutKeysRequest proto.InternalMessageInfo
+var xxx_messageInfo_EditProfileResponse proto.InternalMessageInfo
 
-func (m *PutKeysRequest) GetUserID() string {
+func (m *EditProfileResponse) GetSuccess() bool {
 	if m != nil {
-		return m.UserID
+		return m.Success
 	}
-	return ""
+	return false
 }
 
-func (m *PutKeysRequest) GetKeys() []byte {
+func (m *EditProfileResponse) GetMessage() string {
 	if m != nil {
-		return m.Keys
+		return m.Message
 	}
-	return nil
+	return ""
 }
 
-type PutKeysResponse struct {
+type GetProfileRequest struct {
 	UserID               string   `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PutKeysResponse) Reset()         { *m = PutKeysResponse{} }
-func (m *PutKeysResponse) String() string { return proto.CompactTextString(m) }
-func (*PutKeysResponse) ProtoMessage()    {}
-func (*PutKeysResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{68}
+func (m *GetProfileRequest) Reset()         { *m = GetProfileRequest{} }
+func (m *GetProfileRequest) String() string { return proto.CompactTextString(m) }
+func (*GetProfileRequest) ProtoMessage()    {}
+func (*GetProfileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{86}
 }
-func (m *PutKeysResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PutKeysResponse.Unmarshal(m, b)
+func (m *GetProfileRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetProfileRequest.Unmarshal(m, b)
 }
-func (m *PutKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PutKeysResponse.Marshal(b, m, deterministic)
+func (m *GetProfileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetProfileRequest.Marshal(b, m, deterministic)
 }
-func (dst *PutKeysResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PutKeysResponse.Merge(dst, src)
+func (dst *GetProfileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetProfileRequest.Merge(dst, src)
 }
-func (m *PutKeysResponse) XXX_Size() int {
-	return xxx_messageInfo_PutKeysResponse.Size(m)
+func (m *GetProfileRequest) XXX_Size() int {
+	return xxx_messageInfo_GetProfileRequest.Size(m)
 }
-func (m *PutKeysResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PutKeysResponse.DiscardUnknown(m)
+func (m *GetProfileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetProfileRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PutKeysResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetProfileRequest proto.InternalMessageInfo
 
-func (m *PutKeysResponse) GetUserID() string {
+func (m *GetProfileRequest) GetUserID() string {
 	if m != nil {
 		return m.UserID
 	}
 	return ""
 }
 
-type GroupParticipant struct {
-	UserID               string   `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+type GetProfileResponse struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	UserName             string   `protobuf:"bytes,2,opt,name=userName,proto3" json:"userName,omitempty"`
 	PhoneNumber          string   `protobuf:"bytes,3,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
-	UserName             string   `protobuf:"bytes,4,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
-	CustomData           string   `protobuf:"bytes,5,opt,name=customData,proto3" json:"customData,omitempty"`
-	IsAdmin              bool     `protobuf:"varint,6,opt,name=isAdmin,proto3" json:"isAdmin,omitempty"`
-	Avatar               string   `protobuf:"bytes,7,opt,name=avatar,proto3" json:"avatar,omitempty"`
-	AvatarThumbnail      []byte   `protobuf:"bytes,8,opt,name=avatarThumbnail,proto3" json:"avatarThumbnail,omitempty"`
+	CustomData           string   `protobuf:"bytes,4,opt,name=customData,proto3" json:"customData,omitempty"`
+	AvatarMediaId        string   `protobuf:"bytes,5,opt,name=avatarMediaId,proto3" json:"avatarMediaId,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GroupParticipant) Reset()         { *m = GroupParticipant{} }
-func (m *GroupParticipant) String() string { return proto.CompactTextString(m) }
-func (*GroupParticipant) ProtoMessage()    {}
-func (*GroupParticipant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{69}
+func (m *GetProfileResponse) Reset()         { *m = GetProfileResponse{} }
+func (m *GetProfileResponse) String() string { return proto.CompactTextString(m) }
+func (*GetProfileResponse) ProtoMessage()    {}
+func (*GetProfileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{87}
 }
-func (m *GroupParticipant) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GroupParticipant.Unmarshal(m, b)
+func (m *GetProfileResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetProfileResponse.Unmarshal(m, b)
 }
-func (m *GroupParticipant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GroupParticipant.Marshal(b, m, deterministic)
+func (m *GetProfileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetProfileResponse.Marshal(b, m, deterministic)
 }
-func (dst *GroupParticipant) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GroupParticipant.Merge(dst, src)
+func (dst *GetProfileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetProfileResponse.Merge(dst, src)
 }
-func (m *GroupParticipant) XXX_Size() int {
-	return xxx_messageInfo_GroupParticipant.Size(m)
+func (m *GetProfileResponse) XXX_Size() int {
+	return xxx_messageInfo_GetProfileResponse.Size(m)
 }
-func (m *GroupParticipant) XXX_DiscardUnknown() {
-	xxx_messageInfo_GroupParticipant.DiscardUnknown(m)
+func (m *GetProfileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetProfileResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GroupParticipant proto.InternalMessageInfo
-
-func (m *GroupParticipant) GetUserID() string {
-	if m != nil {
-		return m.UserID
-	}
-	return ""
-}
+var xxx_messageInfo_GetProfileResponse proto.InternalMessageInfo
 
-func (m *GroupParticipant) GetName() string {
+func (m *GetProfileResponse) GetName() string {
 	if m != nil {
 		return m.Name
 	}
 	return ""
 }
 
-func (m *GroupParticipant) GetPhoneNumber() string {
+func (m *GetProfileResponse) GetUserName() string {
 	if m != nil {
-		return m.PhoneNumber
+		return m.UserName
 	}
 	return ""
 }
 
-func (m *GroupParticipant) GetUserName() string {
+func (m *GetProfileResponse) GetPhoneNumber() string {
 	if m != nil {
-		return m.UserName
+		return m.PhoneNumber
 	}
 	return ""
 }
 
-func (m *GroupParticipant) GetCustomData() string {
+func (m *GetProfileResponse) GetCustomData() string {
 	if m != nil {
 		return m.CustomData
 	}
 	return ""
 }
 
-func (m *GroupParticipant) GetIsAdmin() bool {
-	if m != nil {
-		return m.IsAdmin
-	}
-	return false
-}
-
-func (m *GroupParticipant) GetAvatar() string {
+func (m *GetProfileResponse) GetAvatarMediaId() string {
 	if m != nil {
-		return m.Avatar
+		return m.AvatarMediaId
 	}
 	return ""
 }
 
-func (m *GroupParticipant) GetAvatarThumbnail() []byte {
-	if m != nil {
-		return m.AvatarThumbnail
-	}
-	return nil
-}
-
-type CreateGroupConversationRequest struct {
-	Name                 string              `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Avatar               string              `protobuf:"bytes,2,opt,name=avatar,proto3" json:"avatar,omitempty"`
-	Participants         []*GroupParticipant `protobuf:"bytes,3,rep,name=participants,proto3" json:"participants,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+type ListConversationsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateGroupConversationRequest) Reset()         { *m = CreateGroupConversationRequest{} }
-func (m *CreateGroupConversationRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateGroupConversationRequest) ProtoMessage()    {}
-func (*CreateGroupConversationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{70}
+func (m *ListConversationsRequest) Reset()         { *m = ListConversationsRequest{} }
+func (m *ListConversationsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListConversationsRequest) ProtoMessage()    {}
+func (*ListConversationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{88}
 }
-func (m *CreateGroupConversationRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateGroupConversationRequest.Unmarshal(m, b)
+func (m *ListConversationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListConversationsRequest.Unmarshal(m, b)
 }
-func (m *CreateGroupConversationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateGroupConversationRequest.Marshal(b, m, deterministic)
+func (m *ListConversationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListConversationsRequest.Marshal(b, m, deterministic)
 }
-func (dst *CreateGroupConversationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateGroupConversationRequest.Merge(dst, src)
+func (dst *ListConversationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListConversationsRequest.Merge(dst, src)
 }
-func (m *CreateGroupConversationRequest) XXX_Size() int {
-	return xxx_messageInfo_CreateGroupConversationRequest.Size(m)
+func (m *ListConversationsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListConversationsRequest.Size(m)
 }
-func (m *CreateGroupConversationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateGroupConversationRequest.DiscardUnknown(m)
+func (m *ListConversationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListConversationsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateGroupConversationRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListConversationsRequest proto.InternalMessageInfo
 
-func (m *CreateGroupConversationRequest) GetName() string {
-	if m != nil {
-		return m.Name
-	}
-	return ""
+type ListConversationsResponse struct {
+	List                 []*Conversations `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *CreateGroupConversationRequest) GetAvatar() string {
-	if m != nil {
-		return m.Avatar
-	}
-	return ""
+func (m *ListConversationsResponse) Reset()         { *m = ListConversationsResponse{} }
+func (m *ListConversationsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListConversationsResponse) ProtoMessage()    {}
+func (*ListConversationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{89}
+}
+func (m *ListConversationsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListConversationsResponse.Unmarshal(m, b)
+}
+func (m *ListConversationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListConversationsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListConversationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListConversationsResponse.Merge(dst, src)
+}
+func (m *ListConversationsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListConversationsResponse.Size(m)
+}
+func (m *ListConversationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListConversationsResponse.DiscardUnknown(m)
 }
 
-func (m *CreateGroupConversationRequest) GetParticipants() []*GroupParticipant {
+var xxx_messageInfo_ListConversationsResponse proto.InternalMessageInfo
+
+func (m *ListConversationsResponse) GetList() []*Conversations {
 	if m != nil {
-		return m.Participants
+		return m.List
 	}
 	return nil
 }
 
-type CreateGroupConversationResponse struct {
-	GroupID              string   `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID,omitempty"`
+type Conversations struct {
+	ChatID               string   `protobuf:"bytes,1,opt,name=chatID,proto3" json:"chatID,omitempty"`
+	ChatName             string   `protobuf:"bytes,2,opt,name=chatName,proto3" json:"chatName,omitempty"`
+	Excerpt              string   `protobuf:"bytes,3,opt,name=excerpt,proto3" json:"excerpt,omitempty"`
+	Timestamp            int64    `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Notification         int64    `protobuf:"varint,5,opt,name=notification,proto3" json:"notification,omitempty"`
+	ChatType             int32    `protobuf:"varint,6,opt,name=chatType,proto3" json:"chatType,omitempty"`
+	IsGroupAdmin         bool     `protobuf:"varint,7,opt,name=isGroupAdmin,proto3" json:"isGroupAdmin,omitempty"`
+	Avatar               string   `protobuf:"bytes,8,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	AvatarThumbnail      []byte   `protobuf:"bytes,9,opt,name=avatarThumbnail,proto3" json:"avatarThumbnail,omitempty"`
+	PhoneNumber          string   `protobuf:"bytes,10,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
+	UserName             string   `protobuf:"bytes,11,opt,name=userName,proto3" json:"userName,omitempty"`
+	CustomData           string   `protobuf:"bytes,12,opt,name=customData,proto3" json:"customData,omitempty"`
+	Description          string   `protobuf:"bytes,13,opt,name=description,proto3" json:"description,omitempty"`
+	Topic                string   `protobuf:"bytes,14,opt,name=topic,proto3" json:"topic,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateGroupConversationResponse) Reset()         { *m = CreateGroupConversationResponse{} }
-func (m *CreateGroupConversationResponse) String() string { return proto.CompactTextString(m) }
-func (*CreateGroupConversationResponse) ProtoMessage()    {}
-func (*CreateGroupConversationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{71}
+func (m *Conversations) Reset()         { *m = Conversations{} }
+func (m *Conversations) String() string { return proto.CompactTextString(m) }
+func (*Conversations) ProtoMessage()    {}
+func (*Conversations) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{90}
 }
-func (m *CreateGroupConversationResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateGroupConversationResponse.Unmarshal(m, b)
+func (m *Conversations) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Conversations.Unmarshal(m, b)
 }
-func (m *CreateGroupConversationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateGroupConversationResponse.Marshal(b, m, deterministic)
+func (m *Conversations) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Conversations.Marshal(b, m, deterministic)
 }
-func (dst *CreateGroupConversationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateGroupConversationResponse.Merge(dst, src)
+func (dst *Conversations) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Conversations.Merge(dst, src)
 }
-func (m *CreateGroupConversationResponse) XXX_Size() int {
-	return xxx_messageInfo_CreateGroupConversationResponse.Size(m)
+func (m *Conversations) XXX_Size() int {
+	return xxx_messageInfo_Conversations.Size(m)
 }
-func (m *CreateGroupConversationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateGroupConversationResponse.DiscardUnknown(m)
+func (m *Conversations) XXX_DiscardUnknown() {
+	xxx_messageInfo_Conversations.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateGroupConversationResponse proto.InternalMessageInfo
+var xxx_messageInfo_Conversations proto.InternalMessageInfo
 
-func (m *CreateGroupConversationResponse) GetGroupID() string {
+func (m *Conversations) GetChatID() string {
 	if m != nil {
-		return m.GroupID
+		return m.ChatID
 	}
 	return ""
 }
 
-type RegisterFCMRequest struct {
-	FCMToken             string   `protobuf:"bytes,1,opt,name=FCMToken,proto3" json:"FCMToken,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *Conversations) GetChatName() string {
+	if m != nil {
+		return m.ChatName
+	}
+	return ""
 }
 
-func (m *RegisterFCMRequest) Reset()         { *m = RegisterFCMRequest{} }
-func (m *RegisterFCMRequest) String() string { return proto.CompactTextString(m) }
-func (*RegisterFCMRequest) ProtoMessage()    {}
-func (*RegisterFCMRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{72}
+func (m *Conversations) GetExcerpt() string {
+	if m != nil {
+		return m.Excerpt
+	}
+	return ""
 }
-func (m *RegisterFCMRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RegisterFCMRequest.Unmarshal(m, b)
+
+func (m *Conversations) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
 }
-func (m *RegisterFCMRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RegisterFCMRequest.Marshal(b, m, deterministic)
+
+func (m *Conversations) GetNotification() int64 {
+	if m != nil {
+		return m.Notification
+	}
+	return 0
 }
-func (dst *RegisterFCMRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RegisterFCMRequest.Merge(dst, src)
+
+func (m *Conversations) GetChatType() int32 {
+	if m != nil {
+		return m.ChatType
+	}
+	return 0
 }
-func (m *RegisterFCMRequest) XXX_Size() int {
-	return xxx_messageInfo_RegisterFCMRequest.Size(m)
+
+func (m *Conversations) GetIsGroupAdmin() bool {
+	if m != nil {
+		return m.IsGroupAdmin
+	}
+	return false
 }
-func (m *RegisterFCMRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RegisterFCMRequest.DiscardUnknown(m)
+
+func (m *Conversations) GetAvatar() string {
+	if m != nil {
+		return m.Avatar
+	}
+	return ""
 }
 
-var xxx_messageInfo_RegisterFCMRequest proto.InternalMessageInfo
+func (m *Conversations) GetAvatarThumbnail() []byte {
+	if m != nil {
+		return m.AvatarThumbnail
+	}
+	return nil
+}
 
-func (m *RegisterFCMRequest) GetFCMToken() string {
+func (m *Conversations) GetPhoneNumber() string {
 	if m != nil {
-		return m.FCMToken
+		return m.PhoneNumber
 	}
 	return ""
 }
 
-type RegisterFCMResponse struct {
-	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+func (m *Conversations) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+func (m *Conversations) GetCustomData() string {
+	if m != nil {
+		return m.CustomData
+	}
+	return ""
+}
+
+func (m *Conversations) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Conversations) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+type UpdateConversationRequest struct {
+	ChatID               string   `protobuf:"bytes,1,opt,name=chatID,proto3" json:"chatID,omitempty"`
+	Excerpt              string   `protobuf:"bytes,2,opt,name=excerpt,proto3" json:"excerpt,omitempty"`
+	Timestamp            int64    `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RegisterFCMResponse) Reset()         { *m = RegisterFCMResponse{} }
-func (m *RegisterFCMResponse) String() string { return proto.CompactTextString(m) }
-func (*RegisterFCMResponse) ProtoMessage()    {}
-func (*RegisterFCMResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ngobrel_1a3351a33146eeae, []int{73}
+func (m *UpdateConversationRequest) Reset()         { *m = UpdateConversationRequest{} }
+func (m *UpdateConversationRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateConversationRequest) ProtoMessage()    {}
+func (*UpdateConversationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{91}
 }
-func (m *RegisterFCMResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RegisterFCMResponse.Unmarshal(m, b)
+func (m *UpdateConversationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateConversationRequest.Unmarshal(m, b)
 }
-func (m *RegisterFCMResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RegisterFCMResponse.Marshal(b, m, deterministic)
+func (m *UpdateConversationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateConversationRequest.Marshal(b, m, deterministic)
 }
-func (dst *RegisterFCMResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RegisterFCMResponse.Merge(dst, src)
+func (dst *UpdateConversationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateConversationRequest.Merge(dst, src)
 }
-func (m *RegisterFCMResponse) XXX_Size() int {
-	return xxx_messageInfo_RegisterFCMResponse.Size(m)
+func (m *UpdateConversationRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateConversationRequest.Size(m)
 }
-func (m *RegisterFCMResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RegisterFCMResponse.DiscardUnknown(m)
+func (m *UpdateConversationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateConversationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RegisterFCMResponse proto.InternalMessageInfo
+var xxx_messageInfo_UpdateConversationRequest proto.InternalMessageInfo
 
-func (m *RegisterFCMResponse) GetSuccess() bool {
+func (m *UpdateConversationRequest) GetChatID() string {
 	if m != nil {
-		return m.Success
+		return m.ChatID
 	}
-	return false
+	return ""
 }
 
-func init() {
-	proto.RegisterType((*BlockContactRequest)(nil), "BlockContactRequest")
-	proto.RegisterType((*BlockContactResponse)(nil), "BlockContactResponse")
-	proto.RegisterType((*UnblockContactRequest)(nil), "UnblockContactRequest")
-	proto.RegisterType((*UnblockContactResponse)(nil), "UnblockContactResponse")
-	proto.RegisterType((*GetProfilePictureRequest)(nil), "GetProfilePictureRequest")
-	proto.RegisterType((*GetProfilePictureResponse)(nil), "GetProfilePictureResponse")
-	proto.RegisterType((*EchoRequest)(nil), "EchoRequest")
-	proto.RegisterType((*EchoResponse)(nil), "EchoResponse")
-	proto.RegisterType((*RenameGroupRequest)(nil), "RenameGroupRequest")
-	proto.RegisterType((*RenameGroupResponse)(nil), "RenameGroupResponse")
-	proto.RegisterType((*ExitFromGroupRequest)(nil), "ExitFromGroupRequest")
-	proto.RegisterType((*ExitFromGroupResponse)(nil), "ExitFromGroupResponse")
-	proto.RegisterType((*RemoveAdminRoleRequest)(nil), "RemoveAdminRoleRequest")
-	proto.RegisterType((*RemoveAdminRoleResponse)(nil), "RemoveAdminRoleResponse")
-	proto.RegisterType((*RemoveFromGroupRequest)(nil), "RemoveFromGroupRequest")
-	proto.RegisterType((*RemoveFromGroupResponse)(nil), "RemoveFromGroupResponse")
-	proto.RegisterType((*ListGroupParticipantsRequest)(nil), "ListGroupParticipantsRequest")
-	proto.RegisterType((*ListGroupParticipantsResponse)(nil), "ListGroupParticipantsResponse")
-	proto.RegisterType((*VerifyOTPRequest)(nil), "VerifyOTPRequest")
-	proto.RegisterType((*VerifyOTPResponse)(nil), "VerifyOTPResponse")
-	proto.RegisterType((*CreateProfileRequest)(nil), "CreateProfileRequest")
-	proto.RegisterType((*CreateProfileResponse)(nil), "CreateProfileResponse")
-	proto.RegisterType((*EditProfileRequest)(nil), "EditProfileRequest")
-	proto.RegisterType((*EditProfileResponse)(nil), "EditProfileResponse")
-	proto.RegisterType((*GetProfileRequest)(nil), "GetProfileRequest")
-	proto.RegisterType((*GetProfileResponse)(nil), "GetProfileResponse")
-	proto.RegisterType((*ListConversationsRequest)(nil), "ListConversationsRequest")
-	proto.RegisterType((*ListConversationsResponse)(nil), "ListConversationsResponse")
-	proto.RegisterType((*Conversations)(nil), "Conversations")
-	proto.RegisterType((*UpdateConversationRequest)(nil), "UpdateConversationRequest")
-	proto.RegisterType((*UpdateConversationResponse)(nil), "UpdateConversationResponse")
-	proto.RegisterType((*DeleteContactRequest)(nil), "DeleteContactRequest")
-	proto.RegisterType((*DeleteContactResponse)(nil), "DeleteContactResponse")
-	proto.RegisterType((*GetContactsRequest)(nil), "GetContactsRequest")
-	proto.RegisterType((*GetContactsResponse)(nil), "GetContactsResponse")
+func (m *UpdateConversationRequest) GetExcerpt() string {
+	if m != nil {
+		return m.Excerpt
+	}
+	return ""
+}
+
+func (m *UpdateConversationRequest) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type UpdateConversationResponse struct {
+	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message              string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateConversationResponse) Reset()         { *m = UpdateConversationResponse{} }
+func (m *UpdateConversationResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateConversationResponse) ProtoMessage()    {}
+func (*UpdateConversationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{92}
+}
+func (m *UpdateConversationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateConversationResponse.Unmarshal(m, b)
+}
+func (m *UpdateConversationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateConversationResponse.Marshal(b, m, deterministic)
+}
+func (dst *UpdateConversationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateConversationResponse.Merge(dst, src)
+}
+func (m *UpdateConversationResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateConversationResponse.Size(m)
+}
+func (m *UpdateConversationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateConversationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateConversationResponse proto.InternalMessageInfo
+
+func (m *UpdateConversationResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *UpdateConversationResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type DeleteContactRequest struct {
+	UserID               string   `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteContactRequest) Reset()         { *m = DeleteContactRequest{} }
+func (m *DeleteContactRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteContactRequest) ProtoMessage()    {}
+func (*DeleteContactRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{93}
+}
+func (m *DeleteContactRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteContactRequest.Unmarshal(m, b)
+}
+func (m *DeleteContactRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteContactRequest.Marshal(b, m, deterministic)
+}
+func (dst *DeleteContactRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteContactRequest.Merge(dst, src)
+}
+func (m *DeleteContactRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteContactRequest.Size(m)
+}
+func (m *DeleteContactRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteContactRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteContactRequest proto.InternalMessageInfo
+
+func (m *DeleteContactRequest) GetUserID() string {
+	if m != nil {
+		return m.UserID
+	}
+	return ""
+}
+
+type DeleteContactResponse struct {
+	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message              string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteContactResponse) Reset()         { *m = DeleteContactResponse{} }
+func (m *DeleteContactResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteContactResponse) ProtoMessage()    {}
+func (*DeleteContactResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{94}
+}
+func (m *DeleteContactResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteContactResponse.Unmarshal(m, b)
+}
+func (m *DeleteContactResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteContactResponse.Marshal(b, m, deterministic)
+}
+func (dst *DeleteContactResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteContactResponse.Merge(dst, src)
+}
+func (m *DeleteContactResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteContactResponse.Size(m)
+}
+func (m *DeleteContactResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteContactResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteContactResponse proto.InternalMessageInfo
+
+func (m *DeleteContactResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *DeleteContactResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type GetContactsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetContactsRequest) Reset()         { *m = GetContactsRequest{} }
+func (m *GetContactsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetContactsRequest) ProtoMessage()    {}
+func (*GetContactsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{95}
+}
+func (m *GetContactsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetContactsRequest.Unmarshal(m, b)
+}
+func (m *GetContactsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetContactsRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetContactsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetContactsRequest.Merge(dst, src)
+}
+func (m *GetContactsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetContactsRequest.Size(m)
+}
+func (m *GetContactsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetContactsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetContactsRequest proto.InternalMessageInfo
+
+type GetContactsResponse struct {
+	List                 []*Contacts `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *GetContactsResponse) Reset()         { *m = GetContactsResponse{} }
+func (m *GetContactsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetContactsResponse) ProtoMessage()    {}
+func (*GetContactsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{96}
+}
+func (m *GetContactsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetContactsResponse.Unmarshal(m, b)
+}
+func (m *GetContactsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetContactsResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetContactsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetContactsResponse.Merge(dst, src)
+}
+func (m *GetContactsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetContactsResponse.Size(m)
+}
+func (m *GetContactsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetContactsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetContactsResponse proto.InternalMessageInfo
+
+func (m *GetContactsResponse) GetList() []*Contacts {
+	if m != nil {
+		return m.List
+	}
+	return nil
+}
+
+type Contacts struct {
+	PeerID               string   `protobuf:"bytes,1,opt,name=peerID,proto3" json:"peerID,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Notification         int64    `protobuf:"varint,3,opt,name=notification,proto3" json:"notification,omitempty"`
+	AvatarThumbnail      []byte   `protobuf:"bytes,4,opt,name=avatar_thumbnail,json=avatarThumbnail,proto3" json:"avatar_thumbnail,omitempty"`
+	PhoneNumber          string   `protobuf:"bytes,5,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
+	UserName             string   `protobuf:"bytes,6,opt,name=userName,proto3" json:"userName,omitempty"`
+	CustomData           string   `protobuf:"bytes,7,opt,name=customData,proto3" json:"customData,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Contacts) Reset()         { *m = Contacts{} }
+func (m *Contacts) String() string { return proto.CompactTextString(m) }
+func (*Contacts) ProtoMessage()    {}
+func (*Contacts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{97}
+}
+func (m *Contacts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Contacts.Unmarshal(m, b)
+}
+func (m *Contacts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Contacts.Marshal(b, m, deterministic)
+}
+func (dst *Contacts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Contacts.Merge(dst, src)
+}
+func (m *Contacts) XXX_Size() int {
+	return xxx_messageInfo_Contacts.Size(m)
+}
+func (m *Contacts) XXX_DiscardUnknown() {
+	xxx_messageInfo_Contacts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Contacts proto.InternalMessageInfo
+
+func (m *Contacts) GetPeerID() string {
+	if m != nil {
+		return m.PeerID
+	}
+	return ""
+}
+
+func (m *Contacts) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Contacts) GetNotification() int64 {
+	if m != nil {
+		return m.Notification
+	}
+	return 0
+}
+
+func (m *Contacts) GetAvatarThumbnail() []byte {
+	if m != nil {
+		return m.AvatarThumbnail
+	}
+	return nil
+}
+
+func (m *Contacts) GetPhoneNumber() string {
+	if m != nil {
+		return m.PhoneNumber
+	}
+	return ""
+}
+
+func (m *Contacts) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+func (m *Contacts) GetCustomData() string {
+	if m != nil {
+		return m.CustomData
+	}
+	return ""
+}
+
+type PutContactRequest struct {
+	PhoneNumber          string    `protobuf:"bytes,1,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
+	ContactData          *Contacts `protobuf:"bytes,2,opt,name=contactData,proto3" json:"contactData,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *PutContactRequest) Reset()         { *m = PutContactRequest{} }
+func (m *PutContactRequest) String() string { return proto.CompactTextString(m) }
+func (*PutContactRequest) ProtoMessage()    {}
+func (*PutContactRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{98}
+}
+func (m *PutContactRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PutContactRequest.Unmarshal(m, b)
+}
+func (m *PutContactRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PutContactRequest.Marshal(b, m, deterministic)
+}
+func (dst *PutContactRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PutContactRequest.Merge(dst, src)
+}
+func (m *PutContactRequest) XXX_Size() int {
+	return xxx_messageInfo_PutContactRequest.Size(m)
+}
+func (m *PutContactRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PutContactRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PutContactRequest proto.InternalMessageInfo
+
+func (m *PutContactRequest) GetPhoneNumber() string {
+	if m != nil {
+		return m.PhoneNumber
+	}
+	return ""
+}
+
+func (m *PutContactRequest) GetContactData() *Contacts {
+	if m != nil {
+		return m.ContactData
+	}
+	return nil
+}
+
+type PutContactResponse struct {
+	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message              string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PutContactResponse) Reset()         { *m = PutContactResponse{} }
+func (m *PutContactResponse) String() string { return proto.CompactTextString(m) }
+func (*PutContactResponse) ProtoMessage()    {}
+func (*PutContactResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{99}
+}
+func (m *PutContactResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PutContactResponse.Unmarshal(m, b)
+}
+func (m *PutContactResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PutContactResponse.Marshal(b, m, deterministic)
+}
+func (dst *PutContactResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PutContactResponse.Merge(dst, src)
+}
+func (m *PutContactResponse) XXX_Size() int {
+	return xxx_messageInfo_PutContactResponse.Size(m)
+}
+func (m *PutContactResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PutContactResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PutContactResponse proto.InternalMessageInfo
+
+func (m *PutContactResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *PutContactResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type GetMessageReceptionStateRequest struct {
+	UserID               string   `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	MessageID            string   `protobuf:"bytes,2,opt,name=messageID,proto3" json:"messageID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetMessageReceptionStateRequest) Reset()         { *m = GetMessageReceptionStateRequest{} }
+func (m *GetMessageReceptionStateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetMessageReceptionStateRequest) ProtoMessage()    {}
+func (*GetMessageReceptionStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{100}
+}
+func (m *GetMessageReceptionStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMessageReceptionStateRequest.Unmarshal(m, b)
+}
+func (m *GetMessageReceptionStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMessageReceptionStateRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetMessageReceptionStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMessageReceptionStateRequest.Merge(dst, src)
+}
+func (m *GetMessageReceptionStateRequest) XXX_Size() int {
+	return xxx_messageInfo_GetMessageReceptionStateRequest.Size(m)
+}
+func (m *GetMessageReceptionStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMessageReceptionStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMessageReceptionStateRequest proto.InternalMessageInfo
+
+func (m *GetMessageReceptionStateRequest) GetUserID() string {
+	if m != nil {
+		return m.UserID
+	}
+	return ""
+}
+
+func (m *GetMessageReceptionStateRequest) GetMessageID() string {
+	if m != nil {
+		return m.MessageID
+	}
+	return ""
+}
+
+type GetMessageReceptionStateResponse struct {
+	Status               MessageReceptionState `protobuf:"varint,1,opt,name=status,proto3,enum=MessageReceptionState" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetMessageReceptionStateResponse) Reset()         { *m = GetMessageReceptionStateResponse{} }
+func (m *GetMessageReceptionStateResponse) String() string { return proto.CompactTextString(m) }
+func (*GetMessageReceptionStateResponse) ProtoMessage()    {}
+func (*GetMessageReceptionStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{101}
+}
+func (m *GetMessageReceptionStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMessageReceptionStateResponse.Unmarshal(m, b)
+}
+func (m *GetMessageReceptionStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMessageReceptionStateResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetMessageReceptionStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMessageReceptionStateResponse.Merge(dst, src)
+}
+func (m *GetMessageReceptionStateResponse) XXX_Size() int {
+	return xxx_messageInfo_GetMessageReceptionStateResponse.Size(m)
+}
+func (m *GetMessageReceptionStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMessageReceptionStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMessageReceptionStateResponse proto.InternalMessageInfo
+
+func (m *GetMessageReceptionStateResponse) GetStatus() MessageReceptionState {
+	if m != nil {
+		return m.Status
+	}
+	return MessageReceptionState_Draft
+}
+
+type GetMessageStateRequest struct {
+	UserID               string   `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	MessageID            string   `protobuf:"bytes,2,opt,name=messageID,proto3" json:"messageID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetMessageStateRequest) Reset()         { *m = GetMessageStateRequest{} }
+func (m *GetMessageStateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetMessageStateRequest) ProtoMessage()    {}
+func (*GetMessageStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{102}
+}
+func (m *GetMessageStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMessageStateRequest.Unmarshal(m, b)
+}
+func (m *GetMessageStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMessageStateRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetMessageStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMessageStateRequest.Merge(dst, src)
+}
+func (m *GetMessageStateRequest) XXX_Size() int {
+	return xxx_messageInfo_GetMessageStateRequest.Size(m)
+}
+func (m *GetMessageStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMessageStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMessageStateRequest proto.InternalMessageInfo
+
+func (m *GetMessageStateRequest) GetUserID() string {
+	if m != nil {
+		return m.UserID
+	}
+	return ""
+}
+
+func (m *GetMessageStateRequest) GetMessageID() string {
+	if m != nil {
+		return m.MessageID
+	}
+	return ""
+}
+
+type GetMessageStateResponse struct {
+	State                MessageState `protobuf:"varint,1,opt,name=state,proto3,enum=MessageState" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *GetMessageStateResponse) Reset()         { *m = GetMessageStateResponse{} }
+func (m *GetMessageStateResponse) String() string { return proto.CompactTextString(m) }
+func (*GetMessageStateResponse) ProtoMessage()    {}
+func (*GetMessageStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{103}
+}
+func (m *GetMessageStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMessageStateResponse.Unmarshal(m, b)
+}
+func (m *GetMessageStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMessageStateResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetMessageStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMessageStateResponse.Merge(dst, src)
+}
+func (m *GetMessageStateResponse) XXX_Size() int {
+	return xxx_messageInfo_GetMessageStateResponse.Size(m)
+}
+func (m *GetMessageStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMessageStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMessageStateResponse proto.InternalMessageInfo
+
+func (m *GetMessageStateResponse) GetState() MessageState {
+	if m != nil {
+		return m.State
+	}
+	return MessageState_Normal
+}
+
+type PutMessageStateRequest struct {
+	MessageID            int64                 `protobuf:"varint,1,opt,name=messageID,proto3" json:"messageID,omitempty"`
+	Status               MessageReceptionState `protobuf:"varint,2,opt,name=status,proto3,enum=MessageReceptionState" json:"status,omitempty"`
+	ChatID               string                `protobuf:"bytes,3,opt,name=chatID,proto3" json:"chatID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *PutMessageStateRequest) Reset()         { *m = PutMessageStateRequest{} }
+func (m *PutMessageStateRequest) String() string { return proto.CompactTextString(m) }
+func (*PutMessageStateRequest) ProtoMessage()    {}
+func (*PutMessageStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{104}
+}
+func (m *PutMessageStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PutMessageStateRequest.Unmarshal(m, b)
+}
+func (m *PutMessageStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PutMessageStateRequest.Marshal(b, m, deterministic)
+}
+func (dst *PutMessageStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PutMessageStateRequest.Merge(dst, src)
+}
+func (m *PutMessageStateRequest) XXX_Size() int {
+	return xxx_messageInfo_PutMessageStateRequest.Size(m)
+}
+func (m *PutMessageStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PutMessageStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PutMessageStateRequest proto.InternalMessageInfo
+
+func (m *PutMessageStateRequest) GetMessageID() int64 {
+	if m != nil {
+		return m.MessageID
+	}
+	return 0
+}
+
+func (m *PutMessageStateRequest) GetStatus() MessageReceptionState {
+	if m != nil {
+		return m.Status
+	}
+	return MessageReceptionState_Draft
+}
+
+func (m *PutMessageStateRequest) GetChatID() string {
+	if m != nil {
+		return m.ChatID
+	}
+	return ""
+}
+
+type PutMessageStateResponse struct {
+	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PutMessageStateResponse) Reset()         { *m = PutMessageStateResponse{} }
+func (m *PutMessageStateResponse) String() string { return proto.CompactTextString(m) }
+func (*PutMessageStateResponse) ProtoMessage()    {}
+func (*PutMessageStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{105}
+}
+func (m *PutMessageStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PutMessageStateResponse.Unmarshal(m, b)
+}
+func (m *PutMessageStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PutMessageStateResponse.Marshal(b, m, deterministic)
+}
+func (dst *PutMessageStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PutMessageStateResponse.Merge(dst, src)
+}
+func (m *PutMessageStateResponse) XXX_Size() int {
+	return xxx_messageInfo_PutMessageStateResponse.Size(m)
+}
+func (m *PutMessageStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PutMessageStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PutMessageStateResponse proto.InternalMessageInfo
+
+func (m *PutMessageStateResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+type PutMessageReceptionStateRequest struct {
+	MessageID            string                `protobuf:"bytes,1,opt,name=messageID,proto3" json:"messageID,omitempty"`
+	Status               MessageReceptionState `protobuf:"varint,2,opt,name=status,proto3,enum=MessageReceptionState" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *PutMessageReceptionStateRequest) Reset()         { *m = PutMessageReceptionStateRequest{} }
+func (m *PutMessageReceptionStateRequest) String() string { return proto.CompactTextString(m) }
+func (*PutMessageReceptionStateRequest) ProtoMessage()    {}
+func (*PutMessageReceptionStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{106}
+}
+func (m *PutMessageReceptionStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PutMessageReceptionStateRequest.Unmarshal(m, b)
+}
+func (m *PutMessageReceptionStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PutMessageReceptionStateRequest.Marshal(b, m, deterministic)
+}
+func (dst *PutMessageReceptionStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PutMessageReceptionStateRequest.Merge(dst, src)
+}
+func (m *PutMessageReceptionStateRequest) XXX_Size() int {
+	return xxx_messageInfo_PutMessageReceptionStateRequest.Size(m)
+}
+func (m *PutMessageReceptionStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PutMessageReceptionStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PutMessageReceptionStateRequest proto.InternalMessageInfo
+
+func (m *PutMessageReceptionStateRequest) GetMessageID() string {
+	if m != nil {
+		return m.MessageID
+	}
+	return ""
+}
+
+func (m *PutMessageReceptionStateRequest) GetStatus() MessageReceptionState {
+	if m != nil {
+		return m.Status
+	}
+	return MessageReceptionState_Draft
+}
+
+type PutMessageReceptionStateResponse struct {
+	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PutMessageReceptionStateResponse) Reset()         { *m = PutMessageReceptionStateResponse{} }
+func (m *PutMessageReceptionStateResponse) String() string { return proto.CompactTextString(m) }
+func (*PutMessageReceptionStateResponse) ProtoMessage()    {}
+func (*PutMessageReceptionStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{107}
+}
+func (m *PutMessageReceptionStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PutMessageReceptionStateResponse.Unmarshal(m, b)
+}
+func (m *PutMessageReceptionStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PutMessageReceptionStateResponse.Marshal(b, m, deterministic)
+}
+func (dst *PutMessageReceptionStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PutMessageReceptionStateResponse.Merge(dst, src)
+}
+func (m *PutMessageReceptionStateResponse) XXX_Size() int {
+	return xxx_messageInfo_PutMessageReceptionStateResponse.Size(m)
+}
+func (m *PutMessageReceptionStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PutMessageReceptionStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PutMessageReceptionStateResponse proto.InternalMessageInfo
+
+func (m *PutMessageReceptionStateResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+type CreateConversationRequest struct {
+	UserID               string           `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	ChatID               string           `protobuf:"bytes,2,opt,name=chatID,proto3" json:"chatID,omitempty"`
+	Type                 ConversationType `protobuf:"varint,3,opt,name=type,proto3,enum=ConversationType" json:"type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *CreateConversationRequest) Reset()         { *m = CreateConversationRequest{} }
+func (m *CreateConversationRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateConversationRequest) ProtoMessage()    {}
+func (*CreateConversationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{108}
+}
+func (m *CreateConversationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateConversationRequest.Unmarshal(m, b)
+}
+func (m *CreateConversationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateConversationRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateConversationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateConversationRequest.Merge(dst, src)
+}
+func (m *CreateConversationRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateConversationRequest.Size(m)
+}
+func (m *CreateConversationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateConversationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateConversationRequest proto.InternalMessageInfo
+
+func (m *CreateConversationRequest) GetUserID() string {
+	if m != nil {
+		return m.UserID
+	}
+	return ""
+}
+
+func (m *CreateConversationRequest) GetChatID() string {
+	if m != nil {
+		return m.ChatID
+	}
+	return ""
+}
+
+func (m *CreateConversationRequest) GetType() ConversationType {
+	if m != nil {
+		return m.Type
+	}
+	return ConversationType_PeerToPeer
+}
+
+type CreateConversationResponse struct {
+	ChatID               string   `protobuf:"bytes,1,opt,name=chatID,proto3" json:"chatID,omitempty"`
+	Message              string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateConversationResponse) Reset()         { *m = CreateConversationResponse{} }
+func (m *CreateConversationResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateConversationResponse) ProtoMessage()    {}
+func (*CreateConversationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{109}
+}
+func (m *CreateConversationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateConversationResponse.Unmarshal(m, b)
+}
+func (m *CreateConversationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateConversationResponse.Marshal(b, m, deterministic)
+}
+func (dst *CreateConversationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateConversationResponse.Merge(dst, src)
+}
+func (m *CreateConversationResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateConversationResponse.Size(m)
+}
+func (m *CreateConversationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateConversationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateConversationResponse proto.InternalMessageInfo
+
+func (m *CreateConversationResponse) GetChatID() string {
+	if m != nil {
+		return m.ChatID
+	}
+	return ""
+}
+
+func (m *CreateConversationResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type DeleteConversationRequest struct {
+	ChatID               string   `protobuf:"bytes,1,opt,name=chatID,proto3" json:"chatID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteConversationRequest) Reset()         { *m = DeleteConversationRequest{} }
+func (m *DeleteConversationRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteConversationRequest) ProtoMessage()    {}
+func (*DeleteConversationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{110}
+}
+func (m *DeleteConversationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteConversationRequest.Unmarshal(m, b)
+}
+func (m *DeleteConversationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteConversationRequest.Marshal(b, m, deterministic)
+}
+func (dst *DeleteConversationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteConversationRequest.Merge(dst, src)
+}
+func (m *DeleteConversationRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteConversationRequest.Size(m)
+}
+func (m *DeleteConversationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteConversationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteConversationRequest proto.InternalMessageInfo
+
+func (m *DeleteConversationRequest) GetChatID() string {
+	if m != nil {
+		return m.ChatID
+	}
+	return ""
+}
+
+type DeleteConversationResponse struct {
+	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message              string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteConversationResponse) Reset()         { *m = DeleteConversationResponse{} }
+func (m *DeleteConversationResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteConversationResponse) ProtoMessage()    {}
+func (*DeleteConversationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{111}
+}
+func (m *DeleteConversationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteConversationResponse.Unmarshal(m, b)
+}
+func (m *DeleteConversationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteConversationResponse.Marshal(b, m, deterministic)
+}
+func (dst *DeleteConversationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteConversationResponse.Merge(dst, src)
+}
+func (m *DeleteConversationResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteConversationResponse.Size(m)
+}
+func (m *DeleteConversationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteConversationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteConversationResponse proto.InternalMessageInfo
+
+func (m *DeleteConversationResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *DeleteConversationResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type AddToGroupRequest struct {
+	GroupID              string              `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	Participants         []*GroupParticipant `protobuf:"bytes,2,rep,name=participants,proto3" json:"participants,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *AddToGroupRequest) Reset()         { *m = AddToGroupRequest{} }
+func (m *AddToGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*AddToGroupRequest) ProtoMessage()    {}
+func (*AddToGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{112}
+}
+func (m *AddToGroupRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddToGroupRequest.Unmarshal(m, b)
+}
+func (m *AddToGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddToGroupRequest.Marshal(b, m, deterministic)
+}
+func (dst *AddToGroupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddToGroupRequest.Merge(dst, src)
+}
+func (m *AddToGroupRequest) XXX_Size() int {
+	return xxx_messageInfo_AddToGroupRequest.Size(m)
+}
+func (m *AddToGroupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddToGroupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddToGroupRequest proto.InternalMessageInfo
+
+func (m *AddToGroupRequest) GetGroupID() string {
+	if m != nil {
+		return m.GroupID
+	}
+	return ""
+}
+
+func (m *AddToGroupRequest) GetParticipants() []*GroupParticipant {
+	if m != nil {
+		return m.Participants
+	}
+	return nil
+}
+
+type AddToGroupResponse struct {
+	GroupID              string   `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddToGroupResponse) Reset()         { *m = AddToGroupResponse{} }
+func (m *AddToGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*AddToGroupResponse) ProtoMessage()    {}
+func (*AddToGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{113}
+}
+func (m *AddToGroupResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddToGroupResponse.Unmarshal(m, b)
+}
+func (m *AddToGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddToGroupResponse.Marshal(b, m, deterministic)
+}
+func (dst *AddToGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddToGroupResponse.Merge(dst, src)
+}
+func (m *AddToGroupResponse) XXX_Size() int {
+	return xxx_messageInfo_AddToGroupResponse.Size(m)
+}
+func (m *AddToGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddToGroupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddToGroupResponse proto.InternalMessageInfo
+
+func (m *AddToGroupResponse) GetGroupID() string {
+	if m != nil {
+		return m.GroupID
+	}
+	return ""
+}
+
+type UploadMediaRequest struct {
+	FileName             string   `protobuf:"bytes,1,opt,name=fileName,proto3" json:"fileName,omitempty"`
+	ContentType          string   `protobuf:"bytes,2,opt,name=contentType,proto3" json:"contentType,omitempty"`
+	IsEncrypted          bool     `protobuf:"varint,3,opt,name=isEncrypted,proto3" json:"isEncrypted,omitempty"`
+	Contents             []byte   `protobuf:"bytes,4,opt,name=contents,proto3" json:"contents,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UploadMediaRequest) Reset()         { *m = UploadMediaRequest{} }
+func (m *UploadMediaRequest) String() string { return proto.CompactTextString(m) }
+func (*UploadMediaRequest) ProtoMessage()    {}
+func (*UploadMediaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{114}
+}
+func (m *UploadMediaRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UploadMediaRequest.Unmarshal(m, b)
+}
+func (m *UploadMediaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UploadMediaRequest.Marshal(b, m, deterministic)
+}
+func (dst *UploadMediaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadMediaRequest.Merge(dst, src)
+}
+func (m *UploadMediaRequest) XXX_Size() int {
+	return xxx_messageInfo_UploadMediaRequest.Size(m)
+}
+func (m *UploadMediaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadMediaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UploadMediaRequest proto.InternalMessageInfo
+
+func (m *UploadMediaRequest) GetFileName() string {
+	if m != nil {
+		return m.FileName
+	}
+	return ""
+}
+
+func (m *UploadMediaRequest) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
+}
+
+func (m *UploadMediaRequest) GetIsEncrypted() bool {
+	if m != nil {
+		return m.IsEncrypted
+	}
+	return false
+}
+
+func (m *UploadMediaRequest) GetContents() []byte {
+	if m != nil {
+		return m.Contents
+	}
+	return nil
+}
+
+type UploadMediaResponse struct {
+	MediaID              string   `protobuf:"bytes,1,opt,name=mediaID,proto3" json:"mediaID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UploadMediaResponse) Reset()         { *m = UploadMediaResponse{} }
+func (m *UploadMediaResponse) String() string { return proto.CompactTextString(m) }
+func (*UploadMediaResponse) ProtoMessage()    {}
+func (*UploadMediaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{115}
+}
+func (m *UploadMediaResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UploadMediaResponse.Unmarshal(m, b)
+}
+func (m *UploadMediaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UploadMediaResponse.Marshal(b, m, deterministic)
+}
+func (dst *UploadMediaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadMediaResponse.Merge(dst, src)
+}
+func (m *UploadMediaResponse) XXX_Size() int {
+	return xxx_messageInfo_UploadMediaResponse.Size(m)
+}
+func (m *UploadMediaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadMediaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UploadMediaResponse proto.InternalMessageInfo
+
+func (m *UploadMediaResponse) GetMediaID() string {
+	if m != nil {
+		return m.MediaID
+	}
+	return ""
+}
+
+type UploadProfilePictureRequest struct {
+	Contents             []byte   `protobuf:"bytes,1,opt,name=contents,proto3" json:"contents,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UploadProfilePictureRequest) Reset()         { *m = UploadProfilePictureRequest{} }
+func (m *UploadProfilePictureRequest) String() string { return proto.CompactTextString(m) }
+func (*UploadProfilePictureRequest) ProtoMessage()    {}
+func (*UploadProfilePictureRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{116}
+}
+func (m *UploadProfilePictureRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UploadProfilePictureRequest.Unmarshal(m, b)
+}
+func (m *UploadProfilePictureRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UploadProfilePictureRequest.Marshal(b, m, deterministic)
+}
+func (dst *UploadProfilePictureRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadProfilePictureRequest.Merge(dst, src)
+}
+func (m *UploadProfilePictureRequest) XXX_Size() int {
+	return xxx_messageInfo_UploadProfilePictureRequest.Size(m)
+}
+func (m *UploadProfilePictureRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadProfilePictureRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UploadProfilePictureRequest proto.InternalMessageInfo
+
+func (m *UploadProfilePictureRequest) GetContents() []byte {
+	if m != nil {
+		return m.Contents
+	}
+	return nil
+}
+
+type UploadProfilePictureResponse struct {
+	MediaID              string   `protobuf:"bytes,1,opt,name=mediaID,proto3" json:"mediaID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UploadProfilePictureResponse) Reset()         { *m = UploadProfilePictureResponse{} }
+func (m *UploadProfilePictureResponse) String() string { return proto.CompactTextString(m) }
+func (*UploadProfilePictureResponse) ProtoMessage()    {}
+func (*UploadProfilePictureResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{117}
+}
+func (m *UploadProfilePictureResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UploadProfilePictureResponse.Unmarshal(m, b)
+}
+func (m *UploadProfilePictureResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UploadProfilePictureResponse.Marshal(b, m, deterministic)
+}
+func (dst *UploadProfilePictureResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadProfilePictureResponse.Merge(dst, src)
+}
+func (m *UploadProfilePictureResponse) XXX_Size() int {
+	return xxx_messageInfo_UploadProfilePictureResponse.Size(m)
+}
+func (m *UploadProfilePictureResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadProfilePictureResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UploadProfilePictureResponse proto.InternalMessageInfo
+
+func (m *UploadProfilePictureResponse) GetMediaID() string {
+	if m != nil {
+		return m.MediaID
+	}
+	return ""
+}
+
+type GetMediaRequest struct {
+	MediaID              string   `protobuf:"bytes,1,opt,name=mediaID,proto3" json:"mediaID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetMediaRequest) Reset()         { *m = GetMediaRequest{} }
+func (m *GetMediaRequest) String() string { return proto.CompactTextString(m) }
+func (*GetMediaRequest) ProtoMessage()    {}
+func (*GetMediaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{118}
+}
+func (m *GetMediaRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMediaRequest.Unmarshal(m, b)
+}
+func (m *GetMediaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMediaRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetMediaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMediaRequest.Merge(dst, src)
+}
+func (m *GetMediaRequest) XXX_Size() int {
+	return xxx_messageInfo_GetMediaRequest.Size(m)
+}
+func (m *GetMediaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMediaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMediaRequest proto.InternalMessageInfo
+
+func (m *GetMediaRequest) GetMediaID() string {
+	if m != nil {
+		return m.MediaID
+	}
+	return ""
+}
+
+type GetMediaResponse struct {
+	Contents             []byte   `protobuf:"bytes,1,opt,name=contents,proto3" json:"contents,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetMediaResponse) Reset()         { *m = GetMediaResponse{} }
+func (m *GetMediaResponse) String() string { return proto.CompactTextString(m) }
+func (*GetMediaResponse) ProtoMessage()    {}
+func (*GetMediaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{119}
+}
+func (m *GetMediaResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMediaResponse.Unmarshal(m, b)
+}
+func (m *GetMediaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMediaResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetMediaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMediaResponse.Merge(dst, src)
+}
+func (m *GetMediaResponse) XXX_Size() int {
+	return xxx_messageInfo_GetMediaResponse.Size(m)
+}
+func (m *GetMediaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMediaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMediaResponse proto.InternalMessageInfo
+
+func (m *GetMediaResponse) GetContents() []byte {
+	if m != nil {
+		return m.Contents
+	}
+	return nil
+}
+
+type GetMessagesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetMessagesRequest) Reset()         { *m = GetMessagesRequest{} }
+func (m *GetMessagesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetMessagesRequest) ProtoMessage()    {}
+func (*GetMessagesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{120}
+}
+func (m *GetMessagesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMessagesRequest.Unmarshal(m, b)
+}
+func (m *GetMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMessagesRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetMessagesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMessagesRequest.Merge(dst, src)
+}
+func (m *GetMessagesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetMessagesRequest.Size(m)
+}
+func (m *GetMessagesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMessagesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMessagesRequest proto.InternalMessageInfo
+
+type GetMessageNotificationStream struct {
+	Timestamp            int64    `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Sender               string   `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	Recipient            string   `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetMessageNotificationStream) Reset()         { *m = GetMessageNotificationStream{} }
+func (m *GetMessageNotificationStream) String() string { return proto.CompactTextString(m) }
+func (*GetMessageNotificationStream) ProtoMessage()    {}
+func (*GetMessageNotificationStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{121}
+}
+func (m *GetMessageNotificationStream) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMessageNotificationStream.Unmarshal(m, b)
+}
+func (m *GetMessageNotificationStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMessageNotificationStream.Marshal(b, m, deterministic)
+}
+func (dst *GetMessageNotificationStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMessageNotificationStream.Merge(dst, src)
+}
+func (m *GetMessageNotificationStream) XXX_Size() int {
+	return xxx_messageInfo_GetMessageNotificationStream.Size(m)
+}
+func (m *GetMessageNotificationStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMessageNotificationStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMessageNotificationStream proto.InternalMessageInfo
+
+func (m *GetMessageNotificationStream) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *GetMessageNotificationStream) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *GetMessageNotificationStream) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+type AckMessageNotificationStreamRequest struct {
+	Timestamp            int64    `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Sender               string   `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	Recipient            string   `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AckMessageNotificationStreamRequest) Reset()         { *m = AckMessageNotificationStreamRequest{} }
+func (m *AckMessageNotificationStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*AckMessageNotificationStreamRequest) ProtoMessage()    {}
+func (*AckMessageNotificationStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{122}
+}
+func (m *AckMessageNotificationStreamRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AckMessageNotificationStreamRequest.Unmarshal(m, b)
+}
+func (m *AckMessageNotificationStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AckMessageNotificationStreamRequest.Marshal(b, m, deterministic)
+}
+func (dst *AckMessageNotificationStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AckMessageNotificationStreamRequest.Merge(dst, src)
+}
+func (m *AckMessageNotificationStreamRequest) XXX_Size() int {
+	return xxx_messageInfo_AckMessageNotificationStreamRequest.Size(m)
+}
+func (m *AckMessageNotificationStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AckMessageNotificationStreamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AckMessageNotificationStreamRequest proto.InternalMessageInfo
+
+func (m *AckMessageNotificationStreamRequest) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *AckMessageNotificationStreamRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *AckMessageNotificationStreamRequest) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+type AckMessageNotificationStreamResponse struct {
+	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AckMessageNotificationStreamResponse) Reset()         { *m = AckMessageNotificationStreamResponse{} }
+func (m *AckMessageNotificationStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*AckMessageNotificationStreamResponse) ProtoMessage()    {}
+func (*AckMessageNotificationStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{123}
+}
+func (m *AckMessageNotificationStreamResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AckMessageNotificationStreamResponse.Unmarshal(m, b)
+}
+func (m *AckMessageNotificationStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AckMessageNotificationStreamResponse.Marshal(b, m, deterministic)
+}
+func (dst *AckMessageNotificationStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AckMessageNotificationStreamResponse.Merge(dst, src)
+}
+func (m *AckMessageNotificationStreamResponse) XXX_Size() int {
+	return xxx_messageInfo_AckMessageNotificationStreamResponse.Size(m)
+}
+func (m *AckMessageNotificationStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AckMessageNotificationStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AckMessageNotificationStreamResponse proto.InternalMessageInfo
+
+func (m *AckMessageNotificationStreamResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+type GetMessagesResponseItem struct {
+	RecipientID          string   `protobuf:"bytes,1,opt,name=recipientID,proto3" json:"recipientID,omitempty"`
+	SenderID             string   `protobuf:"bytes,2,opt,name=senderID,proto3" json:"senderID,omitempty"`
+	SenderDeviceID       string   `protobuf:"bytes,3,opt,name=senderDeviceID,proto3" json:"senderDeviceID,omitempty"`
+	MessageID            int64    `protobuf:"varint,4,opt,name=messageID,proto3" json:"messageID,omitempty"`
+	MessageTimestamp     int64    `protobuf:"varint,5,opt,name=messageTimestamp,proto3" json:"messageTimestamp,omitempty"`
+	MessageContents      string   `protobuf:"bytes,6,opt,name=messageContents,proto3" json:"messageContents,omitempty"`
+	MessageEncrypted     bool     `protobuf:"varint,7,opt,name=messageEncrypted,proto3" json:"messageEncrypted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetMessagesResponseItem) Reset()         { *m = GetMessagesResponseItem{} }
+func (m *GetMessagesResponseItem) String() string { return proto.CompactTextString(m) }
+func (*GetMessagesResponseItem) ProtoMessage()    {}
+func (*GetMessagesResponseItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{124}
+}
+func (m *GetMessagesResponseItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMessagesResponseItem.Unmarshal(m, b)
+}
+func (m *GetMessagesResponseItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMessagesResponseItem.Marshal(b, m, deterministic)
+}
+func (dst *GetMessagesResponseItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMessagesResponseItem.Merge(dst, src)
+}
+func (m *GetMessagesResponseItem) XXX_Size() int {
+	return xxx_messageInfo_GetMessagesResponseItem.Size(m)
+}
+func (m *GetMessagesResponseItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMessagesResponseItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMessagesResponseItem proto.InternalMessageInfo
+
+func (m *GetMessagesResponseItem) GetRecipientID() string {
+	if m != nil {
+		return m.RecipientID
+	}
+	return ""
+}
+
+func (m *GetMessagesResponseItem) GetSenderID() string {
+	if m != nil {
+		return m.SenderID
+	}
+	return ""
+}
+
+func (m *GetMessagesResponseItem) GetSenderDeviceID() string {
+	if m != nil {
+		return m.SenderDeviceID
+	}
+	return ""
+}
+
+func (m *GetMessagesResponseItem) GetMessageID() int64 {
+	if m != nil {
+		return m.MessageID
+	}
+	return 0
+}
+
+func (m *GetMessagesResponseItem) GetMessageTimestamp() int64 {
+	if m != nil {
+		return m.MessageTimestamp
+	}
+	return 0
+}
+
+func (m *GetMessagesResponseItem) GetMessageContents() string {
+	if m != nil {
+		return m.MessageContents
+	}
+	return ""
+}
+
+func (m *GetMessagesResponseItem) GetMessageEncrypted() bool {
+	if m != nil {
+		return m.MessageEncrypted
+	}
+	return false
+}
+
+type PutMessageResponse struct {
+	MessageID            int64    `protobuf:"varint,1,opt,name=messageID,proto3" json:"messageID,omitempty"`
+	MessageTimestamp     int64    `protobuf:"varint,2,opt,name=messageTimestamp,proto3" json:"messageTimestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PutMessageResponse) Reset()         { *m = PutMessageResponse{} }
+func (m *PutMessageResponse) String() string { return proto.CompactTextString(m) }
+func (*PutMessageResponse) ProtoMessage()    {}
+func (*PutMessageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{125}
+}
+func (m *PutMessageResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PutMessageResponse.Unmarshal(m, b)
+}
+func (m *PutMessageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PutMessageResponse.Marshal(b, m, deterministic)
+}
+func (dst *PutMessageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PutMessageResponse.Merge(dst, src)
+}
+func (m *PutMessageResponse) XXX_Size() int {
+	return xxx_messageInfo_PutMessageResponse.Size(m)
+}
+func (m *PutMessageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PutMessageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PutMessageResponse proto.InternalMessageInfo
+
+func (m *PutMessageResponse) GetMessageID() int64 {
+	if m != nil {
+		return m.MessageID
+	}
+	return 0
+}
+
+func (m *PutMessageResponse) GetMessageTimestamp() int64 {
+	if m != nil {
+		return m.MessageTimestamp
+	}
+	return 0
+}
+
+type PutMessageRequest struct {
+	RecipientID          string   `protobuf:"bytes,1,opt,name=recipientID,proto3" json:"recipientID,omitempty"`
+	MessageID            int64    `protobuf:"varint,2,opt,name=messageID,proto3" json:"messageID,omitempty"`
+	MessageTimestamp     int64    `protobuf:"varint,3,opt,name=messageTimestamp,proto3" json:"messageTimestamp,omitempty"`
+	MessageContents      string   `protobuf:"bytes,4,opt,name=messageContents,proto3" json:"messageContents,omitempty"`
+	MessageEncrypted     bool     `protobuf:"varint,5,opt,name=messageEncrypted,proto3" json:"messageEncrypted,omitempty"`
+	MessageExcerpt       string   `protobuf:"bytes,6,opt,name=messageExcerpt,proto3" json:"messageExcerpt,omitempty"`
+	MessageType          int64    `protobuf:"varint,7,opt,name=messageType,proto3" json:"messageType,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PutMessageRequest) Reset()         { *m = PutMessageRequest{} }
+func (m *PutMessageRequest) String() string { return proto.CompactTextString(m) }
+func (*PutMessageRequest) ProtoMessage()    {}
+func (*PutMessageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{126}
+}
+func (m *PutMessageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PutMessageRequest.Unmarshal(m, b)
+}
+func (m *PutMessageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PutMessageRequest.Marshal(b, m, deterministic)
+}
+func (dst *PutMessageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PutMessageRequest.Merge(dst, src)
+}
+func (m *PutMessageRequest) XXX_Size() int {
+	return xxx_messageInfo_PutMessageRequest.Size(m)
+}
+func (m *PutMessageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PutMessageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PutMessageRequest proto.InternalMessageInfo
+
+func (m *PutMessageRequest) GetRecipientID() string {
+	if m != nil {
+		return m.RecipientID
+	}
+	return ""
+}
+
+func (m *PutMessageRequest) GetMessageID() int64 {
+	if m != nil {
+		return m.MessageID
+	}
+	return 0
+}
+
+func (m *PutMessageRequest) GetMessageTimestamp() int64 {
+	if m != nil {
+		return m.MessageTimestamp
+	}
+	return 0
+}
+
+func (m *PutMessageRequest) GetMessageContents() string {
+	if m != nil {
+		return m.MessageContents
+	}
+	return ""
+}
+
+func (m *PutMessageRequest) GetMessageEncrypted() bool {
+	if m != nil {
+		return m.MessageEncrypted
+	}
+	return false
+}
+
+func (m *PutMessageRequest) GetMessageExcerpt() string {
+	if m != nil {
+		return m.MessageExcerpt
+	}
+	return ""
+}
+
+func (m *PutMessageRequest) GetMessageType() int64 {
+	if m != nil {
+		return m.MessageType
+	}
+	return 0
+}
+
+type PublicGetKeysRequest struct {
+	UserID               string   `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PublicGetKeysRequest) Reset()         { *m = PublicGetKeysRequest{} }
+func (m *PublicGetKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*PublicGetKeysRequest) ProtoMessage()    {}
+func (*PublicGetKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{127}
+}
+func (m *PublicGetKeysRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PublicGetKeysRequest.Unmarshal(m, b)
+}
+func (m *PublicGetKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PublicGetKeysRequest.Marshal(b, m, deterministic)
+}
+func (dst *PublicGetKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PublicGetKeysRequest.Merge(dst, src)
+}
+func (m *PublicGetKeysRequest) XXX_Size() int {
+	return xxx_messageInfo_PublicGetKeysRequest.Size(m)
+}
+func (m *PublicGetKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PublicGetKeysRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PublicGetKeysRequest proto.InternalMessageInfo
+
+func (m *PublicGetKeysRequest) GetUserID() string {
+	if m != nil {
+		return m.UserID
+	}
+	return ""
+}
+
+type PublicGetKeysResponse struct {
+	Keys                 []byte   `protobuf:"bytes,1,opt,name=keys,proto3" json:"keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PublicGetKeysResponse) Reset()         { *m = PublicGetKeysResponse{} }
+func (m *PublicGetKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*PublicGetKeysResponse) ProtoMessage()    {}
+func (*PublicGetKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{128}
+}
+func (m *PublicGetKeysResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PublicGetKeysResponse.Unmarshal(m, b)
+}
+func (m *PublicGetKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PublicGetKeysResponse.Marshal(b, m, deterministic)
+}
+func (dst *PublicGetKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PublicGetKeysResponse.Merge(dst, src)
+}
+func (m *PublicGetKeysResponse) XXX_Size() int {
+	return xxx_messageInfo_PublicGetKeysResponse.Size(m)
+}
+func (m *PublicGetKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PublicGetKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PublicGetKeysResponse proto.InternalMessageInfo
+
+func (m *PublicGetKeysResponse) GetKeys() []byte {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+type PutKeysRequest struct {
+	UserID               string   `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Keys                 []byte   `protobuf:"bytes,2,opt,name=keys,proto3" json:"keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PutKeysRequest) Reset()         { *m = PutKeysRequest{} }
+func (m *PutKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*PutKeysRequest) ProtoMessage()    {}
+func (*PutKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{129}
+}
+func (m *PutKeysRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PutKeysRequest.Unmarshal(m, b)
+}
+func (m *PutKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PutKeysRequest.Marshal(b, m, deterministic)
+}
+func (dst *PutKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PutKeysRequest.Merge(dst, src)
+}
+func (m *PutKeysRequest) XXX_Size() int {
+	return xxx_messageInfo_PutKeysRequest.Size(m)
+}
+func (m *PutKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PutKeysRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PutKeysRequest proto.InternalMessageInfo
+
+func (m *PutKeysRequest) GetUserID() string {
+	if m != nil {
+		return m.UserID
+	}
+	return ""
+}
+
+func (m *PutKeysRequest) GetKeys() []byte {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+type PutKeysResponse struct {
+	UserID               string   `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PutKeysResponse) Reset()         { *m = PutKeysResponse{} }
+func (m *PutKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*PutKeysResponse) ProtoMessage()    {}
+func (*PutKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{130}
+}
+func (m *PutKeysResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PutKeysResponse.Unmarshal(m, b)
+}
+func (m *PutKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PutKeysResponse.Marshal(b, m, deterministic)
+}
+func (dst *PutKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PutKeysResponse.Merge(dst, src)
+}
+func (m *PutKeysResponse) XXX_Size() int {
+	return xxx_messageInfo_PutKeysResponse.Size(m)
+}
+func (m *PutKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PutKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PutKeysResponse proto.InternalMessageInfo
+
+func (m *PutKeysResponse) GetUserID() string {
+	if m != nil {
+		return m.UserID
+	}
+	return ""
+}
+
+type GroupParticipant struct {
+	UserID               string   `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	PhoneNumber          string   `protobuf:"bytes,3,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
+	UserName             string   `protobuf:"bytes,4,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	CustomData           string   `protobuf:"bytes,5,opt,name=customData,proto3" json:"customData,omitempty"`
+	IsAdmin              bool     `protobuf:"varint,6,opt,name=isAdmin,proto3" json:"isAdmin,omitempty"`
+	Avatar               string   `protobuf:"bytes,7,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	AvatarThumbnail      []byte   `protobuf:"bytes,8,opt,name=avatarThumbnail,proto3" json:"avatarThumbnail,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GroupParticipant) Reset()         { *m = GroupParticipant{} }
+func (m *GroupParticipant) String() string { return proto.CompactTextString(m) }
+func (*GroupParticipant) ProtoMessage()    {}
+func (*GroupParticipant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{131}
+}
+func (m *GroupParticipant) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GroupParticipant.Unmarshal(m, b)
+}
+func (m *GroupParticipant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GroupParticipant.Marshal(b, m, deterministic)
+}
+func (dst *GroupParticipant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupParticipant.Merge(dst, src)
+}
+func (m *GroupParticipant) XXX_Size() int {
+	return xxx_messageInfo_GroupParticipant.Size(m)
+}
+func (m *GroupParticipant) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupParticipant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GroupParticipant proto.InternalMessageInfo
+
+func (m *GroupParticipant) GetUserID() string {
+	if m != nil {
+		return m.UserID
+	}
+	return ""
+}
+
+func (m *GroupParticipant) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GroupParticipant) GetPhoneNumber() string {
+	if m != nil {
+		return m.PhoneNumber
+	}
+	return ""
+}
+
+func (m *GroupParticipant) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+func (m *GroupParticipant) GetCustomData() string {
+	if m != nil {
+		return m.CustomData
+	}
+	return ""
+}
+
+func (m *GroupParticipant) GetIsAdmin() bool {
+	if m != nil {
+		return m.IsAdmin
+	}
+	return false
+}
+
+func (m *GroupParticipant) GetAvatar() string {
+	if m != nil {
+		return m.Avatar
+	}
+	return ""
+}
+
+func (m *GroupParticipant) GetAvatarThumbnail() []byte {
+	if m != nil {
+		return m.AvatarThumbnail
+	}
+	return nil
+}
+
+type CreateGroupConversationRequest struct {
+	Name                 string              `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Avatar               string              `protobuf:"bytes,2,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	Participants         []*GroupParticipant `protobuf:"bytes,3,rep,name=participants,proto3" json:"participants,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *CreateGroupConversationRequest) Reset()         { *m = CreateGroupConversationRequest{} }
+func (m *CreateGroupConversationRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateGroupConversationRequest) ProtoMessage()    {}
+func (*CreateGroupConversationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{132}
+}
+func (m *CreateGroupConversationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateGroupConversationRequest.Unmarshal(m, b)
+}
+func (m *CreateGroupConversationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateGroupConversationRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateGroupConversationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateGroupConversationRequest.Merge(dst, src)
+}
+func (m *CreateGroupConversationRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateGroupConversationRequest.Size(m)
+}
+func (m *CreateGroupConversationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateGroupConversationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateGroupConversationRequest proto.InternalMessageInfo
+
+func (m *CreateGroupConversationRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateGroupConversationRequest) GetAvatar() string {
+	if m != nil {
+		return m.Avatar
+	}
+	return ""
+}
+
+func (m *CreateGroupConversationRequest) GetParticipants() []*GroupParticipant {
+	if m != nil {
+		return m.Participants
+	}
+	return nil
+}
+
+type CreateGroupConversationResponse struct {
+	GroupID              string   `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateGroupConversationResponse) Reset()         { *m = CreateGroupConversationResponse{} }
+func (m *CreateGroupConversationResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateGroupConversationResponse) ProtoMessage()    {}
+func (*CreateGroupConversationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{133}
+}
+func (m *CreateGroupConversationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateGroupConversationResponse.Unmarshal(m, b)
+}
+func (m *CreateGroupConversationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateGroupConversationResponse.Marshal(b, m, deterministic)
+}
+func (dst *CreateGroupConversationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateGroupConversationResponse.Merge(dst, src)
+}
+func (m *CreateGroupConversationResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateGroupConversationResponse.Size(m)
+}
+func (m *CreateGroupConversationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateGroupConversationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateGroupConversationResponse proto.InternalMessageInfo
+
+func (m *CreateGroupConversationResponse) GetGroupID() string {
+	if m != nil {
+		return m.GroupID
+	}
+	return ""
+}
+
+type RegisterFCMRequest struct {
+	FCMToken             string   `protobuf:"bytes,1,opt,name=FCMToken,proto3" json:"FCMToken,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegisterFCMRequest) Reset()         { *m = RegisterFCMRequest{} }
+func (m *RegisterFCMRequest) String() string { return proto.CompactTextString(m) }
+func (*RegisterFCMRequest) ProtoMessage()    {}
+func (*RegisterFCMRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{134}
+}
+func (m *RegisterFCMRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegisterFCMRequest.Unmarshal(m, b)
+}
+func (m *RegisterFCMRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegisterFCMRequest.Marshal(b, m, deterministic)
+}
+func (dst *RegisterFCMRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterFCMRequest.Merge(dst, src)
+}
+func (m *RegisterFCMRequest) XXX_Size() int {
+	return xxx_messageInfo_RegisterFCMRequest.Size(m)
+}
+func (m *RegisterFCMRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterFCMRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterFCMRequest proto.InternalMessageInfo
+
+func (m *RegisterFCMRequest) GetFCMToken() string {
+	if m != nil {
+		return m.FCMToken
+	}
+	return ""
+}
+
+type RegisterFCMResponse struct {
+	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegisterFCMResponse) Reset()         { *m = RegisterFCMResponse{} }
+func (m *RegisterFCMResponse) String() string { return proto.CompactTextString(m) }
+func (*RegisterFCMResponse) ProtoMessage()    {}
+func (*RegisterFCMResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{135}
+}
+func (m *RegisterFCMResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegisterFCMResponse.Unmarshal(m, b)
+}
+func (m *RegisterFCMResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegisterFCMResponse.Marshal(b, m, deterministic)
+}
+func (dst *RegisterFCMResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterFCMResponse.Merge(dst, src)
+}
+func (m *RegisterFCMResponse) XXX_Size() int {
+	return xxx_messageInfo_RegisterFCMResponse.Size(m)
+}
+func (m *RegisterFCMResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterFCMResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterFCMResponse proto.InternalMessageInfo
+
+func (m *RegisterFCMResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+type RegisterPushTokenRequest struct {
+	Provider             string   `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Token                string   `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegisterPushTokenRequest) Reset()         { *m = RegisterPushTokenRequest{} }
+func (m *RegisterPushTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*RegisterPushTokenRequest) ProtoMessage()    {}
+func (*RegisterPushTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{136}
+}
+func (m *RegisterPushTokenRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegisterPushTokenRequest.Unmarshal(m, b)
+}
+func (m *RegisterPushTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegisterPushTokenRequest.Marshal(b, m, deterministic)
+}
+func (dst *RegisterPushTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterPushTokenRequest.Merge(dst, src)
+}
+func (m *RegisterPushTokenRequest) XXX_Size() int {
+	return xxx_messageInfo_RegisterPushTokenRequest.Size(m)
+}
+func (m *RegisterPushTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterPushTokenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterPushTokenRequest proto.InternalMessageInfo
+
+func (m *RegisterPushTokenRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *RegisterPushTokenRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+type RegisterPushTokenResponse struct {
+	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegisterPushTokenResponse) Reset()         { *m = RegisterPushTokenResponse{} }
+func (m *RegisterPushTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*RegisterPushTokenResponse) ProtoMessage()    {}
+func (*RegisterPushTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ngobrel_124fb1944a5a5764, []int{137}
+}
+func (m *RegisterPushTokenResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegisterPushTokenResponse.Unmarshal(m, b)
+}
+func (m *RegisterPushTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegisterPushTokenResponse.Marshal(b, m, deterministic)
+}
+func (dst *RegisterPushTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterPushTokenResponse.Merge(dst, src)
+}
+func (m *RegisterPushTokenResponse) XXX_Size() int {
+	return xxx_messageInfo_RegisterPushTokenResponse.Size(m)
+}
+func (m *RegisterPushTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterPushTokenResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterPushTokenResponse proto.InternalMessageInfo
+
+func (m *RegisterPushTokenResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*BlockContactRequest)(nil), "BlockContactRequest")
+	proto.RegisterType((*BlockContactResponse)(nil), "BlockContactResponse")
+	proto.RegisterType((*UnblockContactRequest)(nil), "UnblockContactRequest")
+	proto.RegisterType((*UnblockContactResponse)(nil), "UnblockContactResponse")
+	proto.RegisterType((*GetProfilePictureRequest)(nil), "GetProfilePictureRequest")
+	proto.RegisterType((*GetProfilePictureResponse)(nil), "GetProfilePictureResponse")
+	proto.RegisterType((*EchoRequest)(nil), "EchoRequest")
+	proto.RegisterType((*EchoResponse)(nil), "EchoResponse")
+	proto.RegisterType((*RenameGroupRequest)(nil), "RenameGroupRequest")
+	proto.RegisterType((*RenameGroupResponse)(nil), "RenameGroupResponse")
+	proto.RegisterType((*UpdateGroupAvatarRequest)(nil), "UpdateGroupAvatarRequest")
+	proto.RegisterType((*UpdateGroupAvatarResponse)(nil), "UpdateGroupAvatarResponse")
+	proto.RegisterType((*DisbandGroupRequest)(nil), "DisbandGroupRequest")
+	proto.RegisterType((*DisbandGroupResponse)(nil), "DisbandGroupResponse")
+	proto.RegisterType((*EditGroupRequest)(nil), "EditGroupRequest")
+	proto.RegisterType((*EditGroupResponse)(nil), "EditGroupResponse")
+	proto.RegisterType((*GetGroupInfoRequest)(nil), "GetGroupInfoRequest")
+	proto.RegisterType((*GetGroupInfoResponse)(nil), "GetGroupInfoResponse")
+	proto.RegisterType((*ExitFromGroupRequest)(nil), "ExitFromGroupRequest")
+	proto.RegisterType((*ExitFromGroupResponse)(nil), "ExitFromGroupResponse")
+	proto.RegisterType((*RemoveAdminRoleRequest)(nil), "RemoveAdminRoleRequest")
+	proto.RegisterType((*RemoveAdminRoleResponse)(nil), "RemoveAdminRoleResponse")
+	proto.RegisterType((*RemoveFromGroupRequest)(nil), "RemoveFromGroupRequest")
+	proto.RegisterType((*RemoveFromGroupResponse)(nil), "RemoveFromGroupResponse")
+	proto.RegisterType((*BanFromGroupRequest)(nil), "BanFromGroupRequest")
+	proto.RegisterType((*BanFromGroupResponse)(nil), "BanFromGroupResponse")
+	proto.RegisterType((*UnbanFromGroupRequest)(nil), "UnbanFromGroupRequest")
+	proto.RegisterType((*UnbanFromGroupResponse)(nil), "UnbanFromGroupResponse")
+	proto.RegisterType((*ListGroupBansRequest)(nil), "ListGroupBansRequest")
+	proto.RegisterType((*ListGroupBansResponse)(nil), "ListGroupBansResponse")
+	proto.RegisterType((*MuteGroupMemberRequest)(nil), "MuteGroupMemberRequest")
+	proto.RegisterType((*MuteGroupMemberResponse)(nil), "MuteGroupMemberResponse")
+	proto.RegisterType((*UnmuteGroupMemberRequest)(nil), "UnmuteGroupMemberRequest")
+	proto.RegisterType((*UnmuteGroupMemberResponse)(nil), "UnmuteGroupMemberResponse")
+	proto.RegisterType((*ListGroupMutesRequest)(nil), "ListGroupMutesRequest")
+	proto.RegisterType((*ListGroupMutesResponse)(nil), "ListGroupMutesResponse")
+	proto.RegisterType((*GroupRestriction)(nil), "GroupRestriction")
+	proto.RegisterType((*ListGroupParticipantsRequest)(nil), "ListGroupParticipantsRequest")
+	proto.RegisterType((*ListGroupParticipantsResponse)(nil), "ListGroupParticipantsResponse")
+	proto.RegisterType((*VerifyOTPRequest)(nil), "VerifyOTPRequest")
+	proto.RegisterType((*VerifyOTPResponse)(nil), "VerifyOTPResponse")
+	proto.RegisterType((*RequestDeviceLinkRequest)(nil), "RequestDeviceLinkRequest")
+	proto.RegisterType((*RequestDeviceLinkResponse)(nil), "RequestDeviceLinkResponse")
+	proto.RegisterType((*ApproveDeviceLinkRequest)(nil), "ApproveDeviceLinkRequest")
+	proto.RegisterType((*ApproveDeviceLinkResponse)(nil), "ApproveDeviceLinkResponse")
+	proto.RegisterType((*CompleteDeviceLinkRequest)(nil), "CompleteDeviceLinkRequest")
+	proto.RegisterType((*CompleteDeviceLinkResponse)(nil), "CompleteDeviceLinkResponse")
+	proto.RegisterType((*ListDevicesRequest)(nil), "ListDevicesRequest")
+	proto.RegisterType((*ListDevicesResponse)(nil), "ListDevicesResponse")
+	proto.RegisterType((*Device)(nil), "Device")
+	proto.RegisterType((*RenameDeviceRequest)(nil), "RenameDeviceRequest")
+	proto.RegisterType((*RenameDeviceResponse)(nil), "RenameDeviceResponse")
+	proto.RegisterType((*RemoveDeviceRequest)(nil), "RemoveDeviceRequest")
+	proto.RegisterType((*RemoveDeviceResponse)(nil), "RemoveDeviceResponse")
+	proto.RegisterType((*RefreshTokenRequest)(nil), "RefreshTokenRequest")
+	proto.RegisterType((*RefreshTokenResponse)(nil), "RefreshTokenResponse")
+	proto.RegisterType((*LogoutRequest)(nil), "LogoutRequest")
+	proto.RegisterType((*LogoutResponse)(nil), "LogoutResponse")
+	proto.RegisterType((*LogoutAllDevicesRequest)(nil), "LogoutAllDevicesRequest")
+	proto.RegisterType((*LogoutAllDevicesResponse)(nil), "LogoutAllDevicesResponse")
+	proto.RegisterType((*RequestPhoneNumberChangeRequest)(nil), "RequestPhoneNumberChangeRequest")
+	proto.RegisterType((*RequestPhoneNumberChangeResponse)(nil), "RequestPhoneNumberChangeResponse")
+	proto.RegisterType((*ChangePhoneNumberRequest)(nil), "ChangePhoneNumberRequest")
+	proto.RegisterType((*ChangePhoneNumberResponse)(nil), "ChangePhoneNumberResponse")
+	proto.RegisterType((*DeleteAccountRequest)(nil), "DeleteAccountRequest")
+	proto.RegisterType((*DeleteAccountResponse)(nil), "DeleteAccountResponse")
+	proto.RegisterType((*RequestDataExportRequest)(nil), "RequestDataExportRequest")
+	proto.RegisterType((*RequestDataExportResponse)(nil), "RequestDataExportResponse")
+	proto.RegisterType((*GetDataExportStatusRequest)(nil), "GetDataExportStatusRequest")
+	proto.RegisterType((*GetDataExportStatusResponse)(nil), "GetDataExportStatusResponse")
+	proto.RegisterType((*SetPINRequest)(nil), "SetPINRequest")
+	proto.RegisterType((*SetPINResponse)(nil), "SetPINResponse")
+	proto.RegisterType((*RemovePINRequest)(nil), "RemovePINRequest")
+	proto.RegisterType((*RemovePINResponse)(nil), "RemovePINResponse")
+	proto.RegisterType((*VerifyPINRequest)(nil), "VerifyPINRequest")
+	proto.RegisterType((*VerifyPINResponse)(nil), "VerifyPINResponse")
+	proto.RegisterType((*GetPINStatusRequest)(nil), "GetPINStatusRequest")
+	proto.RegisterType((*GetPINStatusResponse)(nil), "GetPINStatusResponse")
+	proto.RegisterType((*RequestEmailVerificationRequest)(nil), "RequestEmailVerificationRequest")
+	proto.RegisterType((*RequestEmailVerificationResponse)(nil), "RequestEmailVerificationResponse")
+	proto.RegisterType((*VerifyEmailRequest)(nil), "VerifyEmailRequest")
+	proto.RegisterType((*VerifyEmailResponse)(nil), "VerifyEmailResponse")
+	proto.RegisterType((*CreateProfileRequest)(nil), "CreateProfileRequest")
+	proto.RegisterType((*CreateProfileResponse)(nil), "CreateProfileResponse")
+	proto.RegisterType((*EditProfileRequest)(nil), "EditProfileRequest")
+	proto.RegisterType((*EditProfileResponse)(nil), "EditProfileResponse")
+	proto.RegisterType((*GetProfileRequest)(nil), "GetProfileRequest")
+	proto.RegisterType((*GetProfileResponse)(nil), "GetProfileResponse")
+	proto.RegisterType((*ListConversationsRequest)(nil), "ListConversationsRequest")
+	proto.RegisterType((*ListConversationsResponse)(nil), "ListConversationsResponse")
+	proto.RegisterType((*Conversations)(nil), "Conversations")
+	proto.RegisterType((*UpdateConversationRequest)(nil), "UpdateConversationRequest")
+	proto.RegisterType((*UpdateConversationResponse)(nil), "UpdateConversationResponse")
+	proto.RegisterType((*DeleteContactRequest)(nil), "DeleteContactRequest")
+	proto.RegisterType((*DeleteContactResponse)(nil), "DeleteContactResponse")
+	proto.RegisterType((*GetContactsRequest)(nil), "GetContactsRequest")
+	proto.RegisterType((*GetContactsResponse)(nil), "GetContactsResponse")
 	proto.RegisterType((*Contacts)(nil), "Contacts")
 	proto.RegisterType((*PutContactRequest)(nil), "PutContactRequest")
 	proto.RegisterType((*PutContactResponse)(nil), "PutContactResponse")
@@ -3590,1143 +6562,1935 @@ func init() {
 	proto.RegisterType((*CreateGroupConversationResponse)(nil), "CreateGroupConversationResponse")
 	proto.RegisterType((*RegisterFCMRequest)(nil), "RegisterFCMRequest")
 	proto.RegisterType((*RegisterFCMResponse)(nil), "RegisterFCMResponse")
+	proto.RegisterType((*RegisterPushTokenRequest)(nil), "RegisterPushTokenRequest")
+	proto.RegisterType((*RegisterPushTokenResponse)(nil), "RegisterPushTokenResponse")
 	proto.RegisterEnum("ConversationType", ConversationType_name, ConversationType_value)
 	proto.RegisterEnum("MessageState", MessageState_name, MessageState_value)
 	proto.RegisterEnum("MessageReceptionState", MessageReceptionState_name, MessageReceptionState_value)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// NgobrelClient is the client API for Ngobrel service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type NgobrelClient interface {
+	PutMessage(ctx context.Context, in *PutMessageRequest, opts ...grpc.CallOption) (*PutMessageResponse, error)
+	GetMessages(ctx context.Context, in *GetMessagesRequest, opts ...grpc.CallOption) (Ngobrel_GetMessagesClient, error)
+	GetMessageNotification(ctx context.Context, in *GetMessagesRequest, opts ...grpc.CallOption) (Ngobrel_GetMessageNotificationClient, error)
+	UploadMedia(ctx context.Context, opts ...grpc.CallOption) (Ngobrel_UploadMediaClient, error)
+	GetMedia(ctx context.Context, in *GetMediaRequest, opts ...grpc.CallOption) (Ngobrel_GetMediaClient, error)
+	CreateGroupConversation(ctx context.Context, in *CreateGroupConversationRequest, opts ...grpc.CallOption) (*CreateGroupConversationResponse, error)
+	CreateConversation(ctx context.Context, in *CreateConversationRequest, opts ...grpc.CallOption) (*CreateConversationResponse, error)
+	ListConversations(ctx context.Context, in *ListConversationsRequest, opts ...grpc.CallOption) (*ListConversationsResponse, error)
+	UpdateConversation(ctx context.Context, in *UpdateConversationRequest, opts ...grpc.CallOption) (*UpdateConversationResponse, error)
+	ListGroupParticipants(ctx context.Context, in *ListGroupParticipantsRequest, opts ...grpc.CallOption) (*ListGroupParticipantsResponse, error)
+	RemoveAdminRole(ctx context.Context, in *RemoveAdminRoleRequest, opts ...grpc.CallOption) (*RemoveAdminRoleResponse, error)
+	RemoveFromGroup(ctx context.Context, in *RemoveFromGroupRequest, opts ...grpc.CallOption) (*RemoveFromGroupResponse, error)
+	AddToGroup(ctx context.Context, in *AddToGroupRequest, opts ...grpc.CallOption) (*AddToGroupResponse, error)
+	ExitFromGroup(ctx context.Context, in *ExitFromGroupRequest, opts ...grpc.CallOption) (*ExitFromGroupResponse, error)
+	RenameGroup(ctx context.Context, in *RenameGroupRequest, opts ...grpc.CallOption) (*RenameGroupResponse, error)
+	UpdateGroupAvatar(ctx context.Context, in *UpdateGroupAvatarRequest, opts ...grpc.CallOption) (*UpdateGroupAvatarResponse, error)
+	DisbandGroup(ctx context.Context, in *DisbandGroupRequest, opts ...grpc.CallOption) (*DisbandGroupResponse, error)
+	EditGroup(ctx context.Context, in *EditGroupRequest, opts ...grpc.CallOption) (*EditGroupResponse, error)
+	GetGroupInfo(ctx context.Context, in *GetGroupInfoRequest, opts ...grpc.CallOption) (*GetGroupInfoResponse, error)
+	BanFromGroup(ctx context.Context, in *BanFromGroupRequest, opts ...grpc.CallOption) (*BanFromGroupResponse, error)
+	UnbanFromGroup(ctx context.Context, in *UnbanFromGroupRequest, opts ...grpc.CallOption) (*UnbanFromGroupResponse, error)
+	ListGroupBans(ctx context.Context, in *ListGroupBansRequest, opts ...grpc.CallOption) (*ListGroupBansResponse, error)
+	MuteGroupMember(ctx context.Context, in *MuteGroupMemberRequest, opts ...grpc.CallOption) (*MuteGroupMemberResponse, error)
+	UnmuteGroupMember(ctx context.Context, in *UnmuteGroupMemberRequest, opts ...grpc.CallOption) (*UnmuteGroupMemberResponse, error)
+	ListGroupMutes(ctx context.Context, in *ListGroupMutesRequest, opts ...grpc.CallOption) (*ListGroupMutesResponse, error)
+	DeleteConversation(ctx context.Context, in *DeleteConversationRequest, opts ...grpc.CallOption) (*DeleteConversationResponse, error)
+	PutMessageState(ctx context.Context, in *PutMessageStateRequest, opts ...grpc.CallOption) (*PutMessageStateResponse, error)
+	GetContacts(ctx context.Context, in *GetContactsRequest, opts ...grpc.CallOption) (*GetContactsResponse, error)
+	PutContact(ctx context.Context, in *PutContactRequest, opts ...grpc.CallOption) (*PutContactResponse, error)
+	DeleteContact(ctx context.Context, in *DeleteContactRequest, opts ...grpc.CallOption) (*DeleteContactResponse, error)
+	BlockContact(ctx context.Context, in *BlockContactRequest, opts ...grpc.CallOption) (*BlockContactResponse, error)
+	UnblockContact(ctx context.Context, in *UnblockContactRequest, opts ...grpc.CallOption) (*UnblockContactResponse, error)
+	CreateProfile(ctx context.Context, in *CreateProfileRequest, opts ...grpc.CallOption) (*CreateProfileResponse, error)
+	EditProfile(ctx context.Context, in *EditProfileRequest, opts ...grpc.CallOption) (*EditProfileResponse, error)
+	GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*GetProfileResponse, error)
+	UploadProfilePicture(ctx context.Context, opts ...grpc.CallOption) (Ngobrel_UploadProfilePictureClient, error)
+	GetProfilePicture(ctx context.Context, in *GetProfilePictureRequest, opts ...grpc.CallOption) (Ngobrel_GetProfilePictureClient, error)
+	VerifyOTP(ctx context.Context, in *VerifyOTPRequest, opts ...grpc.CallOption) (*VerifyOTPResponse, error)
+	RequestDeviceLink(ctx context.Context, in *RequestDeviceLinkRequest, opts ...grpc.CallOption) (*RequestDeviceLinkResponse, error)
+	ApproveDeviceLink(ctx context.Context, in *ApproveDeviceLinkRequest, opts ...grpc.CallOption) (*ApproveDeviceLinkResponse, error)
+	CompleteDeviceLink(ctx context.Context, in *CompleteDeviceLinkRequest, opts ...grpc.CallOption) (*CompleteDeviceLinkResponse, error)
+	ListDevices(ctx context.Context, in *ListDevicesRequest, opts ...grpc.CallOption) (*ListDevicesResponse, error)
+	RenameDevice(ctx context.Context, in *RenameDeviceRequest, opts ...grpc.CallOption) (*RenameDeviceResponse, error)
+	RemoveDevice(ctx context.Context, in *RemoveDeviceRequest, opts ...grpc.CallOption) (*RemoveDeviceResponse, error)
+	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error)
+	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error)
+	LogoutAllDevices(ctx context.Context, in *LogoutAllDevicesRequest, opts ...grpc.CallOption) (*LogoutAllDevicesResponse, error)
+	RequestPhoneNumberChange(ctx context.Context, in *RequestPhoneNumberChangeRequest, opts ...grpc.CallOption) (*RequestPhoneNumberChangeResponse, error)
+	ChangePhoneNumber(ctx context.Context, in *ChangePhoneNumberRequest, opts ...grpc.CallOption) (*ChangePhoneNumberResponse, error)
+	DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*DeleteAccountResponse, error)
+	RequestDataExport(ctx context.Context, in *RequestDataExportRequest, opts ...grpc.CallOption) (*RequestDataExportResponse, error)
+	GetDataExportStatus(ctx context.Context, in *GetDataExportStatusRequest, opts ...grpc.CallOption) (*GetDataExportStatusResponse, error)
+	SetPIN(ctx context.Context, in *SetPINRequest, opts ...grpc.CallOption) (*SetPINResponse, error)
+	RemovePIN(ctx context.Context, in *RemovePINRequest, opts ...grpc.CallOption) (*RemovePINResponse, error)
+	VerifyPIN(ctx context.Context, in *VerifyPINRequest, opts ...grpc.CallOption) (*VerifyPINResponse, error)
+	GetPINStatus(ctx context.Context, in *GetPINStatusRequest, opts ...grpc.CallOption) (*GetPINStatusResponse, error)
+	RequestEmailVerification(ctx context.Context, in *RequestEmailVerificationRequest, opts ...grpc.CallOption) (*RequestEmailVerificationResponse, error)
+	VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*VerifyEmailResponse, error)
+	Echo(ctx context.Context, in *EchoRequest, opts ...grpc.CallOption) (*EchoResponse, error)
+	RegisterFCM(ctx context.Context, in *RegisterFCMRequest, opts ...grpc.CallOption) (*RegisterFCMResponse, error)
+	RegisterPushToken(ctx context.Context, in *RegisterPushTokenRequest, opts ...grpc.CallOption) (*RegisterPushTokenResponse, error)
+	AckMessageNotificationStream(ctx context.Context, in *AckMessageNotificationStreamRequest, opts ...grpc.CallOption) (*AckMessageNotificationStreamResponse, error)
+}
+
+type ngobrelClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewNgobrelClient(cc *grpc.ClientConn) NgobrelClient {
+	return &ngobrelClient{cc}
+}
+
+func (c *ngobrelClient) PutMessage(ctx context.Context, in *PutMessageRequest, opts ...grpc.CallOption) (*PutMessageResponse, error) {
+	out := new(PutMessageResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/PutMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) GetMessages(ctx context.Context, in *GetMessagesRequest, opts ...grpc.CallOption) (Ngobrel_GetMessagesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Ngobrel_serviceDesc.Streams[0], "/Ngobrel/GetMessages", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ngobrelGetMessagesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Ngobrel_GetMessagesClient interface {
+	Recv() (*GetMessagesResponseItem, error)
+	grpc.ClientStream
+}
+
+type ngobrelGetMessagesClient struct {
+	grpc.ClientStream
+}
+
+func (x *ngobrelGetMessagesClient) Recv() (*GetMessagesResponseItem, error) {
+	m := new(GetMessagesResponseItem)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *ngobrelClient) GetMessageNotification(ctx context.Context, in *GetMessagesRequest, opts ...grpc.CallOption) (Ngobrel_GetMessageNotificationClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Ngobrel_serviceDesc.Streams[1], "/Ngobrel/GetMessageNotification", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ngobrelGetMessageNotificationClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Ngobrel_GetMessageNotificationClient interface {
+	Recv() (*GetMessageNotificationStream, error)
+	grpc.ClientStream
+}
+
+type ngobrelGetMessageNotificationClient struct {
+	grpc.ClientStream
+}
+
+func (x *ngobrelGetMessageNotificationClient) Recv() (*GetMessageNotificationStream, error) {
+	m := new(GetMessageNotificationStream)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *ngobrelClient) UploadMedia(ctx context.Context, opts ...grpc.CallOption) (Ngobrel_UploadMediaClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Ngobrel_serviceDesc.Streams[2], "/Ngobrel/UploadMedia", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ngobrelUploadMediaClient{stream}
+	return x, nil
+}
+
+type Ngobrel_UploadMediaClient interface {
+	Send(*UploadMediaRequest) error
+	CloseAndRecv() (*UploadMediaResponse, error)
+	grpc.ClientStream
+}
+
+type ngobrelUploadMediaClient struct {
+	grpc.ClientStream
+}
+
+func (x *ngobrelUploadMediaClient) Send(m *UploadMediaRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *ngobrelUploadMediaClient) CloseAndRecv() (*UploadMediaResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UploadMediaResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *ngobrelClient) GetMedia(ctx context.Context, in *GetMediaRequest, opts ...grpc.CallOption) (Ngobrel_GetMediaClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Ngobrel_serviceDesc.Streams[3], "/Ngobrel/GetMedia", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ngobrelGetMediaClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Ngobrel_GetMediaClient interface {
+	Recv() (*GetMediaResponse, error)
+	grpc.ClientStream
+}
+
+type ngobrelGetMediaClient struct {
+	grpc.ClientStream
+}
+
+func (x *ngobrelGetMediaClient) Recv() (*GetMediaResponse, error) {
+	m := new(GetMediaResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *ngobrelClient) CreateGroupConversation(ctx context.Context, in *CreateGroupConversationRequest, opts ...grpc.CallOption) (*CreateGroupConversationResponse, error) {
+	out := new(CreateGroupConversationResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/CreateGroupConversation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) CreateConversation(ctx context.Context, in *CreateConversationRequest, opts ...grpc.CallOption) (*CreateConversationResponse, error) {
+	out := new(CreateConversationResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/CreateConversation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) ListConversations(ctx context.Context, in *ListConversationsRequest, opts ...grpc.CallOption) (*ListConversationsResponse, error) {
+	out := new(ListConversationsResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/ListConversations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) UpdateConversation(ctx context.Context, in *UpdateConversationRequest, opts ...grpc.CallOption) (*UpdateConversationResponse, error) {
+	out := new(UpdateConversationResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/UpdateConversation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) ListGroupParticipants(ctx context.Context, in *ListGroupParticipantsRequest, opts ...grpc.CallOption) (*ListGroupParticipantsResponse, error) {
+	out := new(ListGroupParticipantsResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/ListGroupParticipants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) RemoveAdminRole(ctx context.Context, in *RemoveAdminRoleRequest, opts ...grpc.CallOption) (*RemoveAdminRoleResponse, error) {
+	out := new(RemoveAdminRoleResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/RemoveAdminRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) RemoveFromGroup(ctx context.Context, in *RemoveFromGroupRequest, opts ...grpc.CallOption) (*RemoveFromGroupResponse, error) {
+	out := new(RemoveFromGroupResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/RemoveFromGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) AddToGroup(ctx context.Context, in *AddToGroupRequest, opts ...grpc.CallOption) (*AddToGroupResponse, error) {
+	out := new(AddToGroupResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/AddToGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) ExitFromGroup(ctx context.Context, in *ExitFromGroupRequest, opts ...grpc.CallOption) (*ExitFromGroupResponse, error) {
+	out := new(ExitFromGroupResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/ExitFromGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) RenameGroup(ctx context.Context, in *RenameGroupRequest, opts ...grpc.CallOption) (*RenameGroupResponse, error) {
+	out := new(RenameGroupResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/RenameGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) UpdateGroupAvatar(ctx context.Context, in *UpdateGroupAvatarRequest, opts ...grpc.CallOption) (*UpdateGroupAvatarResponse, error) {
+	out := new(UpdateGroupAvatarResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/UpdateGroupAvatar", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) DisbandGroup(ctx context.Context, in *DisbandGroupRequest, opts ...grpc.CallOption) (*DisbandGroupResponse, error) {
+	out := new(DisbandGroupResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/DisbandGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) EditGroup(ctx context.Context, in *EditGroupRequest, opts ...grpc.CallOption) (*EditGroupResponse, error) {
+	out := new(EditGroupResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/EditGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) GetGroupInfo(ctx context.Context, in *GetGroupInfoRequest, opts ...grpc.CallOption) (*GetGroupInfoResponse, error) {
+	out := new(GetGroupInfoResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/GetGroupInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) BanFromGroup(ctx context.Context, in *BanFromGroupRequest, opts ...grpc.CallOption) (*BanFromGroupResponse, error) {
+	out := new(BanFromGroupResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/BanFromGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) UnbanFromGroup(ctx context.Context, in *UnbanFromGroupRequest, opts ...grpc.CallOption) (*UnbanFromGroupResponse, error) {
+	out := new(UnbanFromGroupResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/UnbanFromGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) ListGroupBans(ctx context.Context, in *ListGroupBansRequest, opts ...grpc.CallOption) (*ListGroupBansResponse, error) {
+	out := new(ListGroupBansResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/ListGroupBans", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) MuteGroupMember(ctx context.Context, in *MuteGroupMemberRequest, opts ...grpc.CallOption) (*MuteGroupMemberResponse, error) {
+	out := new(MuteGroupMemberResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/MuteGroupMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) UnmuteGroupMember(ctx context.Context, in *UnmuteGroupMemberRequest, opts ...grpc.CallOption) (*UnmuteGroupMemberResponse, error) {
+	out := new(UnmuteGroupMemberResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/UnmuteGroupMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) ListGroupMutes(ctx context.Context, in *ListGroupMutesRequest, opts ...grpc.CallOption) (*ListGroupMutesResponse, error) {
+	out := new(ListGroupMutesResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/ListGroupMutes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) DeleteConversation(ctx context.Context, in *DeleteConversationRequest, opts ...grpc.CallOption) (*DeleteConversationResponse, error) {
+	out := new(DeleteConversationResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/DeleteConversation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) PutMessageState(ctx context.Context, in *PutMessageStateRequest, opts ...grpc.CallOption) (*PutMessageStateResponse, error) {
+	out := new(PutMessageStateResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/PutMessageState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) GetContacts(ctx context.Context, in *GetContactsRequest, opts ...grpc.CallOption) (*GetContactsResponse, error) {
+	out := new(GetContactsResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/GetContacts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) PutContact(ctx context.Context, in *PutContactRequest, opts ...grpc.CallOption) (*PutContactResponse, error) {
+	out := new(PutContactResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/PutContact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) DeleteContact(ctx context.Context, in *DeleteContactRequest, opts ...grpc.CallOption) (*DeleteContactResponse, error) {
+	out := new(DeleteContactResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/DeleteContact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) BlockContact(ctx context.Context, in *BlockContactRequest, opts ...grpc.CallOption) (*BlockContactResponse, error) {
+	out := new(BlockContactResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/BlockContact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) UnblockContact(ctx context.Context, in *UnblockContactRequest, opts ...grpc.CallOption) (*UnblockContactResponse, error) {
+	out := new(UnblockContactResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/UnblockContact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) CreateProfile(ctx context.Context, in *CreateProfileRequest, opts ...grpc.CallOption) (*CreateProfileResponse, error) {
+	out := new(CreateProfileResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/CreateProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) EditProfile(ctx context.Context, in *EditProfileRequest, opts ...grpc.CallOption) (*EditProfileResponse, error) {
+	out := new(EditProfileResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/EditProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*GetProfileResponse, error) {
+	out := new(GetProfileResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/GetProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) UploadProfilePicture(ctx context.Context, opts ...grpc.CallOption) (Ngobrel_UploadProfilePictureClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Ngobrel_serviceDesc.Streams[4], "/Ngobrel/UploadProfilePicture", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ngobrelUploadProfilePictureClient{stream}
+	return x, nil
+}
+
+type Ngobrel_UploadProfilePictureClient interface {
+	Send(*UploadProfilePictureRequest) error
+	CloseAndRecv() (*UploadProfilePictureResponse, error)
+	grpc.ClientStream
+}
+
+type ngobrelUploadProfilePictureClient struct {
+	grpc.ClientStream
+}
+
+func (x *ngobrelUploadProfilePictureClient) Send(m *UploadProfilePictureRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *ngobrelUploadProfilePictureClient) CloseAndRecv() (*UploadProfilePictureResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UploadProfilePictureResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *ngobrelClient) GetProfilePicture(ctx context.Context, in *GetProfilePictureRequest, opts ...grpc.CallOption) (Ngobrel_GetProfilePictureClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Ngobrel_serviceDesc.Streams[5], "/Ngobrel/GetProfilePicture", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ngobrelGetProfilePictureClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Ngobrel_GetProfilePictureClient interface {
+	Recv() (*GetProfilePictureResponse, error)
+	grpc.ClientStream
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+type ngobrelGetProfilePictureClient struct {
+	grpc.ClientStream
+}
 
-// NgobrelClient is the client API for Ngobrel service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type NgobrelClient interface {
-	// *
-	// Sends message
-	PutMessage(ctx context.Context, in *PutMessageRequest, opts ...grpc.CallOption) (*PutMessageResponse, error)
-	// *
-	// Gets messages for currently logged in deviceID
-	GetMessages(ctx context.Context, in *GetMessagesRequest, opts ...grpc.CallOption) (Ngobrel_GetMessagesClient, error)
-	// *
-	// Get notifications for incoming messages
-	GetMessageNotification(ctx context.Context, in *GetMessagesRequest, opts ...grpc.CallOption) (Ngobrel_GetMessageNotificationClient, error)
-	// *
-	// Uploads media file
-	UploadMedia(ctx context.Context, opts ...grpc.CallOption) (Ngobrel_UploadMediaClient, error)
-	// *
-	// Gets media file
-	GetMedia(ctx context.Context, in *GetMediaRequest, opts ...grpc.CallOption) (Ngobrel_GetMediaClient, error)
-	// *
-	// Creates a group conversation
-	CreateGroupConversation(ctx context.Context, in *CreateGroupConversationRequest, opts ...grpc.CallOption) (*CreateGroupConversationResponse, error)
-	// *
-	// Creates a new conversation, either a peer-to-peer or group conversation
-	CreateConversation(ctx context.Context, in *CreateConversationRequest, opts ...grpc.CallOption) (*CreateConversationResponse, error)
-	// *
-	// List available conversations for currently logged in userID
-	ListConversations(ctx context.Context, in *ListConversationsRequest, opts ...grpc.CallOption) (*ListConversationsResponse, error)
-	// *
-	// Updates a conversation
-	UpdateConversation(ctx context.Context, in *UpdateConversationRequest, opts ...grpc.CallOption) (*UpdateConversationResponse, error)
-	// *
-	// Lists all participants in a group
-	ListGroupParticipants(ctx context.Context, in *ListGroupParticipantsRequest, opts ...grpc.CallOption) (*ListGroupParticipantsResponse, error)
-	// *
-	// Removes an admin role of a group from a user
-	RemoveAdminRole(ctx context.Context, in *RemoveAdminRoleRequest, opts ...grpc.CallOption) (*RemoveAdminRoleResponse, error)
-	// *
-	// Removes a user from a group
-	RemoveFromGroup(ctx context.Context, in *RemoveFromGroupRequest, opts ...grpc.CallOption) (*RemoveFromGroupResponse, error)
-	// *
-	// Invite other people into a conversation
-	AddToGroup(ctx context.Context, in *AddToGroupRequest, opts ...grpc.CallOption) (*AddToGroupResponse, error)
-	// *
-	// Exits from a group
-	ExitFromGroup(ctx context.Context, in *ExitFromGroupRequest, opts ...grpc.CallOption) (*ExitFromGroupResponse, error)
-	// *
-	// Renames a group name
-	RenameGroup(ctx context.Context, in *RenameGroupRequest, opts ...grpc.CallOption) (*RenameGroupResponse, error)
-	// *
-	// Sets the reception state of a particular message
-	PutMessageState(ctx context.Context, in *PutMessageStateRequest, opts ...grpc.CallOption) (*PutMessageStateResponse, error)
-	// *
-	// Gets contact list of the currently logged user ID
-	GetContacts(ctx context.Context, in *GetContactsRequest, opts ...grpc.CallOption) (*GetContactsResponse, error)
-	// *
-	// Adds a new contact into the contact list of the currently logged user ID
-	PutContact(ctx context.Context, in *PutContactRequest, opts ...grpc.CallOption) (*PutContactResponse, error)
-	// *
-	// Deletes a contact from the contact list of the currently logged in user ID
-	DeleteContact(ctx context.Context, in *DeleteContactRequest, opts ...grpc.CallOption) (*DeleteContactResponse, error)
-	// *
-	// Blocks a contact
-	BlockContact(ctx context.Context, in *BlockContactRequest, opts ...grpc.CallOption) (*BlockContactResponse, error)
-	// *
-	// Unblocks a contact
-	UnblockContact(ctx context.Context, in *UnblockContactRequest, opts ...grpc.CallOption) (*UnblockContactResponse, error)
-	// *
-	// Registers a new profile
-	CreateProfile(ctx context.Context, in *CreateProfileRequest, opts ...grpc.CallOption) (*CreateProfileResponse, error)
-	// *
-	// Edits the profile of currently logged in user ID
-	EditProfile(ctx context.Context, in *EditProfileRequest, opts ...grpc.CallOption) (*EditProfileResponse, error)
-	// *
-	// Gets the profile of a specific user ID
-	GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*GetProfileResponse, error)
-	// *
-	// Uploads profile picture
-	UploadProfilePicture(ctx context.Context, opts ...grpc.CallOption) (Ngobrel_UploadProfilePictureClient, error)
-	// *
-	// Gets profile picture
-	GetProfilePicture(ctx context.Context, in *GetProfilePictureRequest, opts ...grpc.CallOption) (Ngobrel_GetProfilePictureClient, error)
-	// *
-	// Verify OTP
-	VerifyOTP(ctx context.Context, in *VerifyOTPRequest, opts ...grpc.CallOption) (*VerifyOTPResponse, error)
-	// *
-	// Echo
-	Echo(ctx context.Context, in *EchoRequest, opts ...grpc.CallOption) (*EchoResponse, error)
-	// *
-	// Registers an FCM token
-	RegisterFCM(ctx context.Context, in *RegisterFCMRequest, opts ...grpc.CallOption) (*RegisterFCMResponse, error)
-	// *
-	// Acknowledges an notification
-	AckMessageNotificationStream(ctx context.Context, in *AckMessageNotificationStreamRequest, opts ...grpc.CallOption) (*AckMessageNotificationStreamResponse, error)
+func (x *ngobrelGetProfilePictureClient) Recv() (*GetProfilePictureResponse, error) {
+	m := new(GetProfilePictureResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
-type ngobrelClient struct {
-	cc *grpc.ClientConn
+func (c *ngobrelClient) VerifyOTP(ctx context.Context, in *VerifyOTPRequest, opts ...grpc.CallOption) (*VerifyOTPResponse, error) {
+	out := new(VerifyOTPResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/VerifyOTP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) RequestDeviceLink(ctx context.Context, in *RequestDeviceLinkRequest, opts ...grpc.CallOption) (*RequestDeviceLinkResponse, error) {
+	out := new(RequestDeviceLinkResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/RequestDeviceLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) ApproveDeviceLink(ctx context.Context, in *ApproveDeviceLinkRequest, opts ...grpc.CallOption) (*ApproveDeviceLinkResponse, error) {
+	out := new(ApproveDeviceLinkResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/ApproveDeviceLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) CompleteDeviceLink(ctx context.Context, in *CompleteDeviceLinkRequest, opts ...grpc.CallOption) (*CompleteDeviceLinkResponse, error) {
+	out := new(CompleteDeviceLinkResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/CompleteDeviceLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) ListDevices(ctx context.Context, in *ListDevicesRequest, opts ...grpc.CallOption) (*ListDevicesResponse, error) {
+	out := new(ListDevicesResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/ListDevices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) RenameDevice(ctx context.Context, in *RenameDeviceRequest, opts ...grpc.CallOption) (*RenameDeviceResponse, error) {
+	out := new(RenameDeviceResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/RenameDevice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) RemoveDevice(ctx context.Context, in *RemoveDeviceRequest, opts ...grpc.CallOption) (*RemoveDeviceResponse, error) {
+	out := new(RemoveDeviceResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/RemoveDevice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error) {
+	out := new(RefreshTokenResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/RefreshToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error) {
+	out := new(LogoutResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/Logout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) LogoutAllDevices(ctx context.Context, in *LogoutAllDevicesRequest, opts ...grpc.CallOption) (*LogoutAllDevicesResponse, error) {
+	out := new(LogoutAllDevicesResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/LogoutAllDevices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) RequestPhoneNumberChange(ctx context.Context, in *RequestPhoneNumberChangeRequest, opts ...grpc.CallOption) (*RequestPhoneNumberChangeResponse, error) {
+	out := new(RequestPhoneNumberChangeResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/RequestPhoneNumberChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) ChangePhoneNumber(ctx context.Context, in *ChangePhoneNumberRequest, opts ...grpc.CallOption) (*ChangePhoneNumberResponse, error) {
+	out := new(ChangePhoneNumberResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/ChangePhoneNumber", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*DeleteAccountResponse, error) {
+	out := new(DeleteAccountResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/DeleteAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) RequestDataExport(ctx context.Context, in *RequestDataExportRequest, opts ...grpc.CallOption) (*RequestDataExportResponse, error) {
+	out := new(RequestDataExportResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/RequestDataExport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) GetDataExportStatus(ctx context.Context, in *GetDataExportStatusRequest, opts ...grpc.CallOption) (*GetDataExportStatusResponse, error) {
+	out := new(GetDataExportStatusResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/GetDataExportStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) SetPIN(ctx context.Context, in *SetPINRequest, opts ...grpc.CallOption) (*SetPINResponse, error) {
+	out := new(SetPINResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/SetPIN", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) RemovePIN(ctx context.Context, in *RemovePINRequest, opts ...grpc.CallOption) (*RemovePINResponse, error) {
+	out := new(RemovePINResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/RemovePIN", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) VerifyPIN(ctx context.Context, in *VerifyPINRequest, opts ...grpc.CallOption) (*VerifyPINResponse, error) {
+	out := new(VerifyPINResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/VerifyPIN", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) GetPINStatus(ctx context.Context, in *GetPINStatusRequest, opts ...grpc.CallOption) (*GetPINStatusResponse, error) {
+	out := new(GetPINStatusResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/GetPINStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) RequestEmailVerification(ctx context.Context, in *RequestEmailVerificationRequest, opts ...grpc.CallOption) (*RequestEmailVerificationResponse, error) {
+	out := new(RequestEmailVerificationResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/RequestEmailVerification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*VerifyEmailResponse, error) {
+	out := new(VerifyEmailResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/VerifyEmail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) Echo(ctx context.Context, in *EchoRequest, opts ...grpc.CallOption) (*EchoResponse, error) {
+	out := new(EchoResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/Echo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) RegisterFCM(ctx context.Context, in *RegisterFCMRequest, opts ...grpc.CallOption) (*RegisterFCMResponse, error) {
+	out := new(RegisterFCMResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/RegisterFCM", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) RegisterPushToken(ctx context.Context, in *RegisterPushTokenRequest, opts ...grpc.CallOption) (*RegisterPushTokenResponse, error) {
+	out := new(RegisterPushTokenResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/RegisterPushToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ngobrelClient) AckMessageNotificationStream(ctx context.Context, in *AckMessageNotificationStreamRequest, opts ...grpc.CallOption) (*AckMessageNotificationStreamResponse, error) {
+	out := new(AckMessageNotificationStreamResponse)
+	err := c.cc.Invoke(ctx, "/Ngobrel/AckMessageNotificationStream", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NgobrelServer is the server API for Ngobrel service.
+type NgobrelServer interface {
+	PutMessage(context.Context, *PutMessageRequest) (*PutMessageResponse, error)
+	GetMessages(*GetMessagesRequest, Ngobrel_GetMessagesServer) error
+	GetMessageNotification(*GetMessagesRequest, Ngobrel_GetMessageNotificationServer) error
+	UploadMedia(Ngobrel_UploadMediaServer) error
+	GetMedia(*GetMediaRequest, Ngobrel_GetMediaServer) error
+	CreateGroupConversation(context.Context, *CreateGroupConversationRequest) (*CreateGroupConversationResponse, error)
+	CreateConversation(context.Context, *CreateConversationRequest) (*CreateConversationResponse, error)
+	ListConversations(context.Context, *ListConversationsRequest) (*ListConversationsResponse, error)
+	UpdateConversation(context.Context, *UpdateConversationRequest) (*UpdateConversationResponse, error)
+	ListGroupParticipants(context.Context, *ListGroupParticipantsRequest) (*ListGroupParticipantsResponse, error)
+	RemoveAdminRole(context.Context, *RemoveAdminRoleRequest) (*RemoveAdminRoleResponse, error)
+	RemoveFromGroup(context.Context, *RemoveFromGroupRequest) (*RemoveFromGroupResponse, error)
+	AddToGroup(context.Context, *AddToGroupRequest) (*AddToGroupResponse, error)
+	ExitFromGroup(context.Context, *ExitFromGroupRequest) (*ExitFromGroupResponse, error)
+	RenameGroup(context.Context, *RenameGroupRequest) (*RenameGroupResponse, error)
+	UpdateGroupAvatar(context.Context, *UpdateGroupAvatarRequest) (*UpdateGroupAvatarResponse, error)
+	DisbandGroup(context.Context, *DisbandGroupRequest) (*DisbandGroupResponse, error)
+	EditGroup(context.Context, *EditGroupRequest) (*EditGroupResponse, error)
+	GetGroupInfo(context.Context, *GetGroupInfoRequest) (*GetGroupInfoResponse, error)
+	BanFromGroup(context.Context, *BanFromGroupRequest) (*BanFromGroupResponse, error)
+	UnbanFromGroup(context.Context, *UnbanFromGroupRequest) (*UnbanFromGroupResponse, error)
+	ListGroupBans(context.Context, *ListGroupBansRequest) (*ListGroupBansResponse, error)
+	MuteGroupMember(context.Context, *MuteGroupMemberRequest) (*MuteGroupMemberResponse, error)
+	UnmuteGroupMember(context.Context, *UnmuteGroupMemberRequest) (*UnmuteGroupMemberResponse, error)
+	ListGroupMutes(context.Context, *ListGroupMutesRequest) (*ListGroupMutesResponse, error)
+	DeleteConversation(context.Context, *DeleteConversationRequest) (*DeleteConversationResponse, error)
+	PutMessageState(context.Context, *PutMessageStateRequest) (*PutMessageStateResponse, error)
+	GetContacts(context.Context, *GetContactsRequest) (*GetContactsResponse, error)
+	PutContact(context.Context, *PutContactRequest) (*PutContactResponse, error)
+	DeleteContact(context.Context, *DeleteContactRequest) (*DeleteContactResponse, error)
+	BlockContact(context.Context, *BlockContactRequest) (*BlockContactResponse, error)
+	UnblockContact(context.Context, *UnblockContactRequest) (*UnblockContactResponse, error)
+	CreateProfile(context.Context, *CreateProfileRequest) (*CreateProfileResponse, error)
+	EditProfile(context.Context, *EditProfileRequest) (*EditProfileResponse, error)
+	GetProfile(context.Context, *GetProfileRequest) (*GetProfileResponse, error)
+	UploadProfilePicture(Ngobrel_UploadProfilePictureServer) error
+	GetProfilePicture(*GetProfilePictureRequest, Ngobrel_GetProfilePictureServer) error
+	VerifyOTP(context.Context, *VerifyOTPRequest) (*VerifyOTPResponse, error)
+	RequestDeviceLink(context.Context, *RequestDeviceLinkRequest) (*RequestDeviceLinkResponse, error)
+	ApproveDeviceLink(context.Context, *ApproveDeviceLinkRequest) (*ApproveDeviceLinkResponse, error)
+	CompleteDeviceLink(context.Context, *CompleteDeviceLinkRequest) (*CompleteDeviceLinkResponse, error)
+	ListDevices(context.Context, *ListDevicesRequest) (*ListDevicesResponse, error)
+	RenameDevice(context.Context, *RenameDeviceRequest) (*RenameDeviceResponse, error)
+	RemoveDevice(context.Context, *RemoveDeviceRequest) (*RemoveDeviceResponse, error)
+	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error)
+	Logout(context.Context, *LogoutRequest) (*LogoutResponse, error)
+	LogoutAllDevices(context.Context, *LogoutAllDevicesRequest) (*LogoutAllDevicesResponse, error)
+	RequestPhoneNumberChange(context.Context, *RequestPhoneNumberChangeRequest) (*RequestPhoneNumberChangeResponse, error)
+	ChangePhoneNumber(context.Context, *ChangePhoneNumberRequest) (*ChangePhoneNumberResponse, error)
+	DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountResponse, error)
+	RequestDataExport(context.Context, *RequestDataExportRequest) (*RequestDataExportResponse, error)
+	GetDataExportStatus(context.Context, *GetDataExportStatusRequest) (*GetDataExportStatusResponse, error)
+	SetPIN(context.Context, *SetPINRequest) (*SetPINResponse, error)
+	RemovePIN(context.Context, *RemovePINRequest) (*RemovePINResponse, error)
+	VerifyPIN(context.Context, *VerifyPINRequest) (*VerifyPINResponse, error)
+	GetPINStatus(context.Context, *GetPINStatusRequest) (*GetPINStatusResponse, error)
+	RequestEmailVerification(context.Context, *RequestEmailVerificationRequest) (*RequestEmailVerificationResponse, error)
+	VerifyEmail(context.Context, *VerifyEmailRequest) (*VerifyEmailResponse, error)
+	Echo(context.Context, *EchoRequest) (*EchoResponse, error)
+	RegisterFCM(context.Context, *RegisterFCMRequest) (*RegisterFCMResponse, error)
+	RegisterPushToken(context.Context, *RegisterPushTokenRequest) (*RegisterPushTokenResponse, error)
+	AckMessageNotificationStream(context.Context, *AckMessageNotificationStreamRequest) (*AckMessageNotificationStreamResponse, error)
 }
 
-func NewNgobrelClient(cc *grpc.ClientConn) NgobrelClient {
-	return &ngobrelClient{cc}
+func RegisterNgobrelServer(s *grpc.Server, srv NgobrelServer) {
+	s.RegisterService(&_Ngobrel_serviceDesc, srv)
 }
 
-func (c *ngobrelClient) PutMessage(ctx context.Context, in *PutMessageRequest, opts ...grpc.CallOption) (*PutMessageResponse, error) {
-	out := new(PutMessageResponse)
-	err := c.cc.Invoke(ctx, "/Ngobrel/PutMessage", in, out, opts...)
-	if err != nil {
+func _Ngobrel_PutMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutMessageRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-func (c *ngobrelClient) GetMessages(ctx context.Context, in *GetMessagesRequest, opts ...grpc.CallOption) (Ngobrel_GetMessagesClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Ngobrel_serviceDesc.Streams[0], "/Ngobrel/GetMessages", opts...)
-	if err != nil {
-		return nil, err
+	if interceptor == nil {
+		return srv.(NgobrelServer).PutMessage(ctx, in)
 	}
-	x := &ngobrelGetMessagesClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Ngobrel/PutMessage",
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NgobrelServer).PutMessage(ctx, req.(*PutMessageRequest))
 	}
-	return x, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-type Ngobrel_GetMessagesClient interface {
-	Recv() (*GetMessagesResponseItem, error)
-	grpc.ClientStream
+func _Ngobrel_GetMessages_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetMessagesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NgobrelServer).GetMessages(m, &ngobrelGetMessagesServer{stream})
 }
 
-type ngobrelGetMessagesClient struct {
-	grpc.ClientStream
+type Ngobrel_GetMessagesServer interface {
+	Send(*GetMessagesResponseItem) error
+	grpc.ServerStream
 }
 
-func (x *ngobrelGetMessagesClient) Recv() (*GetMessagesResponseItem, error) {
-	m := new(GetMessagesResponseItem)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+type ngobrelGetMessagesServer struct {
+	grpc.ServerStream
 }
 
-func (c *ngobrelClient) GetMessageNotification(ctx context.Context, in *GetMessagesRequest, opts ...grpc.CallOption) (Ngobrel_GetMessageNotificationClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Ngobrel_serviceDesc.Streams[1], "/Ngobrel/GetMessageNotification", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &ngobrelGetMessageNotificationClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (x *ngobrelGetMessagesServer) Send(m *GetMessagesResponseItem) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Ngobrel_GetMessageNotification_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetMessagesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return x, nil
+	return srv.(NgobrelServer).GetMessageNotification(m, &ngobrelGetMessageNotificationServer{stream})
 }
 
-type Ngobrel_GetMessageNotificationClient interface {
-	Recv() (*GetMessageNotificationStream, error)
-	grpc.ClientStream
+type Ngobrel_GetMessageNotificationServer interface {
+	Send(*GetMessageNotificationStream) error
+	grpc.ServerStream
 }
 
-type ngobrelGetMessageNotificationClient struct {
-	grpc.ClientStream
+type ngobrelGetMessageNotificationServer struct {
+	grpc.ServerStream
 }
 
-func (x *ngobrelGetMessageNotificationClient) Recv() (*GetMessageNotificationStream, error) {
-	m := new(GetMessageNotificationStream)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (x *ngobrelGetMessageNotificationServer) Send(m *GetMessageNotificationStream) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (c *ngobrelClient) UploadMedia(ctx context.Context, opts ...grpc.CallOption) (Ngobrel_UploadMediaClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Ngobrel_serviceDesc.Streams[2], "/Ngobrel/UploadMedia", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &ngobrelUploadMediaClient{stream}
-	return x, nil
+func _Ngobrel_UploadMedia_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(NgobrelServer).UploadMedia(&ngobrelUploadMediaServer{stream})
 }
 
-type Ngobrel_UploadMediaClient interface {
-	Send(*UploadMediaRequest) error
-	CloseAndRecv() (*UploadMediaResponse, error)
-	grpc.ClientStream
+type Ngobrel_UploadMediaServer interface {
+	SendAndClose(*UploadMediaResponse) error
+	Recv() (*UploadMediaRequest, error)
+	grpc.ServerStream
 }
 
-type ngobrelUploadMediaClient struct {
-	grpc.ClientStream
+type ngobrelUploadMediaServer struct {
+	grpc.ServerStream
 }
 
-func (x *ngobrelUploadMediaClient) Send(m *UploadMediaRequest) error {
-	return x.ClientStream.SendMsg(m)
+func (x *ngobrelUploadMediaServer) SendAndClose(m *UploadMediaResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (x *ngobrelUploadMediaClient) CloseAndRecv() (*UploadMediaResponse, error) {
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	m := new(UploadMediaResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func (x *ngobrelUploadMediaServer) Recv() (*UploadMediaRequest, error) {
+	m := new(UploadMediaRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func (c *ngobrelClient) GetMedia(ctx context.Context, in *GetMediaRequest, opts ...grpc.CallOption) (Ngobrel_GetMediaClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Ngobrel_serviceDesc.Streams[3], "/Ngobrel/GetMedia", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &ngobrelGetMediaClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func _Ngobrel_GetMedia_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetMediaRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return x, nil
+	return srv.(NgobrelServer).GetMedia(m, &ngobrelGetMediaServer{stream})
 }
 
-type Ngobrel_GetMediaClient interface {
-	Recv() (*GetMediaResponse, error)
-	grpc.ClientStream
+type Ngobrel_GetMediaServer interface {
+	Send(*GetMediaResponse) error
+	grpc.ServerStream
 }
 
-type ngobrelGetMediaClient struct {
-	grpc.ClientStream
+type ngobrelGetMediaServer struct {
+	grpc.ServerStream
 }
 
-func (x *ngobrelGetMediaClient) Recv() (*GetMediaResponse, error) {
-	m := new(GetMediaResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (x *ngobrelGetMediaServer) Send(m *GetMediaResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (c *ngobrelClient) CreateGroupConversation(ctx context.Context, in *CreateGroupConversationRequest, opts ...grpc.CallOption) (*CreateGroupConversationResponse, error) {
-	out := new(CreateGroupConversationResponse)
-	err := c.cc.Invoke(ctx, "/Ngobrel/CreateGroupConversation", in, out, opts...)
-	if err != nil {
+func _Ngobrel_CreateGroupConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupConversationRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(NgobrelServer).CreateGroupConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Ngobrel/CreateGroupConversation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NgobrelServer).CreateGroupConversation(ctx, req.(*CreateGroupConversationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *ngobrelClient) CreateConversation(ctx context.Context, in *CreateConversationRequest, opts ...grpc.CallOption) (*CreateConversationResponse, error) {
-	out := new(CreateConversationResponse)
-	err := c.cc.Invoke(ctx, "/Ngobrel/CreateConversation", in, out, opts...)
-	if err != nil {
+func _Ngobrel_CreateConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateConversationRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(NgobrelServer).CreateConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Ngobrel/CreateConversation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NgobrelServer).CreateConversation(ctx, req.(*CreateConversationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *ngobrelClient) ListConversations(ctx context.Context, in *ListConversationsRequest, opts ...grpc.CallOption) (*ListConversationsResponse, error) {
-	out := new(ListConversationsResponse)
-	err := c.cc.Invoke(ctx, "/Ngobrel/ListConversations", in, out, opts...)
-	if err != nil {
+func _Ngobrel_ListConversations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConversationsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(NgobrelServer).ListConversations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Ngobrel/ListConversations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NgobrelServer).ListConversations(ctx, req.(*ListConversationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *ngobrelClient) UpdateConversation(ctx context.Context, in *UpdateConversationRequest, opts ...grpc.CallOption) (*UpdateConversationResponse, error) {
-	out := new(UpdateConversationResponse)
-	err := c.cc.Invoke(ctx, "/Ngobrel/UpdateConversation", in, out, opts...)
-	if err != nil {
+func _Ngobrel_UpdateConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateConversationRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(NgobrelServer).UpdateConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Ngobrel/UpdateConversation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NgobrelServer).UpdateConversation(ctx, req.(*UpdateConversationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *ngobrelClient) ListGroupParticipants(ctx context.Context, in *ListGroupParticipantsRequest, opts ...grpc.CallOption) (*ListGroupParticipantsResponse, error) {
-	out := new(ListGroupParticipantsResponse)
-	err := c.cc.Invoke(ctx, "/Ngobrel/ListGroupParticipants", in, out, opts...)
-	if err != nil {
+func _Ngobrel_ListGroupParticipants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupParticipantsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(NgobrelServer).ListGroupParticipants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Ngobrel/ListGroupParticipants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NgobrelServer).ListGroupParticipants(ctx, req.(*ListGroupParticipantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *ngobrelClient) RemoveAdminRole(ctx context.Context, in *RemoveAdminRoleRequest, opts ...grpc.CallOption) (*RemoveAdminRoleResponse, error) {
-	out := new(RemoveAdminRoleResponse)
-	err := c.cc.Invoke(ctx, "/Ngobrel/RemoveAdminRole", in, out, opts...)
-	if err != nil {
+func _Ngobrel_RemoveAdminRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveAdminRoleRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(NgobrelServer).RemoveAdminRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Ngobrel/RemoveAdminRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NgobrelServer).RemoveAdminRole(ctx, req.(*RemoveAdminRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *ngobrelClient) RemoveFromGroup(ctx context.Context, in *RemoveFromGroupRequest, opts ...grpc.CallOption) (*RemoveFromGroupResponse, error) {
-	out := new(RemoveFromGroupResponse)
-	err := c.cc.Invoke(ctx, "/Ngobrel/RemoveFromGroup", in, out, opts...)
-	if err != nil {
+func _Ngobrel_RemoveFromGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFromGroupRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(NgobrelServer).RemoveFromGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Ngobrel/RemoveFromGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NgobrelServer).RemoveFromGroup(ctx, req.(*RemoveFromGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *ngobrelClient) AddToGroup(ctx context.Context, in *AddToGroupRequest, opts ...grpc.CallOption) (*AddToGroupResponse, error) {
-	out := new(AddToGroupResponse)
-	err := c.cc.Invoke(ctx, "/Ngobrel/AddToGroup", in, out, opts...)
-	if err != nil {
+func _Ngobrel_AddToGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddToGroupRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(NgobrelServer).AddToGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Ngobrel/AddToGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NgobrelServer).AddToGroup(ctx, req.(*AddToGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *ngobrelClient) ExitFromGroup(ctx context.Context, in *ExitFromGroupRequest, opts ...grpc.CallOption) (*ExitFromGroupResponse, error) {
-	out := new(ExitFromGroupResponse)
-	err := c.cc.Invoke(ctx, "/Ngobrel/ExitFromGroup", in, out, opts...)
-	if err != nil {
+func _Ngobrel_ExitFromGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExitFromGroupRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(NgobrelServer).ExitFromGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Ngobrel/ExitFromGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NgobrelServer).ExitFromGroup(ctx, req.(*ExitFromGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *ngobrelClient) RenameGroup(ctx context.Context, in *RenameGroupRequest, opts ...grpc.CallOption) (*RenameGroupResponse, error) {
-	out := new(RenameGroupResponse)
-	err := c.cc.Invoke(ctx, "/Ngobrel/RenameGroup", in, out, opts...)
-	if err != nil {
+func _Ngobrel_RenameGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameGroupRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(NgobrelServer).RenameGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Ngobrel/RenameGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NgobrelServer).RenameGroup(ctx, req.(*RenameGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *ngobrelClient) PutMessageState(ctx context.Context, in *PutMessageStateRequest, opts ...grpc.CallOption) (*PutMessageStateResponse, error) {
-	out := new(PutMessageStateResponse)
-	err := c.cc.Invoke(ctx, "/Ngobrel/PutMessageState", in, out, opts...)
-	if err != nil {
+func _Ngobrel_UpdateGroupAvatar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGroupAvatarRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(NgobrelServer).UpdateGroupAvatar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Ngobrel/UpdateGroupAvatar",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NgobrelServer).UpdateGroupAvatar(ctx, req.(*UpdateGroupAvatarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *ngobrelClient) GetContacts(ctx context.Context, in *GetContactsRequest, opts ...grpc.CallOption) (*GetContactsResponse, error) {
-	out := new(GetContactsResponse)
-	err := c.cc.Invoke(ctx, "/Ngobrel/GetContacts", in, out, opts...)
-	if err != nil {
+func _Ngobrel_DisbandGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisbandGroupRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(NgobrelServer).DisbandGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Ngobrel/DisbandGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NgobrelServer).DisbandGroup(ctx, req.(*DisbandGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *ngobrelClient) PutContact(ctx context.Context, in *PutContactRequest, opts ...grpc.CallOption) (*PutContactResponse, error) {
-	out := new(PutContactResponse)
-	err := c.cc.Invoke(ctx, "/Ngobrel/PutContact", in, out, opts...)
-	if err != nil {
+func _Ngobrel_EditGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditGroupRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(NgobrelServer).EditGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Ngobrel/EditGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NgobrelServer).EditGroup(ctx, req.(*EditGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *ngobrelClient) DeleteContact(ctx context.Context, in *DeleteContactRequest, opts ...grpc.CallOption) (*DeleteContactResponse, error) {
-	out := new(DeleteContactResponse)
-	err := c.cc.Invoke(ctx, "/Ngobrel/DeleteContact", in, out, opts...)
-	if err != nil {
+func _Ngobrel_GetGroupInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupInfoRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(NgobrelServer).GetGroupInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Ngobrel/GetGroupInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NgobrelServer).GetGroupInfo(ctx, req.(*GetGroupInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *ngobrelClient) BlockContact(ctx context.Context, in *BlockContactRequest, opts ...grpc.CallOption) (*BlockContactResponse, error) {
-	out := new(BlockContactResponse)
-	err := c.cc.Invoke(ctx, "/Ngobrel/BlockContact", in, out, opts...)
-	if err != nil {
+func _Ngobrel_BanFromGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanFromGroupRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(NgobrelServer).BanFromGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Ngobrel/BanFromGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NgobrelServer).BanFromGroup(ctx, req.(*BanFromGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *ngobrelClient) UnblockContact(ctx context.Context, in *UnblockContactRequest, opts ...grpc.CallOption) (*UnblockContactResponse, error) {
-	out := new(UnblockContactResponse)
-	err := c.cc.Invoke(ctx, "/Ngobrel/UnblockContact", in, out, opts...)
-	if err != nil {
+func _Ngobrel_UnbanFromGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnbanFromGroupRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(NgobrelServer).UnbanFromGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Ngobrel/UnbanFromGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NgobrelServer).UnbanFromGroup(ctx, req.(*UnbanFromGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *ngobrelClient) CreateProfile(ctx context.Context, in *CreateProfileRequest, opts ...grpc.CallOption) (*CreateProfileResponse, error) {
-	out := new(CreateProfileResponse)
-	err := c.cc.Invoke(ctx, "/Ngobrel/CreateProfile", in, out, opts...)
-	if err != nil {
+func _Ngobrel_ListGroupBans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupBansRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(NgobrelServer).ListGroupBans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Ngobrel/ListGroupBans",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NgobrelServer).ListGroupBans(ctx, req.(*ListGroupBansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *ngobrelClient) EditProfile(ctx context.Context, in *EditProfileRequest, opts ...grpc.CallOption) (*EditProfileResponse, error) {
-	out := new(EditProfileResponse)
-	err := c.cc.Invoke(ctx, "/Ngobrel/EditProfile", in, out, opts...)
-	if err != nil {
+func _Ngobrel_MuteGroupMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteGroupMemberRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(NgobrelServer).MuteGroupMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Ngobrel/MuteGroupMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NgobrelServer).MuteGroupMember(ctx, req.(*MuteGroupMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *ngobrelClient) GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*GetProfileResponse, error) {
-	out := new(GetProfileResponse)
-	err := c.cc.Invoke(ctx, "/Ngobrel/GetProfile", in, out, opts...)
-	if err != nil {
+func _Ngobrel_UnmuteGroupMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnmuteGroupMemberRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(NgobrelServer).UnmuteGroupMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Ngobrel/UnmuteGroupMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NgobrelServer).UnmuteGroupMember(ctx, req.(*UnmuteGroupMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *ngobrelClient) UploadProfilePicture(ctx context.Context, opts ...grpc.CallOption) (Ngobrel_UploadProfilePictureClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Ngobrel_serviceDesc.Streams[4], "/Ngobrel/UploadProfilePicture", opts...)
-	if err != nil {
+func _Ngobrel_ListGroupMutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupMutesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	x := &ngobrelUploadProfilePictureClient{stream}
-	return x, nil
-}
-
-type Ngobrel_UploadProfilePictureClient interface {
-	Send(*UploadProfilePictureRequest) error
-	CloseAndRecv() (*UploadProfilePictureResponse, error)
-	grpc.ClientStream
-}
-
-type ngobrelUploadProfilePictureClient struct {
-	grpc.ClientStream
+	if interceptor == nil {
+		return srv.(NgobrelServer).ListGroupMutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Ngobrel/ListGroupMutes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NgobrelServer).ListGroupMutes(ctx, req.(*ListGroupMutesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *ngobrelUploadProfilePictureClient) Send(m *UploadProfilePictureRequest) error {
-	return x.ClientStream.SendMsg(m)
+func _Ngobrel_DeleteConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteConversationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NgobrelServer).DeleteConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Ngobrel/DeleteConversation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NgobrelServer).DeleteConversation(ctx, req.(*DeleteConversationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *ngobrelUploadProfilePictureClient) CloseAndRecv() (*UploadProfilePictureResponse, error) {
-	if err := x.ClientStream.CloseSend(); err != nil {
+func _Ngobrel_PutMessageState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutMessageStateRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	m := new(UploadProfilePictureResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+	if interceptor == nil {
+		return srv.(NgobrelServer).PutMessageState(ctx, in)
 	}
-	return m, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Ngobrel/PutMessageState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NgobrelServer).PutMessageState(ctx, req.(*PutMessageStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *ngobrelClient) GetProfilePicture(ctx context.Context, in *GetProfilePictureRequest, opts ...grpc.CallOption) (Ngobrel_GetProfilePictureClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Ngobrel_serviceDesc.Streams[5], "/Ngobrel/GetProfilePicture", opts...)
-	if err != nil {
+func _Ngobrel_GetContacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContactsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	x := &ngobrelGetProfilePictureClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+	if interceptor == nil {
+		return srv.(NgobrelServer).GetContacts(ctx, in)
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Ngobrel/GetContacts",
 	}
-	return x, nil
-}
-
-type Ngobrel_GetProfilePictureClient interface {
-	Recv() (*GetProfilePictureResponse, error)
-	grpc.ClientStream
-}
-
-type ngobrelGetProfilePictureClient struct {
-	grpc.ClientStream
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NgobrelServer).GetContacts(ctx, req.(*GetContactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *ngobrelGetProfilePictureClient) Recv() (*GetProfilePictureResponse, error) {
-	m := new(GetProfilePictureResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func _Ngobrel_PutContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutContactRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return m, nil
+	if interceptor == nil {
+		return srv.(NgobrelServer).PutContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Ngobrel/PutContact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NgobrelServer).PutContact(ctx, req.(*PutContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *ngobrelClient) VerifyOTP(ctx context.Context, in *VerifyOTPRequest, opts ...grpc.CallOption) (*VerifyOTPResponse, error) {
-	out := new(VerifyOTPResponse)
-	err := c.cc.Invoke(ctx, "/Ngobrel/VerifyOTP", in, out, opts...)
-	if err != nil {
+func _Ngobrel_DeleteContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteContactRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(NgobrelServer).DeleteContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Ngobrel/DeleteContact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NgobrelServer).DeleteContact(ctx, req.(*DeleteContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *ngobrelClient) Echo(ctx context.Context, in *EchoRequest, opts ...grpc.CallOption) (*EchoResponse, error) {
-	out := new(EchoResponse)
-	err := c.cc.Invoke(ctx, "/Ngobrel/Echo", in, out, opts...)
-	if err != nil {
+func _Ngobrel_BlockContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockContactRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(NgobrelServer).BlockContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Ngobrel/BlockContact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NgobrelServer).BlockContact(ctx, req.(*BlockContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *ngobrelClient) RegisterFCM(ctx context.Context, in *RegisterFCMRequest, opts ...grpc.CallOption) (*RegisterFCMResponse, error) {
-	out := new(RegisterFCMResponse)
-	err := c.cc.Invoke(ctx, "/Ngobrel/RegisterFCM", in, out, opts...)
-	if err != nil {
+func _Ngobrel_UnblockContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockContactRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(NgobrelServer).UnblockContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Ngobrel/UnblockContact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NgobrelServer).UnblockContact(ctx, req.(*UnblockContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *ngobrelClient) AckMessageNotificationStream(ctx context.Context, in *AckMessageNotificationStreamRequest, opts ...grpc.CallOption) (*AckMessageNotificationStreamResponse, error) {
-	out := new(AckMessageNotificationStreamResponse)
-	err := c.cc.Invoke(ctx, "/Ngobrel/AckMessageNotificationStream", in, out, opts...)
-	if err != nil {
+func _Ngobrel_CreateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProfileRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// NgobrelServer is the server API for Ngobrel service.
-type NgobrelServer interface {
-	// *
-	// Sends message
-	PutMessage(context.Context, *PutMessageRequest) (*PutMessageResponse, error)
-	// *
-	// Gets messages for currently logged in deviceID
-	GetMessages(*GetMessagesRequest, Ngobrel_GetMessagesServer) error
-	// *
-	// Get notifications for incoming messages
-	GetMessageNotification(*GetMessagesRequest, Ngobrel_GetMessageNotificationServer) error
-	// *
-	// Uploads media file
-	UploadMedia(Ngobrel_UploadMediaServer) error
-	// *
-	// Gets media file
-	GetMedia(*GetMediaRequest, Ngobrel_GetMediaServer) error
-	// *
-	// Creates a group conversation
-	CreateGroupConversation(context.Context, *CreateGroupConversationRequest) (*CreateGroupConversationResponse, error)
-	// *
-	// Creates a new conversation, either a peer-to-peer or group conversation
-	CreateConversation(context.Context, *CreateConversationRequest) (*CreateConversationResponse, error)
-	// *
-	// List available conversations for currently logged in userID
-	ListConversations(context.Context, *ListConversationsRequest) (*ListConversationsResponse, error)
-	// *
-	// Updates a conversation
-	UpdateConversation(context.Context, *UpdateConversationRequest) (*UpdateConversationResponse, error)
-	// *
-	// Lists all participants in a group
-	ListGroupParticipants(context.Context, *ListGroupParticipantsRequest) (*ListGroupParticipantsResponse, error)
-	// *
-	// Removes an admin role of a group from a user
-	RemoveAdminRole(context.Context, *RemoveAdminRoleRequest) (*RemoveAdminRoleResponse, error)
-	// *
-	// Removes a user from a group
-	RemoveFromGroup(context.Context, *RemoveFromGroupRequest) (*RemoveFromGroupResponse, error)
-	// *
-	// Invite other people into a conversation
-	AddToGroup(context.Context, *AddToGroupRequest) (*AddToGroupResponse, error)
-	// *
-	// Exits from a group
-	ExitFromGroup(context.Context, *ExitFromGroupRequest) (*ExitFromGroupResponse, error)
-	// *
-	// Renames a group name
-	RenameGroup(context.Context, *RenameGroupRequest) (*RenameGroupResponse, error)
-	// *
-	// Sets the reception state of a particular message
-	PutMessageState(context.Context, *PutMessageStateRequest) (*PutMessageStateResponse, error)
-	// *
-	// Gets contact list of the currently logged user ID
-	GetContacts(context.Context, *GetContactsRequest) (*GetContactsResponse, error)
-	// *
-	// Adds a new contact into the contact list of the currently logged user ID
-	PutContact(context.Context, *PutContactRequest) (*PutContactResponse, error)
-	// *
-	// Deletes a contact from the contact list of the currently logged in user ID
-	DeleteContact(context.Context, *DeleteContactRequest) (*DeleteContactResponse, error)
-	// *
-	// Blocks a contact
-	BlockContact(context.Context, *BlockContactRequest) (*BlockContactResponse, error)
-	// *
-	// Unblocks a contact
-	UnblockContact(context.Context, *UnblockContactRequest) (*UnblockContactResponse, error)
-	// *
-	// Registers a new profile
-	CreateProfile(context.Context, *CreateProfileRequest) (*CreateProfileResponse, error)
-	// *
-	// Edits the profile of currently logged in user ID
-	EditProfile(context.Context, *EditProfileRequest) (*EditProfileResponse, error)
-	// *
-	// Gets the profile of a specific user ID
-	GetProfile(context.Context, *GetProfileRequest) (*GetProfileResponse, error)
-	// *
-	// Uploads profile picture
-	UploadProfilePicture(Ngobrel_UploadProfilePictureServer) error
-	// *
-	// Gets profile picture
-	GetProfilePicture(*GetProfilePictureRequest, Ngobrel_GetProfilePictureServer) error
-	// *
-	// Verify OTP
-	VerifyOTP(context.Context, *VerifyOTPRequest) (*VerifyOTPResponse, error)
-	// *
-	// Echo
-	Echo(context.Context, *EchoRequest) (*EchoResponse, error)
-	// *
-	// Registers an FCM token
-	RegisterFCM(context.Context, *RegisterFCMRequest) (*RegisterFCMResponse, error)
-	// *
-	// Acknowledges an notification
-	AckMessageNotificationStream(context.Context, *AckMessageNotificationStreamRequest) (*AckMessageNotificationStreamResponse, error)
-}
-
-func RegisterNgobrelServer(s *grpc.Server, srv NgobrelServer) {
-	s.RegisterService(&_Ngobrel_serviceDesc, srv)
+	if interceptor == nil {
+		return srv.(NgobrelServer).CreateProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Ngobrel/CreateProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NgobrelServer).CreateProfile(ctx, req.(*CreateProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Ngobrel_PutMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PutMessageRequest)
+func _Ngobrel_EditProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditProfileRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NgobrelServer).PutMessage(ctx, in)
+		return srv.(NgobrelServer).EditProfile(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Ngobrel/PutMessage",
+		FullMethod: "/Ngobrel/EditProfile",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NgobrelServer).PutMessage(ctx, req.(*PutMessageRequest))
+		return srv.(NgobrelServer).EditProfile(ctx, req.(*EditProfileRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ngobrel_GetMessages_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetMessagesRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _Ngobrel_GetProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(NgobrelServer).GetMessages(m, &ngobrelGetMessagesServer{stream})
-}
-
-type Ngobrel_GetMessagesServer interface {
-	Send(*GetMessagesResponseItem) error
-	grpc.ServerStream
-}
-
-type ngobrelGetMessagesServer struct {
-	grpc.ServerStream
-}
-
-func (x *ngobrelGetMessagesServer) Send(m *GetMessagesResponseItem) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _Ngobrel_GetMessageNotification_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetMessagesRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+	if interceptor == nil {
+		return srv.(NgobrelServer).GetProfile(ctx, in)
 	}
-	return srv.(NgobrelServer).GetMessageNotification(m, &ngobrelGetMessageNotificationServer{stream})
-}
-
-type Ngobrel_GetMessageNotificationServer interface {
-	Send(*GetMessageNotificationStream) error
-	grpc.ServerStream
-}
-
-type ngobrelGetMessageNotificationServer struct {
-	grpc.ServerStream
-}
-
-func (x *ngobrelGetMessageNotificationServer) Send(m *GetMessageNotificationStream) error {
-	return x.ServerStream.SendMsg(m)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Ngobrel/GetProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NgobrelServer).GetProfile(ctx, req.(*GetProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Ngobrel_UploadMedia_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(NgobrelServer).UploadMedia(&ngobrelUploadMediaServer{stream})
+func _Ngobrel_UploadProfilePicture_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(NgobrelServer).UploadProfilePicture(&ngobrelUploadProfilePictureServer{stream})
 }
 
-type Ngobrel_UploadMediaServer interface {
-	SendAndClose(*UploadMediaResponse) error
-	Recv() (*UploadMediaRequest, error)
+type Ngobrel_UploadProfilePictureServer interface {
+	SendAndClose(*UploadProfilePictureResponse) error
+	Recv() (*UploadProfilePictureRequest, error)
 	grpc.ServerStream
 }
 
-type ngobrelUploadMediaServer struct {
+type ngobrelUploadProfilePictureServer struct {
 	grpc.ServerStream
 }
 
-func (x *ngobrelUploadMediaServer) SendAndClose(m *UploadMediaResponse) error {
+func (x *ngobrelUploadProfilePictureServer) SendAndClose(m *UploadProfilePictureResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func (x *ngobrelUploadMediaServer) Recv() (*UploadMediaRequest, error) {
-	m := new(UploadMediaRequest)
+func (x *ngobrelUploadProfilePictureServer) Recv() (*UploadProfilePictureRequest, error) {
+	m := new(UploadProfilePictureRequest)
 	if err := x.ServerStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func _Ngobrel_GetMedia_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetMediaRequest)
+func _Ngobrel_GetProfilePicture_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetProfilePictureRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(NgobrelServer).GetMedia(m, &ngobrelGetMediaServer{stream})
+	return srv.(NgobrelServer).GetProfilePicture(m, &ngobrelGetProfilePictureServer{stream})
 }
 
-type Ngobrel_GetMediaServer interface {
-	Send(*GetMediaResponse) error
+type Ngobrel_GetProfilePictureServer interface {
+	Send(*GetProfilePictureResponse) error
 	grpc.ServerStream
 }
 
-type ngobrelGetMediaServer struct {
+type ngobrelGetProfilePictureServer struct {
 	grpc.ServerStream
 }
 
-func (x *ngobrelGetMediaServer) Send(m *GetMediaResponse) error {
+func (x *ngobrelGetProfilePictureServer) Send(m *GetProfilePictureResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _Ngobrel_CreateGroupConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateGroupConversationRequest)
+func _Ngobrel_VerifyOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyOTPRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NgobrelServer).CreateGroupConversation(ctx, in)
+		return srv.(NgobrelServer).VerifyOTP(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Ngobrel/CreateGroupConversation",
+		FullMethod: "/Ngobrel/VerifyOTP",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NgobrelServer).CreateGroupConversation(ctx, req.(*CreateGroupConversationRequest))
+		return srv.(NgobrelServer).VerifyOTP(ctx, req.(*VerifyOTPRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ngobrel_CreateConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateConversationRequest)
+func _Ngobrel_RequestDeviceLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestDeviceLinkRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NgobrelServer).CreateConversation(ctx, in)
+		return srv.(NgobrelServer).RequestDeviceLink(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Ngobrel/CreateConversation",
+		FullMethod: "/Ngobrel/RequestDeviceLink",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NgobrelServer).CreateConversation(ctx, req.(*CreateConversationRequest))
+		return srv.(NgobrelServer).RequestDeviceLink(ctx, req.(*RequestDeviceLinkRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ngobrel_ListConversations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListConversationsRequest)
+func _Ngobrel_ApproveDeviceLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveDeviceLinkRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NgobrelServer).ListConversations(ctx, in)
+		return srv.(NgobrelServer).ApproveDeviceLink(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Ngobrel/ListConversations",
+		FullMethod: "/Ngobrel/ApproveDeviceLink",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NgobrelServer).ListConversations(ctx, req.(*ListConversationsRequest))
+		return srv.(NgobrelServer).ApproveDeviceLink(ctx, req.(*ApproveDeviceLinkRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ngobrel_UpdateConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateConversationRequest)
+func _Ngobrel_CompleteDeviceLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteDeviceLinkRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NgobrelServer).UpdateConversation(ctx, in)
+		return srv.(NgobrelServer).CompleteDeviceLink(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Ngobrel/UpdateConversation",
+		FullMethod: "/Ngobrel/CompleteDeviceLink",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NgobrelServer).UpdateConversation(ctx, req.(*UpdateConversationRequest))
+		return srv.(NgobrelServer).CompleteDeviceLink(ctx, req.(*CompleteDeviceLinkRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ngobrel_ListGroupParticipants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListGroupParticipantsRequest)
+func _Ngobrel_ListDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDevicesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NgobrelServer).ListGroupParticipants(ctx, in)
+		return srv.(NgobrelServer).ListDevices(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Ngobrel/ListGroupParticipants",
+		FullMethod: "/Ngobrel/ListDevices",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NgobrelServer).ListGroupParticipants(ctx, req.(*ListGroupParticipantsRequest))
+		return srv.(NgobrelServer).ListDevices(ctx, req.(*ListDevicesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ngobrel_RemoveAdminRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveAdminRoleRequest)
+func _Ngobrel_RenameDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameDeviceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NgobrelServer).RemoveAdminRole(ctx, in)
+		return srv.(NgobrelServer).RenameDevice(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Ngobrel/RemoveAdminRole",
+		FullMethod: "/Ngobrel/RenameDevice",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NgobrelServer).RemoveAdminRole(ctx, req.(*RemoveAdminRoleRequest))
+		return srv.(NgobrelServer).RenameDevice(ctx, req.(*RenameDeviceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ngobrel_RemoveFromGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveFromGroupRequest)
+func _Ngobrel_RemoveDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveDeviceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NgobrelServer).RemoveFromGroup(ctx, in)
+		return srv.(NgobrelServer).RemoveDevice(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Ngobrel/RemoveFromGroup",
+		FullMethod: "/Ngobrel/RemoveDevice",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NgobrelServer).RemoveFromGroup(ctx, req.(*RemoveFromGroupRequest))
+		return srv.(NgobrelServer).RemoveDevice(ctx, req.(*RemoveDeviceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ngobrel_AddToGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddToGroupRequest)
+func _Ngobrel_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NgobrelServer).AddToGroup(ctx, in)
+		return srv.(NgobrelServer).RefreshToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Ngobrel/AddToGroup",
+		FullMethod: "/Ngobrel/RefreshToken",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NgobrelServer).AddToGroup(ctx, req.(*AddToGroupRequest))
+		return srv.(NgobrelServer).RefreshToken(ctx, req.(*RefreshTokenRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ngobrel_ExitFromGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExitFromGroupRequest)
+func _Ngobrel_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NgobrelServer).ExitFromGroup(ctx, in)
+		return srv.(NgobrelServer).Logout(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Ngobrel/ExitFromGroup",
+		FullMethod: "/Ngobrel/Logout",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NgobrelServer).ExitFromGroup(ctx, req.(*ExitFromGroupRequest))
+		return srv.(NgobrelServer).Logout(ctx, req.(*LogoutRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ngobrel_RenameGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RenameGroupRequest)
+func _Ngobrel_LogoutAllDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutAllDevicesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NgobrelServer).RenameGroup(ctx, in)
+		return srv.(NgobrelServer).LogoutAllDevices(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Ngobrel/RenameGroup",
+		FullMethod: "/Ngobrel/LogoutAllDevices",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NgobrelServer).RenameGroup(ctx, req.(*RenameGroupRequest))
+		return srv.(NgobrelServer).LogoutAllDevices(ctx, req.(*LogoutAllDevicesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ngobrel_PutMessageState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PutMessageStateRequest)
+func _Ngobrel_RequestPhoneNumberChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestPhoneNumberChangeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NgobrelServer).PutMessageState(ctx, in)
+		return srv.(NgobrelServer).RequestPhoneNumberChange(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Ngobrel/PutMessageState",
+		FullMethod: "/Ngobrel/RequestPhoneNumberChange",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NgobrelServer).PutMessageState(ctx, req.(*PutMessageStateRequest))
+		return srv.(NgobrelServer).RequestPhoneNumberChange(ctx, req.(*RequestPhoneNumberChangeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ngobrel_GetContacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetContactsRequest)
+func _Ngobrel_ChangePhoneNumber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePhoneNumberRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NgobrelServer).GetContacts(ctx, in)
+		return srv.(NgobrelServer).ChangePhoneNumber(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Ngobrel/GetContacts",
+		FullMethod: "/Ngobrel/ChangePhoneNumber",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NgobrelServer).GetContacts(ctx, req.(*GetContactsRequest))
+		return srv.(NgobrelServer).ChangePhoneNumber(ctx, req.(*ChangePhoneNumberRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ngobrel_PutContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PutContactRequest)
+func _Ngobrel_DeleteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NgobrelServer).PutContact(ctx, in)
+		return srv.(NgobrelServer).DeleteAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Ngobrel/PutContact",
+		FullMethod: "/Ngobrel/DeleteAccount",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NgobrelServer).PutContact(ctx, req.(*PutContactRequest))
+		return srv.(NgobrelServer).DeleteAccount(ctx, req.(*DeleteAccountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ngobrel_DeleteContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteContactRequest)
+func _Ngobrel_RequestDataExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestDataExportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NgobrelServer).DeleteContact(ctx, in)
+		return srv.(NgobrelServer).RequestDataExport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Ngobrel/DeleteContact",
+		FullMethod: "/Ngobrel/RequestDataExport",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NgobrelServer).DeleteContact(ctx, req.(*DeleteContactRequest))
+		return srv.(NgobrelServer).RequestDataExport(ctx, req.(*RequestDataExportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ngobrel_BlockContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BlockContactRequest)
+func _Ngobrel_GetDataExportStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataExportStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NgobrelServer).BlockContact(ctx, in)
+		return srv.(NgobrelServer).GetDataExportStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Ngobrel/BlockContact",
+		FullMethod: "/Ngobrel/GetDataExportStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NgobrelServer).BlockContact(ctx, req.(*BlockContactRequest))
+		return srv.(NgobrelServer).GetDataExportStatus(ctx, req.(*GetDataExportStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ngobrel_UnblockContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnblockContactRequest)
+func _Ngobrel_SetPIN_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPINRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NgobrelServer).UnblockContact(ctx, in)
+		return srv.(NgobrelServer).SetPIN(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Ngobrel/UnblockContact",
+		FullMethod: "/Ngobrel/SetPIN",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NgobrelServer).UnblockContact(ctx, req.(*UnblockContactRequest))
+		return srv.(NgobrelServer).SetPIN(ctx, req.(*SetPINRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ngobrel_CreateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateProfileRequest)
+func _Ngobrel_RemovePIN_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePINRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NgobrelServer).CreateProfile(ctx, in)
+		return srv.(NgobrelServer).RemovePIN(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Ngobrel/CreateProfile",
+		FullMethod: "/Ngobrel/RemovePIN",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NgobrelServer).CreateProfile(ctx, req.(*CreateProfileRequest))
+		return srv.(NgobrelServer).RemovePIN(ctx, req.(*RemovePINRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ngobrel_EditProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EditProfileRequest)
+func _Ngobrel_VerifyPIN_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyPINRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NgobrelServer).EditProfile(ctx, in)
+		return srv.(NgobrelServer).VerifyPIN(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Ngobrel/EditProfile",
+		FullMethod: "/Ngobrel/VerifyPIN",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NgobrelServer).EditProfile(ctx, req.(*EditProfileRequest))
+		return srv.(NgobrelServer).VerifyPIN(ctx, req.(*VerifyPINRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ngobrel_GetProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProfileRequest)
+func _Ngobrel_GetPINStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPINStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NgobrelServer).GetProfile(ctx, in)
+		return srv.(NgobrelServer).GetPINStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Ngobrel/GetProfile",
+		FullMethod: "/Ngobrel/GetPINStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NgobrelServer).GetProfile(ctx, req.(*GetProfileRequest))
+		return srv.(NgobrelServer).GetPINStatus(ctx, req.(*GetPINStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ngobrel_UploadProfilePicture_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(NgobrelServer).UploadProfilePicture(&ngobrelUploadProfilePictureServer{stream})
-}
-
-type Ngobrel_UploadProfilePictureServer interface {
-	SendAndClose(*UploadProfilePictureResponse) error
-	Recv() (*UploadProfilePictureRequest, error)
-	grpc.ServerStream
-}
-
-type ngobrelUploadProfilePictureServer struct {
-	grpc.ServerStream
-}
-
-func (x *ngobrelUploadProfilePictureServer) SendAndClose(m *UploadProfilePictureResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func (x *ngobrelUploadProfilePictureServer) Recv() (*UploadProfilePictureRequest, error) {
-	m := new(UploadProfilePictureRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
+func _Ngobrel_RequestEmailVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestEmailVerificationRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return m, nil
-}
-
-func _Ngobrel_GetProfilePicture_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetProfilePictureRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+	if interceptor == nil {
+		return srv.(NgobrelServer).RequestEmailVerification(ctx, in)
 	}
-	return srv.(NgobrelServer).GetProfilePicture(m, &ngobrelGetProfilePictureServer{stream})
-}
-
-type Ngobrel_GetProfilePictureServer interface {
-	Send(*GetProfilePictureResponse) error
-	grpc.ServerStream
-}
-
-type ngobrelGetProfilePictureServer struct {
-	grpc.ServerStream
-}
-
-func (x *ngobrelGetProfilePictureServer) Send(m *GetProfilePictureResponse) error {
-	return x.ServerStream.SendMsg(m)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Ngobrel/RequestEmailVerification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NgobrelServer).RequestEmailVerification(ctx, req.(*RequestEmailVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Ngobrel_VerifyOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VerifyOTPRequest)
+func _Ngobrel_VerifyEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyEmailRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NgobrelServer).VerifyOTP(ctx, in)
+		return srv.(NgobrelServer).VerifyEmail(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Ngobrel/VerifyOTP",
+		FullMethod: "/Ngobrel/VerifyEmail",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NgobrelServer).VerifyOTP(ctx, req.(*VerifyOTPRequest))
+		return srv.(NgobrelServer).VerifyEmail(ctx, req.(*VerifyEmailRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -4767,6 +8531,24 @@ func _Ngobrel_RegisterFCM_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Ngobrel_RegisterPushToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterPushTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NgobrelServer).RegisterPushToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Ngobrel/RegisterPushToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NgobrelServer).RegisterPushToken(ctx, req.(*RegisterPushTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Ngobrel_AckMessageNotificationStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AckMessageNotificationStreamRequest)
 	if err := dec(in); err != nil {
@@ -4833,6 +8615,50 @@ var _Ngobrel_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RenameGroup",
 			Handler:    _Ngobrel_RenameGroup_Handler,
 		},
+		{
+			MethodName: "UpdateGroupAvatar",
+			Handler:    _Ngobrel_UpdateGroupAvatar_Handler,
+		},
+		{
+			MethodName: "DisbandGroup",
+			Handler:    _Ngobrel_DisbandGroup_Handler,
+		},
+		{
+			MethodName: "EditGroup",
+			Handler:    _Ngobrel_EditGroup_Handler,
+		},
+		{
+			MethodName: "GetGroupInfo",
+			Handler:    _Ngobrel_GetGroupInfo_Handler,
+		},
+		{
+			MethodName: "BanFromGroup",
+			Handler:    _Ngobrel_BanFromGroup_Handler,
+		},
+		{
+			MethodName: "UnbanFromGroup",
+			Handler:    _Ngobrel_UnbanFromGroup_Handler,
+		},
+		{
+			MethodName: "ListGroupBans",
+			Handler:    _Ngobrel_ListGroupBans_Handler,
+		},
+		{
+			MethodName: "MuteGroupMember",
+			Handler:    _Ngobrel_MuteGroupMember_Handler,
+		},
+		{
+			MethodName: "UnmuteGroupMember",
+			Handler:    _Ngobrel_UnmuteGroupMember_Handler,
+		},
+		{
+			MethodName: "ListGroupMutes",
+			Handler:    _Ngobrel_ListGroupMutes_Handler,
+		},
+		{
+			MethodName: "DeleteConversation",
+			Handler:    _Ngobrel_DeleteConversation_Handler,
+		},
 		{
 			MethodName: "PutMessageState",
 			Handler:    _Ngobrel_PutMessageState_Handler,
@@ -4873,6 +8699,86 @@ var _Ngobrel_serviceDesc = grpc.ServiceDesc{
 			MethodName: "VerifyOTP",
 			Handler:    _Ngobrel_VerifyOTP_Handler,
 		},
+		{
+			MethodName: "RequestDeviceLink",
+			Handler:    _Ngobrel_RequestDeviceLink_Handler,
+		},
+		{
+			MethodName: "ApproveDeviceLink",
+			Handler:    _Ngobrel_ApproveDeviceLink_Handler,
+		},
+		{
+			MethodName: "CompleteDeviceLink",
+			Handler:    _Ngobrel_CompleteDeviceLink_Handler,
+		},
+		{
+			MethodName: "ListDevices",
+			Handler:    _Ngobrel_ListDevices_Handler,
+		},
+		{
+			MethodName: "RenameDevice",
+			Handler:    _Ngobrel_RenameDevice_Handler,
+		},
+		{
+			MethodName: "RemoveDevice",
+			Handler:    _Ngobrel_RemoveDevice_Handler,
+		},
+		{
+			MethodName: "RefreshToken",
+			Handler:    _Ngobrel_RefreshToken_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _Ngobrel_Logout_Handler,
+		},
+		{
+			MethodName: "LogoutAllDevices",
+			Handler:    _Ngobrel_LogoutAllDevices_Handler,
+		},
+		{
+			MethodName: "RequestPhoneNumberChange",
+			Handler:    _Ngobrel_RequestPhoneNumberChange_Handler,
+		},
+		{
+			MethodName: "ChangePhoneNumber",
+			Handler:    _Ngobrel_ChangePhoneNumber_Handler,
+		},
+		{
+			MethodName: "DeleteAccount",
+			Handler:    _Ngobrel_DeleteAccount_Handler,
+		},
+		{
+			MethodName: "RequestDataExport",
+			Handler:    _Ngobrel_RequestDataExport_Handler,
+		},
+		{
+			MethodName: "GetDataExportStatus",
+			Handler:    _Ngobrel_GetDataExportStatus_Handler,
+		},
+		{
+			MethodName: "SetPIN",
+			Handler:    _Ngobrel_SetPIN_Handler,
+		},
+		{
+			MethodName: "RemovePIN",
+			Handler:    _Ngobrel_RemovePIN_Handler,
+		},
+		{
+			MethodName: "VerifyPIN",
+			Handler:    _Ngobrel_VerifyPIN_Handler,
+		},
+		{
+			MethodName: "GetPINStatus",
+			Handler:    _Ngobrel_GetPINStatus_Handler,
+		},
+		{
+			MethodName: "RequestEmailVerification",
+			Handler:    _Ngobrel_RequestEmailVerification_Handler,
+		},
+		{
+			MethodName: "VerifyEmail",
+			Handler:    _Ngobrel_VerifyEmail_Handler,
+		},
 		{
 			MethodName: "Echo",
 			Handler:    _Ngobrel_Echo_Handler,
@@ -4881,6 +8787,10 @@ var _Ngobrel_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterFCM",
 			Handler:    _Ngobrel_RegisterFCM_Handler,
 		},
+		{
+			MethodName: "RegisterPushToken",
+			Handler:    _Ngobrel_RegisterPushToken_Handler,
+		},
 		{
 			MethodName: "AckMessageNotificationStream",
 			Handler:    _Ngobrel_AckMessageNotificationStream_Handler,
@@ -4921,148 +8831,244 @@ var _Ngobrel_serviceDesc = grpc.ServiceDesc{
 	Metadata: "ngobrel.proto",
 }
 
-func init() { proto.RegisterFile("ngobrel.proto", fileDescriptor_ngobrel_1a3351a33146eeae) }
-
-var fileDescriptor_ngobrel_1a3351a33146eeae = []byte{
-	// 2230 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x1a, 0xcb, 0x72, 0xdb, 0xc8,
-	0x91, 0x20, 0xa9, 0x07, 0x5b, 0x0f, 0x93, 0xc3, 0x87, 0x28, 0x58, 0x5e, 0xab, 0x66, 0xed, 0x44,
-	0xb6, 0x2b, 0x63, 0xc5, 0xde, 0xd4, 0x6e, 0x12, 0x6f, 0xb2, 0x5e, 0x49, 0x76, 0x14, 0xaf, 0x64,
-	0x16, 0x2d, 0x6f, 0x52, 0x39, 0xec, 0x16, 0x04, 0x8e, 0x25, 0x94, 0x48, 0x80, 0x01, 0x86, 0xca,
-	0xea, 0x92, 0x4b, 0x6e, 0x39, 0xe5, 0x0f, 0x52, 0xb9, 0xe7, 0xaf, 0xf2, 0x15, 0x39, 0x25, 0x35,
-	0x33, 0x78, 0x0c, 0x80, 0x01, 0x41, 0x97, 0xf6, 0xa2, 0x62, 0x37, 0x66, 0xba, 0x7b, 0x7a, 0xa6,
-	0xdf, 0x82, 0x0d, 0xf7, 0xc2, 0x3b, 0xf7, 0xe9, 0x98, 0x4c, 0x7d, 0x8f, 0x79, 0xf8, 0x67, 0xd0,
-	0xfe, 0x7a, 0xec, 0xd9, 0x57, 0x07, 0x9e, 0xcb, 0x2c, 0x9b, 0x0d, 0xe9, 0x9f, 0x67, 0x34, 0x60,
-	0xa8, 0x07, 0xcb, 0xb3, 0x80, 0xfa, 0xc7, 0x87, 0x7d, 0x63, 0xd7, 0xd8, 0x6b, 0x0c, 0x43, 0x08,
-	0x13, 0xe8, 0xa4, 0x97, 0x07, 0x53, 0xcf, 0x0d, 0x68, 0xe1, 0xfa, 0xa7, 0xd0, 0x7d, 0xef, 0x9e,
-	0x7f, 0x04, 0x83, 0x7d, 0xe8, 0x65, 0x37, 0x94, 0xb0, 0x78, 0x06, 0xfd, 0xd7, 0x94, 0x0d, 0x7c,
-	0xef, 0x83, 0x33, 0xa6, 0x03, 0xc7, 0x66, 0x33, 0x9f, 0x96, 0x71, 0xf9, 0x1c, 0xb6, 0x35, 0x7b,
-	0x42, 0x46, 0x26, 0xac, 0xda, 0x9e, 0xcb, 0xa8, 0xcb, 0x02, 0xb1, 0x6d, 0x7d, 0x18, 0xc3, 0xf8,
-	0xa7, 0xb0, 0x76, 0x64, 0x5f, 0x7a, 0x11, 0xfd, 0x3e, 0xac, 0x4c, 0x68, 0x10, 0x58, 0x17, 0x34,
-	0x64, 0x10, 0x81, 0xf8, 0x01, 0xac, 0xcb, 0x85, 0x21, 0xd1, 0x0e, 0x2c, 0xf9, 0x74, 0x3a, 0xbe,
-	0x09, 0xd7, 0x49, 0x00, 0xff, 0x0e, 0xd0, 0x90, 0xba, 0xd6, 0x84, 0xbe, 0xf6, 0xbd, 0xd9, 0x54,
-	0xa1, 0x7a, 0xc1, 0xe1, 0x58, 0xec, 0x08, 0xe4, 0x5f, 0x5c, 0xfa, 0x97, 0x53, 0x6b, 0x42, 0xfb,
-	0x55, 0xf9, 0x25, 0x04, 0xf1, 0x53, 0x68, 0xa7, 0x28, 0x85, 0x6c, 0xfb, 0xb0, 0x12, 0xcc, 0x6c,
-	0x9b, 0x06, 0xf2, 0x28, 0xab, 0xc3, 0x08, 0xc4, 0xfb, 0xd0, 0x39, 0xfa, 0xc1, 0x61, 0xaf, 0x7c,
-	0x6f, 0xb2, 0x18, 0x73, 0xfc, 0x73, 0xe8, 0x66, 0x76, 0x94, 0x32, 0xf9, 0x3d, 0xf4, 0x86, 0x74,
-	0xe2, 0x5d, 0xd3, 0x97, 0xa3, 0x89, 0xe3, 0x0e, 0xbd, 0x31, 0x2d, 0x3f, 0x63, 0x72, 0x67, 0xd5,
-	0xd4, 0x9d, 0x3d, 0x87, 0xad, 0x1c, 0xad, 0xc5, 0x05, 0x58, 0xfc, 0x9c, 0xe5, 0x02, 0x7c, 0x8c,
-	0x06, 0xbe, 0x80, 0x9d, 0x6f, 0x9c, 0x80, 0x89, 0xe5, 0x03, 0xcb, 0x67, 0x8e, 0xed, 0x4c, 0x2d,
-	0x97, 0x05, 0xe5, 0xea, 0xfe, 0x16, 0xee, 0x15, 0xec, 0x0c, 0x99, 0xfe, 0x02, 0xd6, 0xa7, 0x0a,
-	0xbe, 0x6f, 0xec, 0xd6, 0xf6, 0xd6, 0x9e, 0xb5, 0x48, 0x76, 0xc7, 0x30, 0xb5, 0x0c, 0x9f, 0x43,
-	0xf3, 0x5b, 0xea, 0x3b, 0x1f, 0x6e, 0xde, 0x9e, 0x0d, 0x22, 0x29, 0x76, 0x61, 0x6d, 0x7a, 0xe9,
-	0xb9, 0xf4, 0x74, 0x36, 0x39, 0xa7, 0x7e, 0x28, 0x89, 0x8a, 0x42, 0x4d, 0xa8, 0xbd, 0x3d, 0x1b,
-	0x84, 0x1a, 0xe1, 0x3f, 0xb9, 0x99, 0x8c, 0xe8, 0xb5, 0x63, 0xd3, 0xe3, 0xc3, 0x7e, 0x4d, 0xa0,
-	0x63, 0x18, 0x3f, 0x82, 0x96, 0xc2, 0x23, 0x31, 0x01, 0xe6, 0x5d, 0x51, 0x37, 0x32, 0x01, 0x01,
-	0xe0, 0x33, 0xe8, 0x1c, 0xf8, 0xd4, 0x62, 0x34, 0xb4, 0xc6, 0x48, 0x24, 0x95, 0xbc, 0x91, 0x26,
-	0x9f, 0x15, 0xb7, 0x9a, 0x13, 0x17, 0xbf, 0x81, 0x6e, 0x86, 0xea, 0x7c, 0x2f, 0xc2, 0xd9, 0x79,
-	0x6c, 0x7a, 0x48, 0xcf, 0x67, 0x17, 0x21, 0xbd, 0x18, 0xc6, 0x7f, 0x37, 0x00, 0x1d, 0x8d, 0x1c,
-	0x96, 0x91, 0x10, 0x41, 0x9d, 0x1b, 0x5c, 0x48, 0x48, 0xfc, 0xe6, 0x64, 0x38, 0x41, 0xc5, 0x42,
-	0x63, 0x18, 0x7d, 0x02, 0x60, 0xcf, 0x02, 0xe6, 0x4d, 0x0e, 0x2d, 0x66, 0x85, 0x2a, 0x53, 0x30,
-	0xe8, 0x01, 0x6c, 0x58, 0xd7, 0x16, 0xb3, 0xfc, 0x13, 0x3a, 0x72, 0xac, 0xe3, 0x51, 0xbf, 0x2e,
-	0x96, 0xa4, 0x91, 0xf8, 0x18, 0xda, 0x29, 0x59, 0xca, 0x5e, 0xa0, 0xea, 0xa3, 0xaa, 0x69, 0x1f,
-	0xf5, 0x04, 0x5a, 0x89, 0x17, 0x2c, 0x73, 0x99, 0xff, 0x36, 0x00, 0xa9, 0xab, 0x43, 0xbe, 0x1f,
-	0xab, 0x84, 0xcc, 0xd5, 0xd5, 0xf2, 0x2f, 0x2d, 0xad, 0xa6, 0x7a, 0xb9, 0x9a, 0x96, 0x74, 0x6a,
-	0x32, 0xa1, 0xcf, 0xad, 0xe7, 0xc0, 0x73, 0xaf, 0xa9, 0x1f, 0x58, 0xcc, 0xf1, 0xdc, 0xc8, 0xe6,
-	0xf0, 0x6f, 0x61, 0x5b, 0xf3, 0x2d, 0x3c, 0x10, 0x86, 0xfa, 0xd8, 0x09, 0x58, 0x68, 0x4d, 0x9b,
-	0x24, 0xbd, 0x4a, 0x7c, 0xc3, 0xff, 0xab, 0xc2, 0x46, 0x0a, 0xcf, 0xb5, 0x66, 0x5f, 0x5a, 0x2c,
-	0xd1, 0x9a, 0x84, 0x44, 0x2c, 0xb9, 0xb4, 0x98, 0xaa, 0x8a, 0x08, 0xe6, 0x17, 0x43, 0x7f, 0xb0,
-	0xa9, 0x3f, 0x65, 0xa1, 0x1a, 0x22, 0x10, 0xed, 0x40, 0x83, 0x39, 0x13, 0x1a, 0x30, 0x6b, 0x32,
-	0x15, 0x1a, 0xa8, 0x0d, 0x13, 0x04, 0xc2, 0xb0, 0xee, 0x7a, 0xcc, 0xf9, 0xe0, 0xd8, 0x82, 0xb9,
-	0x38, 0x7f, 0x6d, 0x98, 0xc2, 0x45, 0x7c, 0xcf, 0x6e, 0xa6, 0xb4, 0xbf, 0xbc, 0x6b, 0xec, 0x2d,
-	0x0d, 0x63, 0x98, 0xef, 0x77, 0x02, 0xe1, 0x24, 0x84, 0x27, 0xed, 0xaf, 0x88, 0xf7, 0x92, 0xc2,
-	0xf1, 0xf3, 0x48, 0x7d, 0xf6, 0x57, 0xe5, 0x79, 0x24, 0x84, 0xf6, 0xe0, 0x8e, 0xfc, 0x75, 0x76,
-	0x39, 0x9b, 0x9c, 0xbb, 0x96, 0x33, 0xee, 0x37, 0x44, 0x88, 0xcc, 0xa2, 0xb3, 0x17, 0x0d, 0xf9,
-	0x8b, 0x56, 0x9f, 0xc9, 0xda, 0x5c, 0x5b, 0x59, 0xcf, 0x3e, 0x02, 0x7c, 0x05, 0xdb, 0xef, 0xa7,
-	0x23, 0x8b, 0x51, 0xf5, 0x1a, 0x94, 0x27, 0xac, 0xbd, 0x0c, 0x45, 0xe1, 0xd5, 0x39, 0x0a, 0xaf,
-	0x65, 0x14, 0x8e, 0x07, 0x60, 0xea, 0x98, 0xdd, 0xc2, 0xf2, 0x08, 0x74, 0x0e, 0xe9, 0x98, 0x0a,
-	0x8a, 0x8b, 0x64, 0x45, 0x6f, 0xa0, 0x9b, 0x59, 0x7f, 0x0b, 0xe6, 0x1d, 0x61, 0xc8, 0x21, 0xa5,
-	0xd8, 0x28, 0x3e, 0x83, 0x76, 0x0a, 0x1b, 0x32, 0xb8, 0x97, 0x32, 0x87, 0x06, 0x89, 0x17, 0x48,
-	0x4b, 0xf8, 0x8f, 0x01, 0xab, 0x11, 0x8a, 0x4b, 0x3f, 0xa5, 0xaa, 0xf4, 0x12, 0x8a, 0x7d, 0x44,
-	0x55, 0xf1, 0x11, 0xd9, 0x47, 0x5c, 0xd3, 0x3c, 0xe2, 0x47, 0xd0, 0x94, 0xaf, 0xea, 0x7b, 0x16,
-	0xbf, 0xb6, 0xfa, 0x42, 0xaf, 0x6d, 0x69, 0xfe, 0x6b, 0x5b, 0x9e, 0xfb, 0xda, 0x56, 0x72, 0xaf,
-	0xed, 0x1c, 0x5a, 0x83, 0x19, 0xcb, 0xdc, 0x55, 0x79, 0xcc, 0x7c, 0x02, 0x6b, 0xb6, 0xdc, 0x23,
-	0xe8, 0xf2, 0xe3, 0xa7, 0x54, 0xa8, 0x7e, 0xe5, 0xa9, 0xa0, 0xca, 0xe3, 0x16, 0xf7, 0xfb, 0x07,
-	0xb8, 0xff, 0x9a, 0xb2, 0x13, 0x09, 0x0d, 0xa9, 0x4d, 0xa7, 0x5c, 0x9b, 0xef, 0x98, 0xc5, 0xca,
-	0x9c, 0x3c, 0xb7, 0x83, 0x90, 0x4a, 0x9c, 0xfd, 0x24, 0x08, 0x3c, 0x84, 0xdd, 0x62, 0xc2, 0xa1,
-	0xc0, 0x04, 0x96, 0x03, 0x66, 0xb1, 0x99, 0x94, 0x77, 0xf3, 0x59, 0x8f, 0xe8, 0xd7, 0x87, 0xab,
-	0xf0, 0x29, 0xf4, 0x12, 0x9a, 0x3f, 0x82, 0x8c, 0xbf, 0x81, 0xad, 0x1c, 0xbd, 0x50, 0xb4, 0x4f,
-	0x61, 0x89, 0x33, 0xa5, 0xa1, 0x64, 0x1b, 0x24, 0xb5, 0x4a, 0x7e, 0xc3, 0x7f, 0x85, 0xde, 0x60,
-	0xa6, 0x95, 0x27, 0xc5, 0xd7, 0x90, 0x3e, 0x22, 0x46, 0x28, 0xe7, 0xae, 0x2e, 0x72, 0x6e, 0xc5,
-	0x47, 0xd5, 0x54, 0x1f, 0xc5, 0x93, 0xcc, 0x1c, 0xff, 0xd2, 0x24, 0xd3, 0x83, 0xfb, 0xc9, 0x26,
-	0xfd, 0x8d, 0xe7, 0xa4, 0x6f, 0xdc, 0x42, 0x7a, 0xfc, 0x02, 0x76, 0x8b, 0x19, 0x96, 0x8a, 0xeb,
-	0xc3, 0xb6, 0x4c, 0xce, 0x0a, 0x9c, 0xb7, 0xf6, 0xda, 0x13, 0x85, 0x55, 0x53, 0x4e, 0xfd, 0x21,
-	0xd4, 0x19, 0x8f, 0x72, 0x35, 0x21, 0x78, 0x2b, 0x15, 0xaf, 0x79, 0xb8, 0x1b, 0x8a, 0xcf, 0xf8,
-	0x14, 0x4c, 0x1d, 0xcf, 0x24, 0x2b, 0x2c, 0x8a, 0x18, 0x05, 0x46, 0xf6, 0x1c, 0xb6, 0x63, 0x8f,
-	0xbc, 0x68, 0x00, 0xe2, 0x81, 0x44, 0xb7, 0xe9, 0x16, 0xb6, 0x3e, 0x82, 0xd6, 0xcb, 0xd1, 0xe8,
-	0xcc, 0x5b, 0xb0, 0xb4, 0xc9, 0x96, 0x0c, 0xd5, 0xc5, 0x4a, 0x06, 0x02, 0x48, 0xe5, 0x92, 0xc8,
-	0x5b, 0x50, 0xba, 0xfc, 0xc3, 0x00, 0xf4, 0x7e, 0x3a, 0xf6, 0xac, 0x91, 0x48, 0xc7, 0x94, 0x94,
-	0x9e, 0xe7, 0x8e, 0xa7, 0x49, 0xbe, 0x18, 0xc3, 0xdc, 0x9b, 0x86, 0x45, 0xb6, 0xc8, 0x59, 0xc2,
-	0x94, 0x5e, 0x41, 0xf1, 0x15, 0x4e, 0x70, 0xe4, 0xda, 0xfe, 0xcd, 0x94, 0xd1, 0x91, 0xb8, 0xef,
-	0xd5, 0xa1, 0x8a, 0x4a, 0x15, 0xee, 0xf5, 0x4c, 0xe1, 0xfe, 0x14, 0xda, 0x29, 0x89, 0x92, 0x33,
-	0x4c, 0x44, 0xc6, 0x78, 0x98, 0x14, 0xf0, 0x02, 0xc4, 0xbf, 0x84, 0xbb, 0x72, 0x83, 0xbe, 0xb3,
-	0x30, 0xaf, 0x49, 0xf0, 0x05, 0xec, 0xe8, 0xb7, 0x96, 0x32, 0x7d, 0x02, 0x77, 0x84, 0xf7, 0x52,
-	0x94, 0x56, 0xbc, 0x98, 0x40, 0x33, 0x59, 0xbc, 0x40, 0xef, 0x42, 0xc6, 0xfd, 0xd0, 0x68, 0xe3,
-	0xb8, 0xef, 0xc3, 0x4e, 0x82, 0x3d, 0x55, 0xc2, 0xef, 0x3b, 0xe6, 0x53, 0x6b, 0x92, 0x4e, 0x8d,
-	0x8c, 0x6c, 0x2e, 0xda, 0x83, 0xe5, 0x80, 0xba, 0xa3, 0xb8, 0x08, 0x0b, 0x21, 0xbe, 0xcb, 0xa7,
-	0xb6, 0x33, 0x75, 0xa8, 0x1b, 0x65, 0xb7, 0x09, 0x02, 0xdf, 0xc0, 0xa7, 0x2f, 0xed, 0xab, 0x42,
-	0x9e, 0x8a, 0xcf, 0xfa, 0xd1, 0x59, 0x7f, 0x05, 0x0f, 0xe6, 0xb3, 0x2e, 0xf5, 0x5e, 0xff, 0xac,
-	0xaa, 0x21, 0x26, 0xce, 0x94, 0x8e, 0x19, 0x9d, 0xf0, 0x37, 0x1a, 0xb3, 0x8a, 0x2f, 0x4c, 0x45,
-	0xf1, 0x0b, 0x92, 0x72, 0xc6, 0x8e, 0x2c, 0x86, 0xd1, 0x4f, 0x60, 0x53, 0xfe, 0x3e, 0x4c, 0xd7,
-	0xd5, 0x19, 0x6c, 0xda, 0x97, 0xd7, 0xb3, 0x91, 0xe8, 0x31, 0x34, 0x43, 0xe0, 0x2c, 0x56, 0x9e,
-	0x2c, 0x11, 0x72, 0x78, 0x9e, 0xce, 0x87, 0xb8, 0x83, 0xe8, 0xd5, 0xc8, 0xdc, 0x28, 0x8b, 0x56,
-	0xa8, 0x26, 0x26, 0x28, 0x0b, 0x87, 0x1c, 0x1e, 0x7f, 0x27, 0x52, 0x99, 0x38, 0x3a, 0x84, 0x1a,
-	0x9d, 0x1f, 0x3f, 0x75, 0x52, 0x57, 0xf5, 0x52, 0xf3, 0x1b, 0x68, 0xa9, 0x0c, 0xe2, 0x7c, 0xac,
-	0x44, 0xf7, 0xb9, 0xcc, 0xa1, 0x54, 0x82, 0xda, 0xe2, 0x7a, 0xab, 0x2f, 0xae, 0xb7, 0x25, 0xbd,
-	0xde, 0xf8, 0xfd, 0x47, 0xb8, 0xb0, 0x4c, 0x91, 0x97, 0x91, 0xc1, 0xf2, 0x93, 0x46, 0x12, 0x71,
-	0x5f, 0xb9, 0x22, 0x84, 0x54, 0x51, 0xbc, 0xbe, 0x18, 0xcc, 0xce, 0xc7, 0x8e, 0xfd, 0x9a, 0xb2,
-	0x37, 0xf4, 0x26, 0x28, 0xab, 0x2f, 0x9e, 0x40, 0x37, 0xb3, 0x3e, 0x29, 0xef, 0xaf, 0xe8, 0x4d,
-	0xe4, 0x4b, 0xc4, 0x6f, 0xfc, 0x02, 0x36, 0x07, 0xb3, 0x45, 0xc8, 0xc6, 0xbb, 0xab, 0xca, 0xee,
-	0x47, 0x70, 0x27, 0xde, 0x5d, 0xd2, 0xd9, 0xfd, 0xaf, 0x01, 0xcd, 0x6c, 0x64, 0x9a, 0xc7, 0x2b,
-	0x57, 0x64, 0x94, 0x37, 0x1b, 0xee, 0x42, 0x83, 0xef, 0xff, 0x5e, 0x6c, 0xad, 0xcf, 0x2d, 0x0b,
-	0x96, 0x72, 0x9d, 0x88, 0x3e, 0xac, 0x38, 0x81, 0xac, 0xa1, 0x97, 0xa5, 0x8f, 0x08, 0x41, 0xa5,
-	0x7c, 0x5e, 0x29, 0x2b, 0x9f, 0x57, 0xb5, 0x05, 0x0d, 0xfe, 0x9b, 0x01, 0x9f, 0xc8, 0x84, 0x45,
-	0x68, 0x40, 0x97, 0x65, 0xe8, 0x5a, 0x2f, 0x09, 0xe3, 0x6a, 0x8a, 0x71, 0x36, 0xf0, 0xd7, 0x16,
-	0x0b, 0xfc, 0xbf, 0x86, 0xfb, 0x85, 0x42, 0x94, 0x66, 0x01, 0xfb, 0x80, 0x86, 0xf4, 0xc2, 0x09,
-	0x18, 0xf5, 0x5f, 0x1d, 0x9c, 0x28, 0x81, 0xf3, 0xd5, 0xc1, 0xc9, 0x99, 0xd2, 0x08, 0x8c, 0x61,
-	0xd9, 0xc4, 0x56, 0x76, 0x94, 0xf9, 0xe2, 0xc7, 0x5f, 0x42, 0x33, 0x9b, 0xef, 0xa1, 0x4d, 0x80,
-	0x01, 0xa5, 0xfe, 0x99, 0xc7, 0xff, 0x36, 0x2b, 0xa8, 0x01, 0x4b, 0x42, 0xfa, 0xa6, 0xc1, 0x3f,
-	0x9d, 0x58, 0xae, 0x75, 0x41, 0x27, 0xd4, 0x65, 0xcd, 0xea, 0xe3, 0x47, 0xb0, 0xae, 0x66, 0xda,
-	0x08, 0x60, 0xf9, 0xd4, 0xf3, 0x27, 0xd6, 0xb8, 0x59, 0x41, 0x1b, 0xd0, 0x18, 0x52, 0xe6, 0x5b,
-	0x36, 0xa3, 0xa3, 0xa6, 0xf1, 0xf8, 0x10, 0xba, 0xda, 0x74, 0x97, 0x93, 0x3f, 0xf4, 0xad, 0x0f,
-	0xac, 0x59, 0x41, 0xab, 0x50, 0x7f, 0xc7, 0x09, 0x1b, 0x68, 0x1d, 0x56, 0xf9, 0x32, 0xe7, 0x9a,
-	0x8e, 0x9a, 0x55, 0x8e, 0x1f, 0x52, 0x6b, 0xd4, 0xac, 0x3d, 0xfb, 0x57, 0x13, 0x56, 0x4e, 0xe5,
-	0xfc, 0x05, 0x7d, 0x0e, 0x90, 0x38, 0x31, 0x84, 0x48, 0xce, 0xa3, 0x99, 0x6d, 0x92, 0x77, 0xa3,
-	0xb8, 0x82, 0xbe, 0x82, 0x35, 0x25, 0xfe, 0xa0, 0x36, 0xc9, 0x47, 0x75, 0xb3, 0x4f, 0x0a, 0x42,
-	0x14, 0xae, 0xec, 0x1b, 0x68, 0xa0, 0x16, 0x5d, 0x6a, 0x10, 0xd4, 0x13, 0xbb, 0x47, 0xe6, 0x65,
-	0x08, 0x82, 0xe2, 0x0b, 0x58, 0x53, 0xd2, 0x2b, 0xd4, 0x26, 0xf9, 0xf4, 0xcf, 0xec, 0x10, 0x4d,
-	0x06, 0x86, 0x2b, 0x7b, 0x06, 0x7a, 0x0e, 0xab, 0x51, 0x26, 0x83, 0x9a, 0x24, 0x93, 0x01, 0x99,
-	0x2d, 0x92, 0x4d, 0x73, 0x04, 0xcb, 0xef, 0x60, 0xab, 0xe0, 0x6d, 0xa2, 0xfb, 0x64, 0xbe, 0xe9,
-	0x98, 0xbb, 0xa4, 0xe4, 0x59, 0xe3, 0x0a, 0x7a, 0x0b, 0x28, 0x5f, 0x31, 0x20, 0x93, 0x14, 0x96,
-	0x2e, 0xe6, 0x5d, 0x52, 0x5c, 0x62, 0xe0, 0x0a, 0xfa, 0x06, 0x5a, 0xb9, 0xb6, 0x23, 0xda, 0x26,
-	0x45, 0x6d, 0x4a, 0xd3, 0x24, 0x85, 0x5d, 0x4a, 0x29, 0x5e, 0xbe, 0x29, 0x85, 0x4c, 0x52, 0xd8,
-	0x16, 0x33, 0xef, 0x92, 0xe2, 0x2e, 0x16, 0xae, 0xa0, 0x3f, 0x42, 0x57, 0x3b, 0x6f, 0x40, 0xf7,
-	0xc8, 0xbc, 0x09, 0x86, 0xf9, 0x09, 0x99, 0x3b, 0xa6, 0xc0, 0x15, 0xf4, 0x0a, 0xee, 0x64, 0x26,
-	0x37, 0x68, 0x8b, 0xe8, 0xe7, 0x42, 0x66, 0x9f, 0x14, 0x0c, 0x79, 0x54, 0x3a, 0xf1, 0x00, 0x26,
-	0xa6, 0x93, 0x1d, 0xef, 0xc4, 0x74, 0x72, 0xb3, 0x1a, 0x5c, 0xe1, 0x96, 0x97, 0x94, 0x33, 0x08,
-	0x91, 0x5c, 0x05, 0x65, 0xb6, 0x49, 0xbe, 0xde, 0x11, 0x96, 0xb7, 0x91, 0x9a, 0x80, 0xa1, 0x2e,
-	0xd1, 0xcd, 0xd0, 0xcc, 0x1e, 0xd1, 0x0e, 0xca, 0x70, 0x05, 0xfd, 0x0a, 0xd6, 0x94, 0x31, 0x1d,
-	0x6a, 0x93, 0xfc, 0xf8, 0xcf, 0xec, 0x10, 0xcd, 0x24, 0x4f, 0x1e, 0x3f, 0xd3, 0x1a, 0x40, 0x5b,
-	0x44, 0xdf, 0xac, 0x30, 0xfb, 0xa4, 0xa0, 0x8b, 0x20, 0x65, 0x50, 0x3a, 0x7d, 0xd2, 0xe4, 0x33,
-	0xdd, 0x40, 0xb3, 0x43, 0x34, 0xcd, 0x40, 0xa9, 0xba, 0xa4, 0x4b, 0x25, 0x9d, 0x56, 0xba, 0x2d,
-	0x26, 0x9d, 0x56, 0xa6, 0x8d, 0x25, 0x55, 0x97, 0xea, 0x60, 0xa2, 0x2e, 0xd1, 0x75, 0x40, 0xcd,
-	0x1e, 0xd1, 0x36, 0x3a, 0x71, 0x05, 0x7d, 0x09, 0xeb, 0xea, 0xe8, 0x19, 0x75, 0x88, 0x66, 0x70,
-	0x6d, 0x76, 0x89, 0x6e, 0x3e, 0x8d, 0x2b, 0xe8, 0x00, 0x36, 0xd3, 0x83, 0x65, 0xd4, 0x23, 0xda,
-	0xd1, 0xb4, 0xb9, 0x45, 0xf4, 0x13, 0x68, 0x79, 0x8a, 0xd4, 0x58, 0x09, 0x75, 0x89, 0x6e, 0x78,
-	0x65, 0xf6, 0x88, 0x76, 0xfa, 0x24, 0x95, 0xaf, 0x8c, 0x6f, 0x50, 0x9b, 0xe4, 0x07, 0x4b, 0x66,
-	0x87, 0x68, 0x26, 0x3c, 0x52, 0xf9, 0xc9, 0x04, 0x06, 0x21, 0x92, 0x1b, 0xde, 0x98, 0x6d, 0x92,
-	0x1f, 0xd1, 0xe0, 0x0a, 0x7a, 0x0f, 0x1d, 0x5d, 0x41, 0x8a, 0x76, 0xc8, 0x9c, 0x12, 0xd7, 0xbc,
-	0x47, 0xe6, 0x55, 0xb1, 0x7b, 0x06, 0x77, 0x68, 0xb9, 0x29, 0x3a, 0xda, 0x26, 0x45, 0xd3, 0x78,
-	0xd3, 0x24, 0x85, 0x43, 0xf7, 0x7d, 0x03, 0x7d, 0x06, 0x8d, 0x78, 0x66, 0x88, 0x5a, 0x24, 0x3b,
-	0xa3, 0x34, 0x11, 0xc9, 0x8d, 0x14, 0x71, 0x05, 0x3d, 0x84, 0xfa, 0x91, 0x7d, 0xe9, 0xa1, 0x75,
-	0xa2, 0xcc, 0xe5, 0xcd, 0x0d, 0xa2, 0x0e, 0xdf, 0x23, 0xbb, 0x8b, 0x33, 0x0b, 0x61, 0x77, 0xd9,
-	0xcc, 0x44, 0xd8, 0x5d, 0x2e, 0xf9, 0xc0, 0x15, 0x34, 0x81, 0x9d, 0x79, 0x25, 0x23, 0x7a, 0x40,
-	0x16, 0x28, 0x66, 0xcd, 0x87, 0x64, 0x91, 0xba, 0x13, 0x57, 0xbe, 0x6e, 0xfc, 0x69, 0x25, 0xfc,
-	0x17, 0x8d, 0xf3, 0x65, 0xf1, 0x3f, 0x1a, 0xcf, 0xff, 0x1f, 0x00, 0x00, 0xff, 0xff, 0xb8, 0x3c,
-	0x09, 0x34, 0xb4, 0x21, 0x00, 0x00,
+func init() { proto.RegisterFile("ngobrel.proto", fileDescriptor_ngobrel_124fb1944a5a5764) }
+
+var fileDescriptor_ngobrel_124fb1944a5a5764 = []byte{
+	// 3774 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5b, 0xcd, 0x73, 0x1c, 0xb7,
+	0x72, 0xdf, 0x0f, 0x7e, 0x36, 0x3f, 0xb4, 0xc4, 0x7e, 0x70, 0x17, 0xa4, 0x2c, 0x1a, 0x96, 0x13,
+	0x59, 0x2a, 0x43, 0xb2, 0x64, 0x5b, 0x76, 0x62, 0xcb, 0xa2, 0x48, 0x89, 0x61, 0x2c, 0x51, 0x9b,
+	0x15, 0x65, 0xa7, 0x72, 0xb0, 0x6b, 0x38, 0x0b, 0x92, 0x53, 0xda, 0x9d, 0x59, 0xcf, 0xce, 0x32,
+	0xe2, 0x25, 0x87, 0x7c, 0x5c, 0x52, 0x49, 0x55, 0xaa, 0x72, 0xcb, 0x25, 0xff, 0xc0, 0xbb, 0xbf,
+	0xbf, 0xe2, 0x5d, 0xde, 0xe9, 0xdd, 0xdf, 0x5f, 0xf1, 0x6e, 0xaf, 0x30, 0x98, 0x0f, 0x00, 0x83,
+	0xd9, 0x59, 0x3d, 0xca, 0x17, 0x16, 0xd1, 0x18, 0xa0, 0x1b, 0x8d, 0x46, 0x77, 0xa3, 0xf1, 0x5b,
+	0x58, 0x73, 0xcf, 0xbc, 0x13, 0x9f, 0x0d, 0xe8, 0xc8, 0xf7, 0x02, 0x8f, 0x7c, 0x0a, 0xf5, 0x27,
+	0x03, 0xcf, 0x7e, 0xb3, 0xe7, 0xb9, 0x81, 0x65, 0x07, 0x3d, 0xf6, 0xcb, 0x84, 0x8d, 0x03, 0xd4,
+	0x82, 0x85, 0xc9, 0x98, 0xf9, 0x87, 0xfb, 0xed, 0xf2, 0x4e, 0xf9, 0xd6, 0x72, 0x2f, 0x6a, 0x11,
+	0x0a, 0x0d, 0xf5, 0xf3, 0xf1, 0xc8, 0x73, 0xc7, 0x2c, 0xf7, 0xfb, 0xbb, 0xd0, 0x7c, 0xed, 0x9e,
+	0xbc, 0x03, 0x83, 0x7b, 0xd0, 0xd2, 0x07, 0x14, 0xb0, 0xb8, 0x0f, 0xed, 0x03, 0x16, 0x74, 0x7d,
+	0xef, 0xd4, 0x19, 0xb0, 0xae, 0x63, 0x07, 0x13, 0x9f, 0x15, 0x71, 0x79, 0x08, 0x1d, 0xc3, 0x98,
+	0x88, 0x11, 0x86, 0x25, 0xdb, 0x73, 0x03, 0xe6, 0x06, 0xe3, 0x70, 0xd8, 0x6a, 0x2f, 0x69, 0x93,
+	0xbf, 0x86, 0x95, 0xa7, 0xf6, 0xb9, 0x17, 0xcf, 0xdf, 0x86, 0xc5, 0x21, 0x1b, 0x8f, 0xad, 0x33,
+	0x16, 0x31, 0x88, 0x9b, 0xe4, 0x26, 0xac, 0x8a, 0x0f, 0xa3, 0x49, 0x1b, 0x30, 0xef, 0xb3, 0xd1,
+	0xe0, 0x32, 0xfa, 0x4e, 0x34, 0xc8, 0xdf, 0x01, 0xea, 0x31, 0xd7, 0x1a, 0xb2, 0x03, 0xdf, 0x9b,
+	0x8c, 0xa4, 0x59, 0xcf, 0x78, 0x3b, 0x11, 0x3b, 0x6e, 0xf2, 0x1e, 0x97, 0xfd, 0xf3, 0x91, 0x35,
+	0x64, 0xed, 0x8a, 0xe8, 0x89, 0x9a, 0xe4, 0x2e, 0xd4, 0x95, 0x99, 0x22, 0xb6, 0x6d, 0x58, 0x1c,
+	0x4f, 0x6c, 0x9b, 0x8d, 0xc5, 0x52, 0x96, 0x7a, 0x71, 0x93, 0x3c, 0x87, 0xf6, 0xeb, 0x51, 0xdf,
+	0x0a, 0xc4, 0x80, 0xdd, 0x0b, 0x2b, 0xb0, 0xfc, 0x62, 0x01, 0x5a, 0xb0, 0x60, 0x85, 0x9f, 0x46,
+	0xfc, 0xa3, 0x16, 0xf9, 0x02, 0x3a, 0x86, 0xd9, 0x0a, 0x85, 0xb8, 0x0b, 0xf5, 0x7d, 0x67, 0x7c,
+	0x62, 0xb9, 0xfd, 0xd9, 0x14, 0x40, 0xee, 0x41, 0x43, 0x1d, 0x50, 0xc8, 0xe2, 0xdf, 0xcb, 0x50,
+	0x7b, 0xda, 0x77, 0x82, 0x19, 0x35, 0xbc, 0x03, 0x2b, 0x7d, 0x36, 0xb6, 0x7d, 0x67, 0x14, 0x38,
+	0x9e, 0x1b, 0xad, 0x52, 0x26, 0xf1, 0x9d, 0x0c, 0xbc, 0x91, 0x63, 0xb7, 0xab, 0x62, 0x27, 0xc3,
+	0x06, 0xfa, 0x00, 0xc0, 0x9e, 0x8c, 0x03, 0x6f, 0xb8, 0x6f, 0x05, 0x56, 0x7b, 0x2e, 0xec, 0x92,
+	0x28, 0xe4, 0x00, 0x36, 0x24, 0x29, 0x8a, 0xa4, 0x96, 0x0d, 0xab, 0xa2, 0x1a, 0xd6, 0x5d, 0xa8,
+	0x1f, 0x30, 0x31, 0xcf, 0xa1, 0x7b, 0xea, 0x15, 0xab, 0xec, 0xff, 0x2a, 0xd0, 0x50, 0x47, 0xa4,
+	0xdc, 0x73, 0x94, 0x80, 0x60, 0xce, 0x4d, 0x6d, 0x2c, 0xfc, 0x5f, 0x57, 0x4c, 0x75, 0x8a, 0x62,
+	0xe6, 0xf2, 0x15, 0x33, 0xaf, 0x2b, 0x06, 0x6d, 0xc3, 0xb2, 0xed, 0x33, 0x2b, 0xf0, 0xf8, 0x29,
+	0x5d, 0x08, 0xbb, 0x53, 0x82, 0x64, 0x6f, 0x8b, 0xb2, 0xbd, 0xa1, 0x5b, 0x70, 0x4d, 0xfc, 0x77,
+	0x7c, 0x3e, 0x19, 0x9e, 0xb8, 0x96, 0x33, 0x68, 0x2f, 0x85, 0x47, 0x55, 0x27, 0x27, 0xf3, 0xb3,
+	0xfe, 0x6e, 0xd0, 0x5e, 0xde, 0x29, 0xdf, 0xaa, 0xf6, 0x52, 0x02, 0xb7, 0xa7, 0xa7, 0x6f, 0x9d,
+	0xe0, 0x99, 0xef, 0x0d, 0x67, 0xb4, 0xc0, 0xcf, 0xa0, 0xa9, 0x8d, 0x28, 0x34, 0xc1, 0xbf, 0x87,
+	0x56, 0x8f, 0x0d, 0xbd, 0x0b, 0xb6, 0xdb, 0x1f, 0x3a, 0x6e, 0xcf, 0x1b, 0xb0, 0x99, 0x0e, 0x5a,
+	0xe4, 0xb9, 0x2a, 0x8a, 0xe7, 0x7a, 0x00, 0x9b, 0x99, 0xb9, 0x66, 0x17, 0x60, 0xf6, 0x75, 0x16,
+	0x0b, 0xf0, 0x2e, 0x1a, 0x38, 0x80, 0xfa, 0x13, 0xcb, 0x7d, 0x0f, 0xdc, 0xef, 0x41, 0x43, 0x9d,
+	0xa8, 0x90, 0xf5, 0x61, 0x18, 0x81, 0xde, 0x0b, 0xf3, 0xfb, 0xd0, 0xd2, 0xa7, 0x2a, 0x64, 0x7f,
+	0x0f, 0x1a, 0xcf, 0x9d, 0xb1, 0x38, 0x7d, 0x4f, 0x2c, 0x77, 0x5c, 0x6c, 0x60, 0x8f, 0xa0, 0xa9,
+	0x8d, 0x88, 0x98, 0x7c, 0x0c, 0x73, 0x03, 0x67, 0x1c, 0xb4, 0xcb, 0x3b, 0xd5, 0x5b, 0x2b, 0xf7,
+	0x37, 0x68, 0x2c, 0x42, 0xe0, 0x3b, 0x36, 0x3f, 0x80, 0xbd, 0xb0, 0x9b, 0x9c, 0x42, 0xeb, 0xc5,
+	0x24, 0x72, 0xc4, 0x2f, 0xd8, 0xf0, 0x84, 0xf9, 0x7f, 0xf1, 0x8a, 0x79, 0x28, 0xec, 0x4f, 0x7c,
+	0x2b, 0x39, 0xf1, 0xd5, 0x5e, 0xd2, 0x26, 0xff, 0x00, 0x9b, 0x19, 0x3e, 0x85, 0x7e, 0x6d, 0x1b,
+	0x96, 0xd9, 0xdb, 0x91, 0xe3, 0x87, 0xa7, 0xb1, 0x22, 0x4e, 0x63, 0x42, 0x08, 0x63, 0x92, 0x3b,
+	0x7c, 0x4f, 0xc2, 0x87, 0x31, 0xc9, 0x1d, 0xbe, 0xab, 0x88, 0xe4, 0x33, 0x49, 0xff, 0x7c, 0x81,
+	0x33, 0x6c, 0xd9, 0x77, 0xd0, 0xd2, 0x87, 0xbc, 0xdb, 0x9e, 0xfd, 0x6b, 0x19, 0x6a, 0x7a, 0x57,
+	0x5e, 0xf2, 0xc2, 0x37, 0xe5, 0xe4, 0xf2, 0xb5, 0xbc, 0xe2, 0xa4, 0xad, 0x7a, 0xbb, 0xaa, 0xe6,
+	0xed, 0x54, 0xed, 0xcf, 0xe9, 0xda, 0xff, 0x0a, 0xb6, 0x93, 0x55, 0x74, 0x2d, 0x3f, 0x70, 0x6c,
+	0x67, 0x64, 0xb9, 0xc1, 0x0c, 0xeb, 0xff, 0x01, 0xae, 0xe7, 0x8c, 0x8c, 0xd4, 0xf0, 0x05, 0xac,
+	0x8e, 0x24, 0xba, 0xaa, 0x0e, 0x69, 0x44, 0x4f, 0xf9, 0x8c, 0x9c, 0x40, 0xed, 0x07, 0xe6, 0x3b,
+	0xa7, 0x97, 0x2f, 0x8f, 0xbb, 0xb1, 0x14, 0x3b, 0xb0, 0x32, 0x3a, 0xf7, 0x5c, 0x76, 0x34, 0xe1,
+	0xfb, 0x19, 0x49, 0x22, 0x93, 0x50, 0x0d, 0xaa, 0x2f, 0x8f, 0xbb, 0x91, 0x6a, 0xf8, 0xbf, 0xa1,
+	0x19, 0xb3, 0x0b, 0xc7, 0x66, 0x87, 0xfb, 0x51, 0xe0, 0x4a, 0xda, 0xe4, 0xbf, 0xcb, 0xb0, 0x21,
+	0x31, 0x49, 0xd3, 0xb5, 0xc0, 0x7b, 0xc3, 0xdc, 0x38, 0x5d, 0x0b, 0x1b, 0x88, 0xc0, 0xaa, 0xcf,
+	0x4e, 0x7d, 0x36, 0x3e, 0x3f, 0x0e, 0x3b, 0x05, 0x0b, 0x85, 0xa6, 0xea, 0xb8, 0xaa, 0xe9, 0x38,
+	0x94, 0xde, 0x71, 0xf9, 0x5a, 0x38, 0x21, 0xdc, 0x83, 0xa5, 0x9e, 0x4c, 0x22, 0x3e, 0xb4, 0xa3,
+	0xa5, 0xee, 0x87, 0x22, 0x3e, 0x77, 0xdc, 0x37, 0xf1, 0xda, 0xe5, 0x75, 0x94, 0xd5, 0x75, 0xf0,
+	0x38, 0x2b, 0xfe, 0x97, 0xb2, 0x43, 0x89, 0xc2, 0xc7, 0x8e, 0x06, 0x56, 0x70, 0xea, 0xf9, 0xc3,
+	0x58, 0x07, 0x71, 0x9b, 0xfc, 0x6f, 0x19, 0x3a, 0x06, 0xa6, 0x69, 0x3e, 0x3c, 0x70, 0xdc, 0x37,
+	0x7b, 0x5e, 0x3f, 0xce, 0x72, 0x93, 0x36, 0xe7, 0xca, 0xff, 0x7f, 0xc5, 0x6c, 0x9f, 0x05, 0x31,
+	0xd7, 0x94, 0xc2, 0xb5, 0xf1, 0x8b, 0xdf, 0xb5, 0x2e, 0x07, 0x9e, 0xd5, 0x8f, 0xd8, 0xa6, 0x84,
+	0x02, 0x7b, 0xfc, 0x12, 0xda, 0xbb, 0xa3, 0x91, 0xef, 0x5d, 0x30, 0xa3, 0x26, 0xf2, 0x64, 0xe2,
+	0xc9, 0xbd, 0x61, 0x5c, 0xba, 0x98, 0x3c, 0x15, 0x92, 0x1f, 0xa1, 0xb3, 0xe7, 0x0d, 0x47, 0x03,
+	0x16, 0xbc, 0x1b, 0xc7, 0x22, 0x2d, 0x90, 0xff, 0x2a, 0x03, 0x36, 0xcd, 0x3c, 0xfd, 0x66, 0x93,
+	0x1a, 0x61, 0x65, 0x9a, 0x11, 0x56, 0x8b, 0x8c, 0x30, 0xa3, 0xd8, 0x06, 0x20, 0x7e, 0x5c, 0x85,
+	0x24, 0xf1, 0xf1, 0x26, 0xf7, 0xa1, 0xae, 0x50, 0x23, 0xe1, 0xb6, 0x14, 0x0f, 0xb6, 0x48, 0x45,
+	0x7f, 0xe4, 0xb7, 0x7e, 0x5f, 0x86, 0x05, 0x41, 0x98, 0x6a, 0x9b, 0xa6, 0x7c, 0x72, 0x8a, 0x3d,
+	0x72, 0xf1, 0x9d, 0x71, 0xd7, 0x77, 0x86, 0x96, 0x7f, 0x19, 0x9d, 0x91, 0x94, 0x20, 0x7a, 0xf7,
+	0x26, 0xbe, 0xcf, 0xdc, 0xa0, 0x3d, 0x1f, 0xf7, 0x46, 0x04, 0xd5, 0x03, 0x2e, 0xe8, 0x1e, 0x90,
+	0xc0, 0xea, 0xc0, 0x1a, 0x07, 0xbb, 0x76, 0xe0, 0x5c, 0xb0, 0xdd, 0x20, 0xcc, 0x2a, 0xab, 0x3d,
+	0x85, 0x46, 0x9e, 0xc6, 0x57, 0xa9, 0x68, 0xa9, 0x33, 0x1c, 0x3e, 0xc3, 0x02, 0x79, 0xe4, 0x57,
+	0xa7, 0x99, 0x21, 0xf2, 0xd4, 0x45, 0x6a, 0x35, 0x33, 0x63, 0xc1, 0x44, 0x1e, 0x52, 0xc8, 0xe4,
+	0x6b, 0xce, 0x24, 0x35, 0x95, 0x98, 0x89, 0x6e, 0x55, 0xe5, 0xac, 0x55, 0x11, 0x97, 0x33, 0x93,
+	0x87, 0xfe, 0xba, 0xce, 0x92, 0x5c, 0x83, 0xb5, 0xe7, 0xde, 0x99, 0x37, 0x89, 0x8b, 0x06, 0xe4,
+	0x36, 0xac, 0xc7, 0x84, 0xc2, 0x75, 0x76, 0x60, 0x53, 0x7c, 0xbb, 0x3b, 0x18, 0x68, 0x96, 0xfe,
+	0x39, 0xb4, 0xb3, 0x5d, 0x85, 0x13, 0xfe, 0x02, 0x37, 0xa2, 0x09, 0xba, 0x69, 0xb0, 0xd9, 0x3b,
+	0xb7, 0xdc, 0x33, 0x36, 0x7b, 0x6c, 0x6a, 0xc1, 0xc2, 0xc0, 0xb3, 0xad, 0x41, 0x6c, 0x2a, 0x51,
+	0x8b, 0xab, 0xf0, 0xc4, 0xb7, 0xdc, 0xd8, 0x47, 0x8a, 0x06, 0x79, 0x04, 0x3b, 0xf9, 0x2c, 0x53,
+	0x87, 0xe6, 0x05, 0xa3, 0x7d, 0x76, 0x32, 0x39, 0x8b, 0xad, 0x23, 0x6e, 0x93, 0x0b, 0x68, 0x8b,
+	0xaf, 0xa5, 0xe1, 0x57, 0x89, 0xa3, 0x7f, 0x05, 0xeb, 0xae, 0x17, 0x38, 0xa7, 0x97, 0x51, 0x6d,
+	0x66, 0x1c, 0x8a, 0xbb, 0xd4, 0xd3, 0xa8, 0xa1, 0x23, 0xcd, 0xf2, 0x2d, 0x4c, 0x0e, 0x35, 0x91,
+	0x2a, 0x19, 0x91, 0xc8, 0x97, 0xd0, 0xd8, 0x67, 0xdc, 0x8b, 0xee, 0xda, 0xb6, 0x37, 0x71, 0x93,
+	0x6a, 0x52, 0x78, 0xc9, 0x0c, 0xcf, 0x7f, 0xf7, 0xf0, 0x28, 0x5a, 0x8b, 0x44, 0xe1, 0x39, 0x9d,
+	0x36, 0xae, 0x70, 0xbb, 0x71, 0x1a, 0x87, 0xad, 0xc0, 0x7a, 0xfa, 0x76, 0xe4, 0xf9, 0x89, 0x1d,
+	0x3e, 0x84, 0x8e, 0xa1, 0x2f, 0xdd, 0x10, 0x16, 0x52, 0xd2, 0xe3, 0x1a, 0xb7, 0xc9, 0x57, 0x80,
+	0x0f, 0x98, 0x34, 0xe8, 0x55, 0x60, 0x05, 0x93, 0xb1, 0x74, 0xd0, 0x73, 0x47, 0xbe, 0x84, 0x2d,
+	0xe3, 0xc8, 0x34, 0x84, 0x8c, 0x43, 0x4a, 0x1c, 0x42, 0x44, 0x4b, 0xd4, 0x11, 0xfa, 0x8e, 0x95,
+	0xa4, 0x8a, 0x71, 0x93, 0xec, 0xc2, 0xda, 0x2b, 0xc6, 0x95, 0x13, 0x73, 0xaf, 0x41, 0x75, 0xe4,
+	0xc4, 0x67, 0x98, 0xff, 0xab, 0x69, 0xb5, 0x92, 0xd1, 0xea, 0x6d, 0x58, 0x8f, 0xa7, 0x28, 0x54,
+	0xe7, 0x7d, 0xa8, 0x09, 0x47, 0x25, 0x71, 0x2c, 0xda, 0xb5, 0x4f, 0x61, 0x43, 0x1a, 0x53, 0xc8,
+	0xe2, 0x66, 0x9c, 0x2d, 0x4e, 0x5b, 0x14, 0xf9, 0x8f, 0x24, 0xdf, 0x9b, 0x69, 0xd6, 0x5f, 0x2d,
+	0x08, 0x37, 0xc3, 0x3a, 0x4e, 0xf7, 0xf0, 0x48, 0xb1, 0x01, 0x72, 0x04, 0x0d, 0x95, 0x9c, 0xfa,
+	0x58, 0x67, 0xfc, 0x8a, 0x05, 0x91, 0x78, 0xa2, 0xc1, 0x4f, 0x8c, 0xcf, 0x86, 0x8e, 0xdb, 0x67,
+	0xfe, 0xfe, 0x44, 0xf8, 0x94, 0xa5, 0x9e, 0x4c, 0x22, 0x2c, 0xf1, 0x5a, 0x4f, 0x87, 0x96, 0x33,
+	0x08, 0x57, 0xee, 0xd8, 0xe1, 0x0d, 0x2e, 0xd6, 0x51, 0x03, 0xe6, 0x19, 0xef, 0x8b, 0xdd, 0x77,
+	0xd8, 0x78, 0x47, 0x4f, 0xf5, 0x18, 0x76, 0xf2, 0xd9, 0x44, 0x4b, 0xe0, 0x91, 0xd9, 0xeb, 0x33,
+	0xd9, 0x55, 0xa5, 0x04, 0xf2, 0x08, 0x90, 0xd8, 0x96, 0x70, 0x82, 0xe9, 0xb2, 0x21, 0x98, 0xe3,
+	0x03, 0xe3, 0x70, 0xcb, 0xff, 0xe7, 0x75, 0x31, 0x65, 0x7c, 0xa1, 0xb9, 0xfc, 0xa1, 0x0c, 0x8d,
+	0xbd, 0x30, 0x31, 0x88, 0xea, 0xc0, 0xb3, 0x04, 0xfa, 0x42, 0x17, 0xa5, 0xe5, 0xe1, 0xd5, 0xa9,
+	0x79, 0xf8, 0x9c, 0x96, 0xf7, 0xa4, 0x3a, 0x9f, 0x37, 0xeb, 0x7c, 0x41, 0xd2, 0x39, 0x5f, 0x9a,
+	0x7d, 0x6e, 0xb9, 0x2e, 0x1b, 0x44, 0xc5, 0xb1, 0xb8, 0x49, 0xbe, 0x87, 0xa6, 0xb6, 0xb2, 0x82,
+	0x4c, 0x53, 0x0e, 0x22, 0x15, 0x2d, 0x88, 0xfc, 0x67, 0x19, 0x10, 0x2f, 0x5d, 0x6a, 0x5a, 0x8a,
+	0x53, 0x9e, 0xb2, 0x9a, 0xd3, 0xf1, 0x09, 0xa5, 0x1b, 0x48, 0xd2, 0xd6, 0xea, 0x80, 0xd5, 0x4c,
+	0x1d, 0xf0, 0x26, 0xac, 0x89, 0xd2, 0xdd, 0x8b, 0xd0, 0x41, 0xf5, 0x23, 0xe5, 0xa8, 0x44, 0x72,
+	0x08, 0x75, 0x45, 0x96, 0x2b, 0x14, 0x52, 0xef, 0xc0, 0xc6, 0x01, 0xd3, 0x57, 0x95, 0xf7, 0x60,
+	0xf0, 0x9b, 0x32, 0xa0, 0x03, 0x96, 0xe1, 0xfb, 0xae, 0x4a, 0xd0, 0xcc, 0xa7, 0x6a, 0x34, 0x9f,
+	0x69, 0x75, 0xe4, 0xac, 0x9a, 0xe6, 0x4d, 0x6a, 0xc2, 0xd0, 0xe6, 0xb9, 0xfc, 0x9e, 0xe7, 0x5e,
+	0x30, 0x7f, 0x1c, 0x1e, 0xc3, 0xc4, 0xc3, 0x7c, 0x07, 0x1d, 0x43, 0x5f, 0xb4, 0x20, 0xa2, 0x64,
+	0xfb, 0xeb, 0x54, 0xfd, 0x2a, 0xec, 0x23, 0xbf, 0xad, 0xc2, 0x9a, 0x42, 0xe7, 0x5a, 0xb3, 0xcf,
+	0xad, 0x34, 0x6c, 0x45, 0xad, 0xf0, 0x25, 0xe5, 0xdc, 0x0a, 0x64, 0x55, 0xc4, 0x6d, 0xbe, 0x31,
+	0xec, 0xad, 0xcd, 0xfc, 0x51, 0x10, 0xa9, 0x21, 0x6e, 0x72, 0x3f, 0x11, 0x38, 0x43, 0x36, 0x0e,
+	0xac, 0xe1, 0x28, 0xf6, 0x9b, 0x09, 0x81, 0x7b, 0xde, 0x30, 0xdb, 0x88, 0xbc, 0x4b, 0xb8, 0xfe,
+	0x6a, 0x4f, 0xa1, 0xc5, 0x7c, 0x8f, 0x2f, 0x47, 0x2c, 0x3c, 0x32, 0xf3, 0xbd, 0xa4, 0xcd, 0xc7,
+	0x3b, 0x63, 0xf1, 0x4a, 0xc1, 0x2b, 0xa8, 0xe1, 0xd1, 0x59, 0xea, 0x29, 0x34, 0xa9, 0xea, 0xbc,
+	0x54, 0x54, 0x75, 0x5e, 0x36, 0x57, 0x9d, 0xb5, 0x8d, 0x86, 0xec, 0x46, 0xcb, 0x66, 0xb2, 0x32,
+	0xf5, 0xac, 0xac, 0x66, 0x8c, 0x40, 0xab, 0xc5, 0xaf, 0x4d, 0xa9, 0xc5, 0xaf, 0x4b, 0xb5, 0x78,
+	0xf2, 0x26, 0x7e, 0xa5, 0x91, 0xb7, 0x4f, 0x32, 0x7d, 0xe3, 0x26, 0x4a, 0x1b, 0x55, 0x99, 0xb2,
+	0x51, 0x55, 0x6d, 0xa3, 0x48, 0x17, 0xb0, 0x89, 0xd9, 0x15, 0x4e, 0x2c, 0x8d, 0xb3, 0xbf, 0x19,
+	0xdf, 0x12, 0xbf, 0x87, 0xa6, 0xf6, 0xfd, 0x15, 0x98, 0x37, 0x42, 0x07, 0x10, 0xcd, 0x24, 0x5d,
+	0x25, 0xea, 0x0a, 0x35, 0x62, 0x70, 0x5d, 0x39, 0x46, 0xcb, 0x34, 0xf9, 0x40, 0x9c, 0xa0, 0x3f,
+	0x96, 0x61, 0x29, 0x26, 0x71, 0xe9, 0x47, 0x4c, 0x96, 0x5e, 0xb4, 0x8c, 0x97, 0x66, 0xdd, 0xf8,
+	0xab, 0x06, 0xe3, 0xff, 0x04, 0x6a, 0xc2, 0x1a, 0x7f, 0x0e, 0x12, 0x2b, 0x9d, 0x9b, 0xc9, 0x4a,
+	0xe7, 0xa7, 0x5b, 0xe9, 0xc2, 0x54, 0x2b, 0x5d, 0xcc, 0x3c, 0x79, 0x9d, 0xc0, 0x46, 0x77, 0x12,
+	0x68, 0x7b, 0x55, 0x7c, 0xed, 0xb8, 0x03, 0x2b, 0xb6, 0x18, 0x13, 0xce, 0xcb, 0x97, 0xaf, 0xa8,
+	0x50, 0xee, 0xe5, 0x0f, 0xa8, 0x32, 0x8f, 0x2b, 0xec, 0xef, 0x8f, 0x70, 0xe3, 0x80, 0x05, 0x2f,
+	0x44, 0xab, 0xc7, 0x6c, 0x16, 0x1e, 0x24, 0x9e, 0x85, 0x15, 0x05, 0x07, 0x7e, 0x0e, 0xa2, 0x59,
+	0x92, 0x34, 0x3b, 0x25, 0x90, 0x1e, 0xec, 0xe4, 0x4f, 0x1c, 0x09, 0x4c, 0x95, 0xf4, 0x7d, 0xfd,
+	0x7e, 0x8b, 0x9a, 0xbf, 0x8f, 0xbe, 0x22, 0x47, 0xd0, 0x4a, 0xe7, 0x7c, 0x0f, 0x32, 0x3e, 0x82,
+	0xcd, 0xcc, 0x7c, 0x91, 0x68, 0x1f, 0xc1, 0x3c, 0x67, 0xca, 0x22, 0xc9, 0xd6, 0xa8, 0xf2, 0x95,
+	0xe8, 0x23, 0xff, 0x02, 0xad, 0xee, 0xc4, 0x28, 0x8f, 0xc2, 0xb7, 0x2c, 0x7c, 0x44, 0x42, 0x90,
+	0xd6, 0x5d, 0x99, 0x65, 0xdd, 0x92, 0x8f, 0xaa, 0xca, 0x3e, 0x8a, 0x3f, 0x4a, 0x75, 0x27, 0x66,
+	0xf9, 0xf3, 0x13, 0x40, 0x0f, 0x6e, 0xa4, 0x83, 0xcc, 0x3b, 0x9e, 0x91, 0x7e, 0xf9, 0x0a, 0xd2,
+	0x93, 0x6f, 0x60, 0x27, 0x9f, 0x61, 0xa1, 0xb8, 0x3e, 0x74, 0x44, 0x52, 0x97, 0xe3, 0xbc, 0x8d,
+	0xdb, 0x9e, 0x2a, 0xac, 0xa2, 0x38, 0xf5, 0x8f, 0x61, 0x2e, 0xe0, 0xd1, 0xb1, 0x1a, 0x0a, 0xbe,
+	0xa1, 0xc4, 0x79, 0x1e, 0x26, 0x7b, 0x61, 0x37, 0x39, 0x02, 0x6c, 0xe2, 0x99, 0x66, 0x93, 0x79,
+	0x11, 0x23, 0xe7, 0x90, 0x3d, 0x80, 0x4e, 0xe2, 0x91, 0x67, 0x0d, 0x40, 0x3c, 0x90, 0x98, 0x06,
+	0x5d, 0xe1, 0xac, 0xf7, 0x61, 0x63, 0xb7, 0xdf, 0x3f, 0xf6, 0x66, 0x7c, 0x0f, 0xd4, 0x5f, 0x2f,
+	0x2a, 0xb3, 0xbd, 0x5e, 0x50, 0x40, 0x32, 0x97, 0xa2, 0x57, 0x77, 0xf2, 0x3f, 0x65, 0x40, 0xaf,
+	0x47, 0xbc, 0x30, 0x1e, 0xa6, 0x71, 0xd2, 0x75, 0x84, 0xe7, 0x9c, 0x47, 0x69, 0x9e, 0x99, 0xb4,
+	0xb9, 0x37, 0x8d, 0xa0, 0x29, 0x61, 0xae, 0x13, 0x5d, 0x47, 0x24, 0x12, 0xff, 0xc2, 0x19, 0x3f,
+	0x75, 0x6d, 0xff, 0x72, 0x14, 0xb0, 0x7e, 0x54, 0xaf, 0x91, 0x49, 0x0a, 0xdc, 0x65, 0x4e, 0x83,
+	0xbb, 0xdc, 0x85, 0xba, 0x22, 0x51, 0xba, 0x86, 0xb8, 0xaa, 0x50, 0x56, 0xab, 0x0a, 0x5f, 0xc3,
+	0x96, 0x18, 0x60, 0xc6, 0xe3, 0x4c, 0x83, 0xd6, 0x7c, 0x05, 0xdb, 0xe6, 0xa1, 0x85, 0x4c, 0xef,
+	0xc0, 0xb5, 0xd0, 0x7b, 0x49, 0x4a, 0xcb, 0xff, 0x98, 0x42, 0x2d, 0xfd, 0x78, 0x06, 0xc4, 0x8f,
+	0x88, 0xfb, 0xd1, 0xa1, 0x4d, 0xe2, 0xbe, 0x0f, 0xdb, 0x29, 0xf5, 0x48, 0x0a, 0xbf, 0xaf, 0x02,
+	0x9f, 0x59, 0x43, 0x35, 0x35, 0x2a, 0xeb, 0x39, 0x2c, 0xaf, 0xd6, 0x30, 0x7e, 0x43, 0x8f, 0x4f,
+	0xa5, 0x68, 0xf1, 0x51, 0x3e, 0xb3, 0x9d, 0x91, 0xc3, 0xdc, 0x38, 0x2b, 0x4e, 0x09, 0xe4, 0x12,
+	0x3e, 0xda, 0xb5, 0xdf, 0xe4, 0xf2, 0x94, 0x7c, 0xd6, 0x7b, 0x67, 0xfd, 0x18, 0x6e, 0x4e, 0x67,
+	0x5d, 0xe8, 0xbd, 0xfe, 0xbf, 0x22, 0x87, 0x98, 0x24, 0x53, 0x3a, 0x0c, 0xd8, 0x50, 0x54, 0x31,
+	0x22, 0x56, 0xc9, 0x86, 0xc9, 0x24, 0xbe, 0x41, 0x42, 0xce, 0xf4, 0xc9, 0x33, 0x6e, 0xf3, 0xa2,
+	0xa4, 0xf8, 0x7f, 0x5f, 0x7d, 0xe2, 0xd3, 0xa8, 0xaa, 0x2f, 0x9f, 0xd3, 0x23, 0xd1, 0x6d, 0xa8,
+	0x45, 0x8d, 0xe3, 0x44, 0x79, 0xe2, 0x6a, 0x91, 0xa1, 0xf3, 0x6b, 0x40, 0x44, 0xdb, 0x8b, 0xad,
+	0x46, 0xe4, 0x46, 0x3a, 0x59, 0x9a, 0x35, 0x3d, 0x82, 0xe2, 0xc2, 0x91, 0xa1, 0x93, 0x9f, 0xc2,
+	0x54, 0x26, 0x89, 0x0e, 0x69, 0xd1, 0x64, 0x4a, 0xfc, 0x34, 0x49, 0x5d, 0x31, 0x4b, 0xcd, 0x77,
+	0x60, 0x43, 0x66, 0x90, 0xe4, 0x63, 0x05, 0xba, 0xcf, 0x64, 0x0e, 0x85, 0x12, 0x54, 0x67, 0xd7,
+	0xdb, 0xdc, 0xec, 0x7a, 0x9b, 0x37, 0xeb, 0x8d, 0xef, 0x7f, 0x4c, 0x8b, 0xae, 0x29, 0x62, 0x33,
+	0x34, 0x2a, 0x5f, 0x69, 0x2c, 0x11, 0xf7, 0x95, 0xe2, 0xe5, 0x47, 0x26, 0xf1, 0xfb, 0x45, 0x77,
+	0x72, 0x32, 0x70, 0xec, 0x03, 0x16, 0x7c, 0xcf, 0x2e, 0xc7, 0x45, 0xf7, 0x8b, 0x3b, 0xd0, 0xd4,
+	0xbe, 0x4f, 0xcb, 0x02, 0x6f, 0xd8, 0x65, 0xec, 0x4b, 0xc2, 0xff, 0xc9, 0x37, 0xb0, 0xde, 0x9d,
+	0xcc, 0x32, 0x6d, 0x32, 0xba, 0x22, 0x8d, 0xfe, 0x04, 0xae, 0x75, 0x27, 0x2a, 0x93, 0x3c, 0xa9,
+	0xfe, 0x14, 0x63, 0x09, 0xa4, 0xc8, 0x34, 0x8d, 0x97, 0x09, 0xe9, 0x55, 0x50, 0xa4, 0xd8, 0x82,
+	0x65, 0x3e, 0xfe, 0xe7, 0x70, 0xe8, 0xdc, 0xd4, 0x6b, 0x41, 0x16, 0xf0, 0xd5, 0x86, 0x45, 0x67,
+	0x2c, 0xee, 0xde, 0x0b, 0xc2, 0x47, 0x44, 0xcd, 0xab, 0x83, 0xbd, 0xc8, 0xbf, 0x95, 0xe1, 0x03,
+	0x91, 0xb0, 0x84, 0x1a, 0x30, 0x65, 0x19, 0xa6, 0x92, 0x4d, 0x0e, 0xaa, 0x31, 0x13, 0xf8, 0xab,
+	0xb3, 0x05, 0xfe, 0xbf, 0x85, 0x1b, 0xb9, 0x42, 0x14, 0x66, 0x01, 0xf7, 0x00, 0xf5, 0xd8, 0x99,
+	0x33, 0x0e, 0x98, 0xff, 0x6c, 0xef, 0x85, 0x14, 0x38, 0x9f, 0xed, 0xbd, 0x90, 0x9f, 0xe6, 0x92,
+	0xb6, 0x80, 0x7e, 0x4a, 0x23, 0x66, 0x81, 0x7e, 0xc6, 0x03, 0xba, 0x13, 0xed, 0x1d, 0x90, 0x97,
+	0x27, 0x7d, 0xef, 0xc2, 0xe9, 0x27, 0x97, 0xb3, 0xa4, 0x6d, 0x2e, 0x85, 0x73, 0x98, 0x8d, 0x61,
+	0xb6, 0x22, 0x21, 0x6e, 0x7f, 0x0b, 0x35, 0x3d, 0xe9, 0x44, 0xeb, 0x00, 0x5d, 0xc6, 0xfc, 0x63,
+	0x8f, 0xff, 0xad, 0x95, 0xd0, 0x32, 0xcc, 0x87, 0x2a, 0xac, 0x95, 0x79, 0xd7, 0x0b, 0xcb, 0xb5,
+	0xce, 0xd8, 0x90, 0xb9, 0x41, 0xad, 0x72, 0xfb, 0x13, 0x58, 0x95, 0xd3, 0x7d, 0x04, 0xb0, 0x70,
+	0xe4, 0xf9, 0x43, 0x6b, 0x50, 0x2b, 0xa1, 0x35, 0x58, 0xee, 0xb1, 0xc0, 0xb7, 0xec, 0x80, 0xf5,
+	0x6b, 0xe5, 0xdb, 0xfb, 0xd0, 0x34, 0xe6, 0xdc, 0x7c, 0xfa, 0x7d, 0xdf, 0x3a, 0x0d, 0x6a, 0x25,
+	0xb4, 0x04, 0x73, 0xaf, 0xf8, 0xc4, 0x65, 0xb4, 0x0a, 0x4b, 0xfc, 0x33, 0xe7, 0x82, 0xf5, 0x6b,
+	0x15, 0x4e, 0xef, 0x31, 0xab, 0x5f, 0xab, 0xde, 0xff, 0xdd, 0x87, 0xb0, 0x78, 0x24, 0xa0, 0xd3,
+	0xe8, 0x21, 0x40, 0xea, 0x49, 0x11, 0xa2, 0x19, 0xb7, 0x8a, 0xeb, 0x34, 0xeb, 0xcb, 0x49, 0x09,
+	0x3d, 0x86, 0x15, 0x29, 0x08, 0xa2, 0x3a, 0xcd, 0xa6, 0x16, 0xb8, 0x4d, 0x73, 0xe2, 0x24, 0x29,
+	0xdd, 0x2b, 0xa3, 0xae, 0x7c, 0xf3, 0x93, 0x23, 0xb1, 0x79, 0xb2, 0xeb, 0x74, 0x5a, 0x9a, 0x12,
+	0xce, 0xf8, 0x0d, 0xac, 0x48, 0x39, 0x1e, 0xaa, 0xd3, 0x6c, 0x0e, 0x8a, 0x1b, 0xd4, 0x90, 0x06,
+	0x92, 0xd2, 0xad, 0x32, 0x7a, 0x00, 0x4b, 0x71, 0x3a, 0x85, 0x6a, 0x54, 0x4b, 0xc3, 0xf0, 0x06,
+	0xd5, 0x73, 0xad, 0x90, 0xe5, 0x4f, 0xb0, 0x99, 0x73, 0x40, 0xd0, 0x0d, 0x3a, 0xfd, 0xfc, 0xe2,
+	0x1d, 0x5a, 0x70, 0xb6, 0x48, 0x09, 0xbd, 0x04, 0x94, 0xbd, 0xb6, 0x20, 0x4c, 0x73, 0xef, 0x4f,
+	0x78, 0x8b, 0xe6, 0xdf, 0x73, 0x48, 0x09, 0x3d, 0x87, 0x8d, 0x4c, 0xcd, 0x14, 0x75, 0x68, 0x5e,
+	0x8d, 0x15, 0x63, 0x9a, 0x5b, 0x62, 0x15, 0xe2, 0x65, 0x2b, 0x63, 0x08, 0xd3, 0xdc, 0xda, 0x1c,
+	0xde, 0xa2, 0xf9, 0xa5, 0x34, 0x52, 0x42, 0xff, 0x28, 0x41, 0xd6, 0x64, 0xfc, 0x15, 0xba, 0x4e,
+	0xa7, 0x21, 0xba, 0xf0, 0x07, 0x74, 0x2a, 0x6c, 0x8b, 0x94, 0xd0, 0x33, 0xb8, 0xa6, 0xc1, 0x4d,
+	0xd1, 0x26, 0x35, 0x83, 0x59, 0x71, 0x9b, 0xe6, 0x20, 0x53, 0xe5, 0x79, 0x12, 0xec, 0x64, 0x32,
+	0x8f, 0x0e, 0xcc, 0xc4, 0xed, 0x6c, 0x47, 0x32, 0xcf, 0x43, 0x80, 0xf4, 0x4e, 0x85, 0x10, 0xcd,
+	0x5c, 0xe3, 0x70, 0x9d, 0x66, 0x2f, 0x5d, 0xe1, 0xc9, 0x5b, 0x53, 0x60, 0xbb, 0xa8, 0x49, 0x4d,
+	0xc0, 0x5f, 0xdc, 0xa2, 0x46, 0x74, 0x2f, 0x29, 0xa1, 0xbf, 0x81, 0x15, 0x09, 0x61, 0x8f, 0xea,
+	0x34, 0x8b, 0xdc, 0xc7, 0x0d, 0x6a, 0x00, 0xe1, 0x0b, 0xfb, 0xc9, 0xc0, 0xe3, 0x51, 0x87, 0xe6,
+	0x01, 0xf0, 0x31, 0xa6, 0xb9, 0x68, 0x7a, 0x52, 0x42, 0xdf, 0xc2, 0xaa, 0x0c, 0x82, 0x47, 0x0d,
+	0x6a, 0x00, 0xd1, 0xe3, 0x26, 0x35, 0x21, 0xe5, 0x49, 0x09, 0x7d, 0x0e, 0xcb, 0x09, 0x14, 0x1d,
+	0x6d, 0x50, 0x1d, 0x1c, 0x8f, 0x11, 0xcd, 0x20, 0xd5, 0x05, 0x53, 0x19, 0x45, 0x8e, 0x1a, 0xd4,
+	0x00, 0x43, 0xc7, 0x4d, 0x6a, 0x82, 0x9a, 0x8b, 0xe1, 0x32, 0x70, 0x17, 0x35, 0xa8, 0x01, 0x10,
+	0x8c, 0x9b, 0xd4, 0x84, 0xee, 0x25, 0x25, 0xb4, 0x07, 0xeb, 0x2a, 0xf4, 0x16, 0xb5, 0xa8, 0x11,
+	0xd6, 0x8b, 0x37, 0xa9, 0x19, 0xa3, 0x2b, 0x6c, 0x40, 0x41, 0xd6, 0xa2, 0x26, 0x35, 0x61, 0x73,
+	0x71, 0x8b, 0x1a, 0x01, 0xb8, 0xc2, 0x8c, 0x35, 0xcc, 0x2b, 0xda, 0xa4, 0x66, 0xb4, 0x2d, 0x6e,
+	0xd3, 0x1c, 0x78, 0x6c, 0x64, 0x0f, 0x3a, 0x34, 0x95, 0xdb, 0x43, 0x0e, 0xf8, 0x15, 0x63, 0x53,
+	0x97, 0xac, 0x1c, 0x15, 0x7e, 0x8a, 0x5a, 0x54, 0x25, 0xa4, 0xca, 0x31, 0xe3, 0x54, 0x85, 0x53,
+	0xca, 0x56, 0x59, 0x10, 0xa6, 0xb9, 0xf5, 0x1a, 0xbc, 0x45, 0xf3, 0xcb, 0x32, 0x42, 0x57, 0x5a,
+	0x4d, 0x0e, 0x6d, 0x52, 0x73, 0x95, 0x10, 0xb7, 0x69, 0x4e, 0xf9, 0x4e, 0x9c, 0x3b, 0xa9, 0xc4,
+	0x2e, 0xc2, 0x9c, 0x56, 0x86, 0xc7, 0x0d, 0x6a, 0xa8, 0xc2, 0x0b, 0x77, 0x91, 0x96, 0x87, 0x45,
+	0xa0, 0x56, 0xeb, 0xd1, 0xb8, 0xae, 0xd0, 0x64, 0x53, 0x51, 0x9e, 0x0e, 0x50, 0x93, 0x9a, 0x9e,
+	0x1e, 0x70, 0x8b, 0x1a, 0x5f, 0x18, 0x22, 0x83, 0x97, 0x7e, 0xc6, 0xc4, 0x0d, 0x3e, 0xfb, 0x33,
+	0x28, 0xdc, 0xd4, 0xa8, 0x9a, 0xc1, 0xcb, 0x13, 0xb4, 0xa8, 0x4a, 0x50, 0x0c, 0xde, 0x3c, 0xc9,
+	0x63, 0x58, 0x53, 0xde, 0x81, 0x51, 0x93, 0x9a, 0x5e, 0xbc, 0x71, 0x8b, 0x1a, 0x9f, 0x8b, 0x85,
+	0xf2, 0xa5, 0xf7, 0x56, 0x54, 0xa7, 0xd9, 0x97, 0x60, 0xdc, 0xa0, 0x86, 0x27, 0x59, 0xa1, 0xfc,
+	0xf4, 0xc9, 0x14, 0x21, 0x9a, 0x79, 0x6d, 0xc5, 0x75, 0x9a, 0x7d, 0x53, 0x25, 0x25, 0xf4, 0x1a,
+	0x1a, 0xa6, 0x4a, 0x10, 0xda, 0xa6, 0x53, 0x6a, 0x4b, 0xf8, 0x3a, 0x9d, 0x56, 0x3e, 0xba, 0x55,
+	0xe6, 0x87, 0x2e, 0xf3, 0xa3, 0x2f, 0xd4, 0xa1, 0x79, 0x3f, 0x1e, 0xc3, 0x98, 0xe6, 0xfe, 0x46,
+	0xec, 0x5e, 0x99, 0x7b, 0xd1, 0x04, 0x36, 0x8c, 0x36, 0xa8, 0x8e, 0x53, 0xc6, 0x88, 0x66, 0x50,
+	0xc5, 0xe2, 0xe0, 0x67, 0x80, 0xb6, 0xa8, 0x43, 0xf3, 0x10, 0xbf, 0x18, 0xd3, 0x5c, 0x5c, 0xae,
+	0x98, 0x2d, 0x83, 0x74, 0x45, 0x1d, 0x9a, 0x87, 0x9a, 0xc5, 0x98, 0xe6, 0x02, 0x63, 0xa3, 0xac,
+	0x29, 0x03, 0x52, 0xe5, 0x59, 0x53, 0x1e, 0x26, 0x16, 0x6f, 0x19, 0xfb, 0x64, 0xe3, 0x91, 0x10,
+	0xa5, 0xa8, 0x4e, 0xb3, 0xa8, 0x53, 0xdc, 0xa0, 0x06, 0xd0, 0xa9, 0x38, 0x3e, 0x32, 0x7a, 0x12,
+	0x35, 0xa8, 0xdc, 0x4c, 0x8f, 0x8f, 0x09, 0x62, 0x19, 0x0f, 0x4f, 0x71, 0x91, 0xe1, 0xf0, 0x0c,
+	0xb2, 0x12, 0x37, 0x35, 0xaa, 0x3a, 0x5c, 0x82, 0xf2, 0x34, 0xa8, 0xdc, 0x94, 0x87, 0x67, 0xe1,
+	0x90, 0xa4, 0x84, 0xee, 0xc0, 0x82, 0x00, 0x18, 0xa2, 0x75, 0xaa, 0x20, 0x18, 0xf1, 0x35, 0xaa,
+	0x02, 0x18, 0x49, 0x09, 0x1d, 0x42, 0x4d, 0x47, 0x23, 0xa2, 0x36, 0xcd, 0xc1, 0x2e, 0xe2, 0x0e,
+	0xcd, 0x83, 0x2e, 0x92, 0x12, 0xb2, 0x12, 0xcc, 0x5a, 0x06, 0x2f, 0x88, 0x76, 0x68, 0x01, 0x7a,
+	0x11, 0x7f, 0x48, 0x8b, 0xc0, 0x86, 0xc2, 0xe4, 0x32, 0xd0, 0x3e, 0xd4, 0xa1, 0x79, 0x30, 0x43,
+	0x8c, 0x69, 0x2e, 0x12, 0x50, 0x76, 0xb3, 0x11, 0x2e, 0x2f, 0x71, 0xb3, 0x2a, 0xbe, 0x0f, 0xb7,
+	0x74, 0xb2, 0xe9, 0x40, 0x25, 0xd8, 0x38, 0xe9, 0x40, 0xe9, 0xd0, 0x3d, 0x8c, 0x4d, 0x5d, 0xc9,
+	0x6c, 0xbd, 0xf0, 0x39, 0x57, 0x47, 0xd9, 0xa1, 0x2d, 0x9a, 0x8f, 0xda, 0xc3, 0xdb, 0x74, 0x0a,
+	0x30, 0x4f, 0x18, 0x83, 0x40, 0xc9, 0xa1, 0x75, 0xaa, 0x20, 0xee, 0xf0, 0x35, 0xaa, 0xc2, 0xe7,
+	0x44, 0x6e, 0x96, 0x40, 0xde, 0xd0, 0x06, 0xd5, 0x21, 0x73, 0x18, 0xd1, 0x0c, 0x22, 0x4e, 0x8c,
+	0x4a, 0x20, 0x6d, 0x89, 0x2f, 0x52, 0x46, 0x65, 0x10, 0x6f, 0x49, 0x46, 0x97, 0x40, 0xcd, 0x44,
+	0x46, 0xa7, 0x03, 0xd2, 0x70, 0x53, 0xa3, 0x1a, 0x8c, 0x2d, 0x03, 0xf9, 0x4a, 0x8d, 0x2d, 0x0f,
+	0x74, 0x86, 0x3f, 0x9c, 0xf2, 0x85, 0xec, 0x40, 0x24, 0x4c, 0x17, 0xaa, 0xd3, 0x2c, 0x42, 0x0c,
+	0x37, 0xa8, 0x01, 0xf6, 0x45, 0x4a, 0xfc, 0x85, 0x8b, 0xff, 0x00, 0x17, 0xad, 0x52, 0xe9, 0x07,
+	0xbb, 0x78, 0x8d, 0xca, 0xbf, 0xca, 0x8d, 0xb3, 0xfa, 0xa4, 0x78, 0x12, 0x66, 0xf5, 0x7a, 0xf1,
+	0x05, 0x37, 0x54, 0xa2, 0x6a, 0x7b, 0x5a, 0xe5, 0x23, 0xb4, 0x3d, 0x73, 0x6d, 0x05, 0x63, 0x53,
+	0x57, 0x32, 0xdb, 0x10, 0xb6, 0xa7, 0xd5, 0xd8, 0xd1, 0x4d, 0x3a, 0x43, 0xf5, 0x1f, 0x7f, 0x4c,
+	0x67, 0x29, 0xd4, 0x93, 0xd2, 0x93, 0xe5, 0x7f, 0x5a, 0x8c, 0x7e, 0x09, 0x7e, 0xb2, 0x10, 0xfe,
+	0x14, 0xfc, 0xc1, 0x9f, 0x07, 0x00, 0x50, 0x3f, 0x27, 0x4a, 0x1b, 0x3e, 0x00, 0x00,
 }
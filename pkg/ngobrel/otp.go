@@ -0,0 +1,141 @@
+package ngobrel
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"log"
+	"math/big"
+	"os"
+	"strconv"
+)
+
+// Reads OTP_LENGTH and OTP_SECRET. Without OTP_SECRET a random key is used,
+// which invalidates all pending OTPs whenever the server restarts.
+func otpSettings() (int, []byte, error) {
+	length := DefaultOTPLength
+	if s := os.Getenv("OTP_LENGTH"); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil || n < MinOTPLength || n > MaxOTPLength {
+			return 0, nil, errors.New("OTP_LENGTH must be a number between " + strconv.Itoa(MinOTPLength) + " and " + strconv.Itoa(MaxOTPLength))
+		}
+		length = n
+	}
+
+	secret := []byte(os.Getenv("OTP_SECRET"))
+	if len(secret) == 0 {
+		log.Println("OTP_SECRET is not set, using a random key. OTPs will not survive a restart.")
+		secret = make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			return 0, nil, err
+		}
+	}
+
+	return length, secret, nil
+}
+
+// Generates a numeric OTP of the given length with crypto/rand
+func generateOTP(length int) (string, error) {
+	max := big.NewInt(10)
+	code := make([]byte, length)
+	for i := range code {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		code[i] = byte('0' + n.Int64())
+	}
+	return string(code), nil
+}
+
+// Hashes an OTP with the server key, bound to the phone number and device ID it was sent for
+func hashOTP(secret []byte, phoneNumber, deviceID, otpCode string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(phoneNumber))
+	mac.Write([]byte{0})
+	mac.Write([]byte(deviceID))
+	mac.Write([]byte{0})
+	mac.Write([]byte(otpCode))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func verifyOTPHash(secret []byte, phoneNumber, deviceID, otpCode, otpHash string) bool {
+	expected := hashOTP(secret, phoneNumber, deviceID, otpCode)
+	return hmac.Equal([]byte(expected), []byte(otpHash))
+}
+
+// Generates an OTP for a device and queues it to be sent once tx is committed, over the
+// channel, in the locale and of the brand asked by the client if they are available.
+// A new OTP replaces the previous ones of the phone number.
+func putOTP(srv *Server, tx *sql.Tx, phoneNumber, deviceID string, hint *otpHint) (string, error) {
+	otpCode, err := generateOTP(srv.otpLength)
+	if err != nil {
+		log.Println(err)
+		return "", err
+	}
+	otpHash := hashOTP(srv.otpSecret, phoneNumber, deviceID, otpCode)
+
+	err = queueOTP(srv, tx, phoneNumber, otpCode, hint)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = tx.Exec(`INSERT INTO otp (phone_number, otp_hash, created_at, expired_at) values ($1, $2, now(), now() + $3::float8 * interval '1 second')
+	ON CONFLICT (phone_number) DO UPDATE SET otp_hash=$2, created_at=now(), expired_at=now() + $3::float8 * interval '1 second'`,
+		phoneNumber, otpHash, OTPTTL.Seconds())
+	if err != nil {
+		log.Println(err)
+		return "", err
+	}
+
+	return otpCode, nil
+}
+
+// Checks the OTP of a phone number and device. The OTP can only be used once.
+func consumeOTP(srv *Server, phoneNumber, deviceID, otp string) (bool, error) {
+	rows, err := srv.db.Query(`SELECT otp_hash FROM otp WHERE phone_number=$1 AND expired_at > now()`, phoneNumber)
+	if err != nil {
+		log.Println(err)
+		return false, err
+	}
+	defer rows.Close()
+
+	var otpHash string
+	for rows.Next() {
+		var dbOTPHash string
+		if err := rows.Scan(&dbOTPHash); err != nil {
+			log.Println(err)
+			return false, err
+		}
+
+		if verifyOTPHash(srv.otpSecret, phoneNumber, deviceID, otp, dbOTPHash) {
+			otpHash = dbOTPHash
+		}
+	}
+	rows.Close()
+
+	if otpHash == "" {
+		return false, nil
+	}
+
+	result, err := srv.db.Exec(`DELETE FROM otp WHERE phone_number=$1 AND otp_hash=$2`, phoneNumber, otpHash)
+	if err != nil {
+		log.Println(err)
+		return false, err
+	}
+	count, err := result.RowsAffected()
+	if err != nil {
+		log.Println(err)
+		return false, err
+	}
+	if count != 1 {
+		return false, nil
+	}
+
+	// The fallback channels are no longer needed
+	cancelOTPMessages(srv.db, phoneNumber)
+	return true, nil
+}
@@ -0,0 +1,235 @@
+package ngobrel
+
+import (
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis"
+)
+
+// An OTP can be delivered over several channels. The client may prefer one of them, the others
+// follow in the order of OTP_CHANNELS. All messages are queued at once, each following channel
+// OTPChannelFallbackTimeout after the previous one, and the ones not sent yet are dropped as soon
+// as the OTP is used or replaced.
+//
+// The queued messages do not hold the code. It is kept in redis as OTP-MESSAGE-<key> until the
+// OTP expires, and the message is rendered from its brand and locale when it is sent.
+
+const (
+	OTPChannelSms   = "sms"
+	OTPChannelVoice = "voice"
+	OTPChannelEmail = "email"
+)
+
+// Delivers a message to a phone number or an email address, returning the ID given by the provider.
+// Every Sms is an OTPChannel.
+type OTPChannel interface {
+	SendMessage(from string, to string, message string) (string, error)
+}
+
+// An OTPChannel which needs to know the locale the message is written in, e.g. to speak it
+type LocalizedOTPChannel interface {
+	OTPChannel
+	SendLocalizedMessage(from string, to string, message string, locale string) (string, error)
+}
+
+// What the client asked for about the delivery of an OTP
+type otpHint struct {
+	locale  string
+	brand   string
+	channel string
+}
+
+// Sets up the channels listed in OTP_CHANNELS, "sms" by default:
+//
+//   - sms uses the given Sms
+//   - voice calls with Twilio, using VOICE_ACCOUNT, VOICE_TOKEN, VOICE_FROM and VOICE_URL
+//   - email sends with SMTP_ADDR, SMTP_USER, SMTP_PASSWORD, SMTP_FROM and SMTP_SUBJECT
+func otpChannelsFromEnv(sms Sms) (map[string]OTPChannel, []string, error) {
+	names := os.Getenv("OTP_CHANNELS")
+	if names == "" {
+		names = OTPChannelSms
+	}
+
+	channels := make(map[string]OTPChannel)
+	var order []string
+	for _, name := range strings.Split(names, ",") {
+		name = strings.TrimSpace(name)
+
+		switch name {
+		case OTPChannelSms:
+			channels[name] = sms
+		case OTPChannelVoice:
+			voice := NewTwilioVoice()
+			voice.SetAccount(os.Getenv("VOICE_ACCOUNT"), os.Getenv("VOICE_TOKEN"))
+			voice.SetValue("from", os.Getenv("VOICE_FROM"))
+			if url := os.Getenv("VOICE_URL"); url != "" {
+				voice.SetValue("url", url)
+			}
+			channels[name] = voice
+		case OTPChannelEmail:
+			email := NewSmtpEmail()
+			email.SetAccount(os.Getenv("SMTP_USER"), os.Getenv("SMTP_PASSWORD"))
+			email.SetValue("addr", os.Getenv("SMTP_ADDR"))
+			email.SetValue("from", os.Getenv("SMTP_FROM"))
+			if subject := os.Getenv("SMTP_SUBJECT"); subject != "" {
+				email.SetValue("subject", subject)
+			}
+			channels[name] = email
+		default:
+			return nil, nil, errors.New("unknown OTP channel " + name)
+		}
+		order = append(order, name)
+	}
+
+	return channels, order, nil
+}
+
+// The channels to deliver an OTP with, in order. Email is skipped without a verified address.
+func (srv *Server) otpChannelsFor(hint *otpHint, email string) ([]string, error) {
+	if hint.channel != "" {
+		if _, ok := srv.otpChannels[hint.channel]; ok == false {
+			return nil, errors.New("otp-channel-not-available")
+		}
+	}
+
+	var channels []string
+	for _, channel := range append([]string{hint.channel}, srv.otpChannelOrder...) {
+		if channel == "" || (channel == OTPChannelEmail && email == "") {
+			continue
+		}
+
+		seen := false
+		for _, c := range channels {
+			seen = seen || c == channel
+		}
+		if seen == false {
+			channels = append(channels, channel)
+		}
+	}
+
+	if len(channels) == 0 {
+		return nil, errors.New("otp-channel-not-available")
+	}
+	return channels, nil
+}
+
+// Reads the digits one by one, so text to speech does not read them as a number
+func spellOTP(otpCode string) string {
+	digits := make([]string, len(otpCode))
+	for i, c := range otpCode {
+		digits[i] = string(c)
+	}
+	return strings.Join(digits, ", ")
+}
+
+// The email address verified by the account of a phone number, if any. OTPs are never sent to
+// an address given along with the request, as whoever asks for the OTP may not own the number.
+func verifiedEmail(tx *sql.Tx, phoneNumber string) (string, error) {
+	var email string
+	err := tx.QueryRow(`SELECT COALESCE(email, '') FROM profile WHERE phone_number=$1`, phoneNumber).Scan(&email)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	if err != nil {
+		log.Println(err)
+	}
+	return email, err
+}
+
+// Keeps the code of an OTP in redis until the OTP expires, returning the key its messages refer to
+func storeOTPCode(srv *Server, otpCode string) (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		log.Println(err)
+		return "", err
+	}
+	key := hex.EncodeToString(b)
+
+	err := srv.redisClient.Set("OTP-MESSAGE-"+key, otpCode, OTPTTL).Err()
+	if err != nil {
+		log.Println(err)
+		return "", err
+	}
+	return key, nil
+}
+
+// Renders a queued OTP message with its code, failing with otp-expired once the code is gone
+func renderOTPMessage(srv *Server, channel, phoneNumber, locale, brand, otpKey string) (string, error) {
+	code, err := srv.redisClient.Get("OTP-MESSAGE-" + otpKey).Result()
+	if err == redis.Nil {
+		return "", errors.New("otp-expired")
+	}
+	if err != nil {
+		log.Println(err)
+		return "", err
+	}
+
+	if channel == OTPChannelVoice {
+		code = spellOTP(code)
+	}
+	_, message, _, err := srv.smsTemplates.otpMessage(phoneNumber, locale, brand, code)
+	return message, err
+}
+
+// Queues the messages of an OTP, dropping the ones of the previous OTP of the phone number
+func queueOTP(srv *Server, tx *sql.Tx, phoneNumber, otpCode string, hint *otpHint) error {
+	email := ""
+	if _, ok := srv.otpChannels[OTPChannelEmail]; ok {
+		var err error
+		email, err = verifiedEmail(tx, phoneNumber)
+		if err != nil {
+			return err
+		}
+	}
+
+	channels, err := srv.otpChannelsFor(hint, email)
+	if err != nil {
+		return err
+	}
+
+	err = cancelOTPMessages(tx, phoneNumber)
+	if err != nil {
+		return err
+	}
+
+	otpKey, err := storeOTPCode(srv, otpCode)
+	if err != nil {
+		return err
+	}
+
+	brand, _, locale := srv.smsTemplates.lookup(phoneNumber, hint.locale, hint.brand)
+	for i, channel := range channels {
+		recipient := phoneNumber
+		if channel == OTPChannelEmail {
+			recipient = email
+		}
+
+		delay := OTPChannelFallbackTimeout * time.Duration(i)
+		err = queueMessage(tx, channel, brand.sender, recipient, locale, hint.brand, phoneNumber, otpKey, delay)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type execer interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+}
+
+// Drops the messages of an OTP which have not been sent yet
+func cancelOTPMessages(db execer, phoneNumber string) error {
+	_, err := db.Exec(`UPDATE sms_queue SET status='cancelled', message='', updated_at=now() WHERE otp_phone_number=$1 AND status='queued'`, phoneNumber)
+	if err != nil {
+		log.Println(err)
+	}
+	return err
+}
@@ -0,0 +1,57 @@
+package ngobrel
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestOTPChannelsFor(t *testing.T) {
+	srv := &Server{
+		otpChannels: map[string]OTPChannel{
+			OTPChannelSms:   NewTwilioSms(),
+			OTPChannelVoice: NewTwilioVoice(),
+			OTPChannelEmail: NewSmtpEmail(),
+		},
+		otpChannelOrder: []string{OTPChannelSms, OTPChannelEmail, OTPChannelVoice},
+	}
+
+	cases := []struct {
+		channel string
+		email   string
+		want    []string
+	}{
+		{"", "", []string{OTPChannelSms, OTPChannelVoice}},
+		{"", "user@example.com", []string{OTPChannelSms, OTPChannelEmail, OTPChannelVoice}},
+		{OTPChannelVoice, "", []string{OTPChannelVoice, OTPChannelSms}},
+		// Without a verified address, asking for email does not send to one
+		{OTPChannelEmail, "", []string{OTPChannelSms, OTPChannelVoice}},
+		{OTPChannelEmail, "user@example.com", []string{OTPChannelEmail, OTPChannelSms, OTPChannelVoice}},
+	}
+	for _, c := range cases {
+		got, err := srv.otpChannelsFor(&otpHint{channel: c.channel}, c.email)
+		if err != nil {
+			t.Errorf("%q %q: %v", c.channel, c.email, err)
+			continue
+		}
+		if reflect.DeepEqual(got, c.want) == false {
+			t.Errorf("%q %q: got %v, want %v", c.channel, c.email, got, c.want)
+		}
+	}
+
+	if _, err := srv.otpChannelsFor(&otpHint{channel: "fax"}, ""); err == nil {
+		t.Errorf("an unknown channel was accepted")
+	}
+}
+
+func TestNormalizeEmail(t *testing.T) {
+	email, err := normalizeEmail(" User@Example.com ")
+	if err != nil || email != "user@example.com" {
+		t.Errorf("got %q %v", email, err)
+	}
+
+	for _, bad := range []string{"", "user", "User <user@example.com>", "a@b.c, d@e.f"} {
+		if _, err := normalizeEmail(bad); err == nil {
+			t.Errorf("%q was accepted", bad)
+		}
+	}
+}
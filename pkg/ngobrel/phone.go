@@ -0,0 +1,222 @@
+package ngobrel
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/ttacon/libphonenumber"
+	"golang.org/x/net/context"
+)
+
+// Reads PHONE_DEFAULT_REGION, the ISO 3166-1 region used for numbers without a country code
+func phoneRegion() string {
+	region := strings.ToUpper(os.Getenv("PHONE_DEFAULT_REGION"))
+	if region == "" {
+		return DefaultPhoneRegion
+	}
+
+	if libphonenumber.GetCountryCodeForRegion(region) == 0 {
+		log.Fatal("PHONE_DEFAULT_REGION is not a known region: " + region)
+	}
+	return region
+}
+
+// Normalizes a phone number to E.164. Numbers without a leading + are read as national
+// numbers of the region, or with the international prefix of the region. When that is not
+// a valid number, they are read as international numbers with 00 or without the +,
+// e.g. "0062812..." or "62812..." for region ID.
+func NormalizePhoneNumber(phoneNumber, region string) (string, error) {
+	phoneNumber = strings.TrimSpace(phoneNumber)
+	if phoneNumber == "" {
+		return "", errors.New("invalid-phone-number")
+	}
+
+	number, err := libphonenumber.Parse(phoneNumber, region)
+	if err != nil || libphonenumber.IsValidNumber(number) == false {
+		if strings.HasPrefix(phoneNumber, "+") {
+			return "", errors.New("invalid-phone-number")
+		}
+
+		international := "+" + phoneNumber
+		if strings.HasPrefix(phoneNumber, "00") {
+			international = "+" + phoneNumber[2:]
+		}
+		number, err = libphonenumber.Parse(international, region)
+		if err != nil || libphonenumber.IsValidNumber(number) == false {
+			return "", errors.New("invalid-phone-number")
+		}
+	}
+
+	return libphonenumber.Format(number, libphonenumber.E164), nil
+}
+
+func (srv *Server) normalizePhoneNumber(phoneNumber string) (string, error) {
+	normalized, err := NormalizePhoneNumber(phoneNumber, srv.phoneRegion)
+	if err != nil {
+		log.Println(err, phoneNumber)
+	}
+	return normalized, err
+}
+
+func isPhoneNumberInUse(srv *Server, phoneNumber string) (bool, error) {
+	rows, err := srv.db.Query(`SELECT user_id FROM profile WHERE phone_number=$1`, phoneNumber)
+	if err != nil {
+		log.Println(err)
+		return false, err
+	}
+
+	defer rows.Close()
+	return rows.Next(), nil
+}
+
+func (req *RequestPhoneNumberChangeRequest) RequestPhoneNumberChange(srv *Server, userID uuid.UUID, deviceID uuid.UUID) (*RequestPhoneNumberChangeResponse, error) {
+	inUse, err := isPhoneNumberInUse(srv, req.PhoneNumber)
+	if err != nil {
+		return nil, err
+	}
+	if inUse {
+		return nil, errors.New("phone-number-already-in-use")
+	}
+
+	log.Println("RequestPhoneNumberChange", userID.String(), req.PhoneNumber)
+
+	tx, err := srv.db.Begin()
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	otpCode, err := putOTP(srv, tx, req.PhoneNumber, deviceID.String(), &otpHint{locale: req.Locale, brand: req.Brand})
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	srv.wakeSmsQueue()
+
+	if srv.debugMode == false {
+		otpCode = ""
+	}
+
+	return &RequestPhoneNumberChangeResponse{
+		OtpDebug: otpCode, // DEBUG Mode
+	}, nil
+}
+
+func (req *ChangePhoneNumberRequest) ChangePhoneNumber(srv *Server, userID uuid.UUID, deviceID uuid.UUID, now float64) (*ChangePhoneNumberResponse, error) {
+	verified, err := consumeOTP(srv, req.PhoneNumber, deviceID.String(), req.OTP)
+	if err != nil {
+		return nil, err
+	}
+	if verified == false {
+		log.Println("Verification failed for", req.PhoneNumber)
+		return nil, errors.New("verification-otp-failed")
+	}
+
+	ctx := context.Background()
+	tx, err := srv.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	// The number could have been taken since the OTP was sent
+	result, err := tx.Exec(`UPDATE profile SET phone_number=$1, updated_at=now() WHERE user_id=$2
+	AND NOT EXISTS (SELECT 1 FROM profile WHERE phone_number=$1)`, req.PhoneNumber, userID.String())
+	if err != nil {
+		_ = tx.Rollback()
+		log.Println(err)
+		return nil, err
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		_ = tx.Rollback()
+		log.Println(err)
+		return nil, err
+	}
+	if count != 1 {
+		_ = tx.Rollback()
+		return nil, errors.New("phone-number-already-in-use")
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	log.Println("Phone number changed", userID.String(), req.PhoneNumber)
+
+	if req.NotifyContacts {
+		notifyPhoneNumberChange(srv, userID, deviceID, req.PhoneNumber, now)
+	}
+
+	return &ChangePhoneNumberResponse{
+		Success:     true,
+		PhoneNumber: req.PhoneNumber,
+	}, nil
+}
+
+// Tells the users who have userID in their contacts about the new phone number, with a single
+// statement putting the message to all their active devices. The number is already changed,
+// so failures are only logged.
+func notifyPhoneNumberChange(srv *Server, userID uuid.UUID, deviceID uuid.UUID, phoneNumber string, now float64) {
+	contents, _ := json.Marshal(&ManagementMessage{
+		MessageType: "management",
+		Text:        "phone-number-changed",
+		PhoneNumber: phoneNumber,
+	})
+	messageID := (time.Now().UnixNano() / 1000000) - 946659600000 // 2000-01-01T00:00:00
+
+	// 87654321
+	// ---*---- bit #4 is set when a contact has blocked the user
+	rows, err := srv.db.Query(`
+	WITH recipients AS (
+		SELECT c.user_id, d.device_id
+		FROM contacts c, devices d
+		WHERE c.chat_id=$1
+		AND c.chat_type=0
+		AND d.user_id=c.user_id
+		AND d.device_state=1
+		AND NOT EXISTS (SELECT 1 FROM chat_list x WHERE x.user_id=c.user_id AND x.chat_id=$1 AND (x.chat_type & 16) = 16)
+	), delivered AS (
+		INSERT INTO conversations (recipient_id, message_id, sender_id, sender_device_id, recipient_device_id, message_timestamp, message_contents, message_encrypted)
+		SELECT user_id, $2::bigint, $1::uuid, $3::uuid, device_id, to_timestamp($4), $5::text, false FROM recipients
+	)
+	SELECT DISTINCT user_id FROM recipients`,
+		userID.String(), messageID, deviceID.String(), now, string(contents))
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	defer rows.Close()
+
+	ts := time.Now().UnixNano() / 1000
+	for rows.Next() {
+		var contactID uuid.UUID
+		if err := rows.Scan(&contactID); err != nil {
+			log.Println(err)
+			return
+		}
+
+		srv.dispatchPush(&pushJob{
+			senderID:     userID,
+			chatID:       contactID,
+			isManagement: true,
+			timestamp:    ts,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		log.Println(err)
+	}
+}
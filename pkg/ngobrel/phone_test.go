@@ -0,0 +1,59 @@
+package ngobrel
+
+import "testing"
+
+func TestNormalizePhoneNumber(t *testing.T) {
+	cases := []struct {
+		phoneNumber string
+		region      string
+		want        string
+	}{
+		// International
+		{"+6281234567890", "ID", "+6281234567890"},
+		{" +62 812-3456-7890 ", "ID", "+6281234567890"},
+		{"+14155550100", "ID", "+14155550100"},
+
+		// National
+		{"081234567890", "ID", "+6281234567890"},
+		{"(415) 555-0100", "US", "+14155550100"},
+
+		// International without the +
+		{"6281234567890", "ID", "+6281234567890"},
+		{"442071234567", "ID", "+442071234567"},
+		{"6281234567890", "US", "+6281234567890"},
+
+		// International with 00, or with the international prefix of the region
+		{"006281234567890", "ID", "+6281234567890"},
+		{"00 44 20 7123 4567", "ID", "+442071234567"},
+		{"001442071234567", "ID", "+442071234567"},
+		{"011442071234567", "US", "+442071234567"},
+
+		// Valid both as a national number (Medan) and without the + (Singapore),
+		// the national reading wins
+		{"6561234567", "ID", "+626561234567"},
+		{"+6561234567", "ID", "+6561234567"},
+
+		// Invalid
+		{"", "ID", ""},
+		{"   ", "ID", ""},
+		{"abc", "ID", ""},
+		{"12", "ID", ""},
+		{"+62123", "ID", ""},
+		{"+0812345678", "ID", ""},
+		{"4420712345", "ID", ""},
+		{"0099", "ID", ""},
+	}
+
+	for _, c := range cases {
+		got, err := NormalizePhoneNumber(c.phoneNumber, c.region)
+		if c.want == "" {
+			if err == nil || err.Error() != "invalid-phone-number" {
+				t.Errorf("%q %s: got %q %v, want invalid-phone-number", c.phoneNumber, c.region, got, err)
+			}
+			continue
+		}
+		if err != nil || got != c.want {
+			t.Errorf("%q %s: got %q %v, want %q", c.phoneNumber, c.region, got, err, c.want)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package ngobrel
+
+import (
+	"errors"
+	"log"
+	"time"
+
+	"github.com/lib/pq"
+	uuid "github.com/satori/go.uuid"
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/net/context"
+)
+
+// The PIN of an account is kept as a bcrypt hash in profile.pin_hash. Once it is set, VerifyOTP
+// only gives a restricted token, so taking over the phone number is not enough to use the account.
+
+func validatePIN(pin string) error {
+	if len(pin) < MinPINLength || len(pin) > MaxPINLength {
+		return errors.New("invalid-pin")
+	}
+	for _, c := range pin {
+		if c < '0' || c > '9' {
+			return errors.New("invalid-pin")
+		}
+	}
+	return nil
+}
+
+func getPIN(srv *Server, userID string) (string, pq.NullTime, error) {
+	rows, err := srv.db.Query(`SELECT COALESCE(pin_hash, ''), pin_verified_at FROM profile WHERE user_id=$1`, userID)
+	if err != nil {
+		log.Println(err)
+		return "", pq.NullTime{}, err
+	}
+
+	defer rows.Close()
+	var pinHash string
+	var verifiedAt pq.NullTime
+	for rows.Next() {
+		if err := rows.Scan(&pinHash, &verifiedAt); err != nil {
+			log.Println(err)
+			return "", pq.NullTime{}, err
+		}
+	}
+
+	return pinHash, verifiedAt, nil
+}
+
+func hasPIN(srv *Server, userID string) (bool, error) {
+	pinHash, _, err := getPIN(srv, userID)
+	return pinHash != "", err
+}
+
+// Checks the PIN of a user, counting every attempt towards a lockout until one succeeds
+func (srv *Server) checkPIN(ctx context.Context, userID, pin string) error {
+	err := srv.limitPINVerify(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	pinHash, _, err := getPIN(srv, userID)
+	if err != nil {
+		return err
+	}
+	if pinHash == "" {
+		return errors.New("pin-not-set")
+	}
+
+	if bcrypt.CompareHashAndPassword([]byte(pinHash), []byte(pin)) != nil {
+		log.Println("PIN verification failed for", userID)
+		return errors.New("pin-verification-failed")
+	}
+
+	srv.resetPINVerify(userID)
+	return nil
+}
+
+func (req *SetPINRequest) SetPIN(srv *Server, ctx context.Context, userID uuid.UUID) (*SetPINResponse, error) {
+	if err := validatePIN(req.Pin); err != nil {
+		return nil, err
+	}
+
+	isSet, err := hasPIN(srv, userID.String())
+	if err != nil {
+		return nil, err
+	}
+	if isSet {
+		if err := srv.checkPIN(ctx, userID.String(), req.CurrentPIN); err != nil {
+			return nil, err
+		}
+	}
+
+	pinHash, err := bcrypt.GenerateFromPassword([]byte(req.Pin), bcrypt.DefaultCost)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	_, err = srv.db.Exec(`UPDATE profile SET pin_hash=$1, pin_verified_at=now(), updated_at=now() WHERE user_id=$2`, string(pinHash), userID.String())
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return &SetPINResponse{Success: true}, nil
+}
+
+func (req *RemovePINRequest) RemovePIN(srv *Server, ctx context.Context, userID uuid.UUID) (*RemovePINResponse, error) {
+	if err := srv.checkPIN(ctx, userID.String(), req.CurrentPIN); err != nil {
+		return nil, err
+	}
+
+	_, err := srv.db.Exec(`UPDATE profile SET pin_hash=NULL, pin_verified_at=NULL, updated_at=now() WHERE user_id=$1`, userID.String())
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return &RemovePINResponse{Success: true}, nil
+}
+
+func (req *VerifyPINRequest) VerifyPIN(srv *Server, ctx context.Context, p *Principal) (*VerifyPINResponse, error) {
+	userID := p.UserID.String()
+	deviceID := p.DeviceID.String()
+
+	if err := srv.checkPIN(ctx, userID, req.Pin); err != nil {
+		return nil, err
+	}
+
+	_, err := srv.db.Exec(`UPDATE profile SET pin_verified_at=now() WHERE user_id=$1`, userID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	if p.Restricted == false {
+		return &VerifyPINResponse{Success: true}, nil
+	}
+
+	err = activateVerifiedDevice(srv, userID, deviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = revokeRestrictedSession(srv, p.token)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := createSession(srv, userID, deviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VerifyPINResponse{
+		Success:      true,
+		Token:        s.token,
+		RefreshToken: s.refreshToken,
+		ExpiredAt:    s.expiredAt.UnixNano() / 1000000,
+	}, nil
+}
+
+func (req *GetPINStatusRequest) GetPINStatus(srv *Server, userID uuid.UUID) (*GetPINStatusResponse, error) {
+	pinHash, verifiedAt, err := getPIN(srv, userID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	if pinHash == "" {
+		return &GetPINStatusResponse{}, nil
+	}
+
+	return &GetPINStatusResponse{
+		IsSet:       true,
+		ReminderDue: verifiedAt.Valid == false || time.Since(verifiedAt.Time) > PINReminderInterval,
+	}, nil
+}
@@ -0,0 +1,134 @@
+package ngobrel
+
+import (
+	"expvar"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// Notifications are sent by a pool of workers fed by a bounded queue, so a slow push provider
+// never holds up the senders. Messages are only dispatched after their transaction is committed.
+// When the queue stays full for PushEnqueueTimeout the notification is dropped.
+//
+// Counts:
+//
+//	enqueued      notifications put in the queue
+//	blocked       notifications which had to wait for room in the queue
+//	dropped       notifications given up on because the queue was full or closed
+//	sent          notifications handled by the workers
+//	queue_length  notifications waiting in the queue
+var pushStats = expvar.NewMap("push")
+
+// A notification of a message which has been put to a user or a group
+type pushJob struct {
+	senderID uuid.UUID
+	// The recipient user, or the group
+	chatID uuid.UUID
+	// The members of the group to notify, nil for a user
+	groupRecipients []groupRecipient
+	excerpt         string
+	isManagement    bool
+	timestamp       int64
+}
+
+func pushQueueSize() int {
+	size, err := strconv.Atoi(os.Getenv("PUSH_QUEUE_SIZE"))
+	if err != nil || size < 1 {
+		return DefaultPushQueueSize
+	}
+	return size
+}
+
+// Queues a notification, waiting at most PushEnqueueTimeout for room in the queue
+func (srv *Server) dispatchPush(job *pushJob) {
+	srv.pushLock.RLock()
+	defer srv.pushLock.RUnlock()
+
+	if srv.pushClosed {
+		log.Println("Push queue is closed, dropping notification to", job.chatID.String())
+		pushStats.Add("dropped", 1)
+		return
+	}
+
+	select {
+	case srv.pushQueue <- job:
+		pushStats.Add("enqueued", 1)
+		return
+	default:
+	}
+
+	pushStats.Add("blocked", 1)
+	timer := time.NewTimer(PushEnqueueTimeout)
+	defer timer.Stop()
+
+	select {
+	case srv.pushQueue <- job:
+		pushStats.Add("enqueued", 1)
+	case <-timer.C:
+		log.Println("Push queue is full, dropping notification to", job.chatID.String())
+		pushStats.Add("dropped", 1)
+	}
+}
+
+// Starts PUSH_WORKERS workers, DefaultPushWorkers if it is not set
+func (srv *Server) StartPushWorkers() {
+	workers, err := strconv.Atoi(os.Getenv("PUSH_WORKERS"))
+	if err != nil || workers < 1 {
+		workers = DefaultPushWorkers
+	}
+
+	queue := srv.pushQueue
+	pushStats.Set("queue_length", expvar.Func(func() interface{} {
+		return len(queue)
+	}))
+
+	for i := 0; i < workers; i++ {
+		srv.pushWorkers.Add(1)
+		go func() {
+			defer srv.pushWorkers.Done()
+			for job := range queue {
+				srv.sendPush(job)
+				pushStats.Add("sent", 1)
+			}
+		}()
+	}
+}
+
+func (srv *Server) sendPush(job *pushJob) {
+	if len(srv.pushProviders) == 0 {
+		return
+	}
+
+	if job.groupRecipients != nil {
+		srv.sendGroupNotification(job.senderID, job.chatID, job.groupRecipients, job.excerpt, job.timestamp, job.isManagement)
+		return
+	}
+	srv.sendUserNotification(job.senderID, job.chatID, job.excerpt, job.timestamp, job.isManagement)
+}
+
+// Stops taking notifications and waits up to PushDrainTimeout for the queued ones to be sent
+func (srv *Server) StopPushWorkers() {
+	srv.pushLock.Lock()
+	if srv.pushClosed == false {
+		srv.pushClosed = true
+		close(srv.pushQueue)
+	}
+	srv.pushLock.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		srv.pushWorkers.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		log.Println("Push queue drained")
+	case <-time.After(PushDrainTimeout):
+		log.Println("Push queue not drained in time,", len(srv.pushQueue), "notifications left")
+	}
+}
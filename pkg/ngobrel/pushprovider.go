@@ -0,0 +1,185 @@
+package ngobrel
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"expvar"
+	"log"
+	"math/big"
+	"os"
+	"time"
+)
+
+// The push providers a device can register a token with
+const (
+	PushProviderFCM     = "fcm"
+	PushProviderAPNs    = "apns"
+	PushProviderWebPush = "webpush"
+)
+
+var pushProviderNames = []string{PushProviderFCM, PushProviderAPNs, PushProviderWebPush}
+
+// A notification of a message, sent to a single device
+type PushNotification struct {
+	// Shown to the user, both empty for a silent notification
+	Title string
+	Body  string
+	// Passed to the app, e.g. chatID, recipientID and timestamp
+	Data map[string]string
+}
+
+// Delivers notifications to the devices which registered a token with it
+type PushProvider interface {
+	// Sends a notification, trying again as the provider allows. Errors which tell the token
+	// is no longer valid have a TokenInvalid method returning true.
+	SendNotification(token string, notification *PushNotification) error
+}
+
+// An error response of a push provider
+type pushError interface {
+	error
+	// Whether the token the notification was sent to should be dropped
+	TokenInvalid() bool
+	// Whether the notification may get through later
+	Retryable() bool
+	// How long the provider asks to wait before trying again, if it does
+	retryAfter() time.Duration
+	// The counter of the error
+	outcome() string
+}
+
+// Sets up the push providers which are configured:
+//
+//   - fcm with FCM_PROJECT_URL and FCM_CONFIG_PATH
+//   - apns with APNS_KEY_PATH, APNS_KEY_ID, APNS_TEAM_ID, APNS_TOPIC and APNS_URL
+//   - webpush with VAPID_PRIVATE_KEY and VAPID_SUBJECT
+func pushProvidersFromEnv() (map[string]PushProvider, error) {
+	providers := make(map[string]PushProvider)
+
+	if os.Getenv("FCM_PROJECT_URL") != "" {
+		fcm, err := newFCMFromEnv()
+		if err != nil {
+			return nil, err
+		}
+		providers[PushProviderFCM] = fcm
+	}
+
+	if os.Getenv("APNS_KEY_PATH") != "" {
+		apns, err := newAPNsFromEnv()
+		if err != nil {
+			return nil, err
+		}
+		providers[PushProviderAPNs] = apns
+	}
+
+	if os.Getenv("VAPID_PRIVATE_KEY") != "" {
+		webPush, err := newWebPushFromEnv()
+		if err != nil {
+			return nil, err
+		}
+		providers[PushProviderWebPush] = webPush
+	}
+
+	return providers, nil
+}
+
+// Waits between the attempts of sendWithRetry, replaced in tests
+var pushSleep = time.Sleep
+
+// Sends with send, trying again after quota and server errors, up to PushMaxAttempts times.
+// The wait starts at PushRetryBase and doubles up to PushRetryMax, unless the provider asks for longer.
+// A message the provider asks to hold back for more than PushRetryMax is given up on, so a worker
+// is never blocked longer than that. The outcomes are counted in stats.
+func sendWithRetry(stats *expvar.Map, name string, send func() error) error {
+	backoff := PushRetryBase
+	for attempt := 1; ; attempt++ {
+		err := send()
+		if err == nil {
+			stats.Add("sent", 1)
+			return nil
+		}
+
+		retryable := true
+		var wait time.Duration
+		if pushErr, ok := err.(pushError); ok {
+			stats.Add(pushErr.outcome(), 1)
+			retryable = pushErr.Retryable()
+			wait = pushErr.retryAfter()
+		} else {
+			stats.Add("network_error", 1)
+		}
+
+		if retryable == false || attempt >= PushMaxAttempts {
+			log.Println("Giving up sending", name, "message", err)
+			stats.Add("failed", 1)
+			return err
+		}
+
+		if wait > PushRetryMax {
+			log.Println("Giving up sending", name, "message, asked to wait", wait, err)
+			stats.Add("failed", 1)
+			return err
+		}
+
+		if wait < backoff {
+			wait = backoff
+		}
+		log.Println("Sending", name, "message failed, retrying in", wait, err)
+		stats.Add("retried", 1)
+		pushSleep(wait)
+
+		backoff *= 2
+		if backoff > PushRetryMax {
+			backoff = PushRetryMax
+		}
+	}
+}
+
+// Signs a JSON web token with ES256, as APNs and Web Push expect
+func signJWT(key *ecdsa.PrivateKey, header interface{}, claims interface{}) (string, error) {
+	h, err := json.Marshal(header)
+	if err != nil {
+		return "", err
+	}
+	c, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	unsigned := base64.RawURLEncoding.EncodeToString(h) + "." + base64.RawURLEncoding.EncodeToString(c)
+	digest := sha256.Sum256([]byte(unsigned))
+	r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+	if err != nil {
+		return "", err
+	}
+
+	// The signature is r and s, each padded to the size of the curve
+	size := (key.Curve.Params().BitSize + 7) / 8
+	signature := make([]byte, 2*size)
+	copyPadded(signature[:size], r)
+	copyPadded(signature[size:], s)
+
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+func copyPadded(dst []byte, n *big.Int) {
+	b := n.Bytes()
+	copy(dst[len(dst)-len(b):], b)
+}
+
+// Decodes base64url, with or without padding, as keys and subscriptions are given in either
+func decodeBase64URL(s string) ([]byte, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err == nil {
+		return b, nil
+	}
+	b, err = base64.URLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, errors.New("invalid-base64")
+	}
+	return b, nil
+}
@@ -0,0 +1,129 @@
+package ngobrel
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"expvar"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Checks the ES256 signature of a JSON web token, returning its claims
+func verifyJWT(t *testing.T, token string, key *ecdsa.PublicKey) map[string]interface{} {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		t.Fatalf("not a JWT: %s", token)
+	}
+
+	var header map[string]interface{}
+	if err := json.Unmarshal(mustDecodeBase64URL(t, parts[0]), &header); err != nil || header["alg"] != "ES256" {
+		t.Fatalf("header: %s %v", parts[0], err)
+	}
+
+	signature := mustDecodeBase64URL(t, parts[2])
+	if len(signature) != 64 {
+		t.Fatalf("signature of %d bytes", len(signature))
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	r := new(big.Int).SetBytes(signature[:32])
+	s := new(big.Int).SetBytes(signature[32:])
+	if ecdsa.Verify(key, digest[:], r, s) == false {
+		t.Fatalf("bad signature: %s", token)
+	}
+
+	var claims map[string]interface{}
+	if err := json.Unmarshal(mustDecodeBase64URL(t, parts[1]), &claims); err != nil {
+		t.Fatal(err)
+	}
+	return claims
+}
+
+func TestSignJWT(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Signatures with a short r or s are padded, so sign a few times
+	for i := 0; i < 20; i++ {
+		token, err := signJWT(key, map[string]string{"alg": "ES256", "kid": "KEY123"}, map[string]interface{}{"iss": "TEAM123", "iat": 1540000000})
+		if err != nil {
+			t.Fatal(err)
+		}
+		claims := verifyJWT(t, token, &key.PublicKey)
+		if claims["iss"] != "TEAM123" || claims["iat"] != float64(1540000000) {
+			t.Errorf("claims: %v", claims)
+		}
+		if strings.ContainsAny(token, "+/=") {
+			t.Errorf("not base64url: %s", token)
+		}
+	}
+
+	other, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	token, _ := signJWT(other, map[string]string{"alg": "ES256"}, map[string]interface{}{})
+	parts := strings.Split(token, ".")
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	signature, _ := base64.RawURLEncoding.DecodeString(parts[2])
+	if ecdsa.Verify(&key.PublicKey, digest[:], new(big.Int).SetBytes(signature[:32]), new(big.Int).SetBytes(signature[32:])) {
+		t.Errorf("a signature of another key was verified")
+	}
+}
+
+// A pushError with fixed answers
+type fakePushError struct {
+	retryable bool
+	wait      time.Duration
+}
+
+func (e *fakePushError) Error() string             { return "fake" }
+func (e *fakePushError) TokenInvalid() bool        { return false }
+func (e *fakePushError) Retryable() bool           { return e.retryable }
+func (e *fakePushError) retryAfter() time.Duration { return e.wait }
+func (e *fakePushError) outcome() string           { return "fake" }
+
+func TestSendWithRetry(t *testing.T) {
+	waits, restore := fakePushSleep()
+	defer restore()
+	stats := new(expvar.Map).Init()
+
+	// Backoff, capped at PushRetryMax, and a Retry-After within it
+	attempts := 0
+	err := sendWithRetry(stats, "test", func() error {
+		attempts++
+		if attempts == 2 {
+			return &fakePushError{retryable: true, wait: 5 * time.Second}
+		}
+		if attempts < PushMaxAttempts {
+			return errors.New("network")
+		}
+		return nil
+	})
+	if err != nil || attempts != PushMaxAttempts {
+		t.Fatalf("%d attempts, %v", attempts, err)
+	}
+	if want := []time.Duration{time.Second, 5 * time.Second, 4 * time.Second}; len(*waits) != len(want) || (*waits)[0] != want[0] || (*waits)[1] != want[1] || (*waits)[2] != want[2] {
+		t.Errorf("waits: got %v, want %v", *waits, want)
+	}
+
+	// A Retry-After over PushRetryMax drops the notification
+	*waits = nil
+	attempts = 0
+	err = sendWithRetry(stats, "test", func() error {
+		attempts++
+		return &fakePushError{retryable: true, wait: PushRetryMax + time.Second}
+	})
+	if err == nil || attempts != 1 || len(*waits) != 0 {
+		t.Errorf("%d attempts, waits %v, %v", attempts, *waits, err)
+	}
+
+	if stats.Get("sent").String() != "1" || stats.Get("failed").String() != "1" || stats.Get("retried").String() != "3" {
+		t.Errorf("stats: %s", stats.String())
+	}
+}
@@ -0,0 +1,234 @@
+package ngobrel
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/go-redis/redis"
+	uuid "github.com/satori/go.uuid"
+)
+
+// Push tokens are kept per device in redis, in <PROVIDER>-DEV-<deviceID>, e.g. FCM-DEV-<deviceID>.
+// A device has a token with a single provider. FCM-<userID> is the token registered for
+// the user before tokens were kept per device, it is used until a device registers one.
+
+// A push token and the redis key it is kept in
+type pushToken struct {
+	provider string
+	key      string
+	token    string
+}
+
+func pushTokenKey(provider string, deviceID string) string {
+	return strings.ToUpper(provider) + "-DEV-" + deviceID
+}
+
+// The keys a device may have a push token in
+func pushTokenKeys(deviceID string) []string {
+	keys := make([]string, len(pushProviderNames))
+	for i, provider := range pushProviderNames {
+		keys[i] = pushTokenKey(provider, deviceID)
+	}
+	return keys
+}
+
+// Returns the push tokens of the active devices of a user
+func pushTokensOf(srv *Server, userID string) ([]pushToken, error) {
+	rows, err := srv.db.Query(`SELECT device_id FROM devices WHERE user_id=$1 AND device_state=1`, userID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tokens []pushToken
+	for rows.Next() {
+		var deviceID string
+		if err := rows.Scan(&deviceID); err != nil {
+			log.Println(err)
+			return nil, err
+		}
+		for _, provider := range pushProviderNames {
+			tokens = append(tokens, pushToken{provider: provider, key: pushTokenKey(provider, deviceID)})
+		}
+	}
+	tokens = append(tokens, pushToken{provider: PushProviderFCM, key: "FCM-" + userID})
+
+	keys := make([]string, len(tokens))
+	for i, t := range tokens {
+		keys[i] = t.key
+	}
+	values, err := srv.redisClient.MGet(keys...).Result()
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	var found []pushToken
+	for i, value := range values[:len(values)-1] {
+		if token, ok := value.(string); ok && token != "" {
+			tokens[i].token = token
+			found = append(found, tokens[i])
+		}
+	}
+	if userToken, ok := values[len(values)-1].(string); ok && userToken != "" && len(found) == 0 {
+		t := tokens[len(tokens)-1]
+		t.token = userToken
+		found = append(found, t)
+	}
+	return found, nil
+}
+
+// Sends a notification to every active device of a user
+func (srv *Server) sendNotification(chatID string, sender string, recipientChatID string, recipient string, excerpt string, now int64, isManagement bool) {
+
+	log.Println("sendNotification", sender, excerpt, isManagement)
+	tokens, err := pushTokensOf(srv, recipient)
+	if err != nil {
+		log.Println("Error getting push tokens of ", recipient)
+		log.Println(err)
+		return
+	}
+
+	notification := &PushNotification{
+		Data: map[string]string{
+			"chatID":      chatID,
+			"recipientID": recipient,
+			"timestamp":   fmt.Sprintf("%d", now),
+		},
+	}
+	if isManagement == false && excerpt != "" {
+		notification.Title = sender
+		notification.Body = excerpt
+		notification.Data["groupID"] = recipientChatID
+	}
+
+	srv.sendToPushTokens(tokens, notification)
+}
+
+// Sends a notification to each of the tokens, dropping the ones the provider no longer accepts
+func (srv *Server) sendToPushTokens(tokens []pushToken, notification *PushNotification) {
+	for _, t := range tokens {
+		provider, ok := srv.pushProviders[t.provider]
+		if ok == false {
+			continue
+		}
+
+		err := provider.SendNotification(t.token, notification)
+		if pushErr, ok := err.(pushError); ok && pushErr.TokenInvalid() {
+			removePushToken(srv, t)
+		}
+	}
+}
+
+// Drops a token the provider no longer accepts, unless the device has registered another one meanwhile
+func removePushToken(srv *Server, t pushToken) {
+	removed, err := srv.redisClient.Eval(`if redis.call("GET", KEYS[1]) == ARGV[1] then return redis.call("DEL", KEYS[1]) end return 0`,
+		[]string{t.key}, t.token).Int64()
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	if removed > 0 {
+		log.Println("Removed stale push token", t.key)
+		pushStats.Add("token_removed", 1)
+	}
+}
+
+// Sends a notification to a user after a message has been put to them
+func (srv *Server) sendUserNotification(senderID uuid.UUID, recipientID uuid.UUID, excerpt string, ts int64, isManagement bool) {
+	senderName, err := getNameFromUserID(srv, senderID.String(), recipientID.String())
+	if err != nil {
+		log.Println(err)
+	}
+
+	srv.sendNotification(senderID.String(), senderName, recipientID.String(), recipientID.String(), excerpt, ts, isManagement)
+}
+
+// Sends notifications to the members of a group after a message has been put to them
+func (srv *Server) sendGroupNotification(senderID uuid.UUID, groupID uuid.UUID, recipients []groupRecipient, excerpt string, ts int64, isManagement bool) {
+	senderName, err := getNameFromUserID(srv, senderID.String(), groupID.String())
+	if err != nil {
+		log.Println(err)
+	}
+
+	for _, recipient := range recipients {
+		name := recipient.contactName
+		if name == "" {
+			name = senderName
+		}
+		srv.sendNotification(senderID.String(), name, groupID.String(), recipient.userID.String(), excerpt, ts, isManagement)
+	}
+}
+
+// Registers the push token of a device, replacing the one it had with any provider
+func (req *RegisterPushTokenRequest) RegisterPushToken(srv *Server, userID uuid.UUID, deviceID uuid.UUID) (*RegisterPushTokenResponse, error) {
+	known := false
+	for _, provider := range pushProviderNames {
+		known = known || provider == req.Provider
+	}
+	if known == false {
+		return nil, errors.New("invalid-push-provider")
+	}
+
+	if req.Token == "" {
+		return nil, errors.New("invalid-push-token")
+	}
+	if req.Provider == PushProviderAPNs && validAPNsToken(req.Token) == false {
+		return nil, errors.New("invalid-push-token")
+	}
+	if req.Provider == PushProviderWebPush {
+		if _, err := parseWebPushSubscription(req.Token); err != nil {
+			return nil, errors.New("invalid-push-token")
+		}
+	}
+
+	log.Println("Registering", req.Provider, "push token for ", userID.String(), deviceID.String())
+	key := pushTokenKey(req.Provider, deviceID.String())
+
+	var others []string
+	for _, k := range pushTokenKeys(deviceID.String()) {
+		if k != key {
+			others = append(others, k)
+		}
+	}
+	// The token registered for the user before tokens were kept per device is no longer needed
+	others = append(others, "FCM-"+userID.String())
+
+	_, err := srv.redisClient.TxPipelined(func(pipe redis.Pipeliner) error {
+		pipe.Set(key, req.Token, 0)
+		pipe.Del(others...)
+		return nil
+	})
+	if err != nil {
+		log.Println("Error registering push token for device " + deviceID.String())
+		log.Println(err)
+		return nil, err
+	}
+
+	return &RegisterPushTokenResponse{Success: true}, nil
+}
+
+// Kept for the clients which only know FCM
+func (req *RegisterFCMRequest) RegisterFCM(srv *Server, userID uuid.UUID, deviceID uuid.UUID) (*RegisterFCMResponse, error) {
+	register := &RegisterPushTokenRequest{
+		Provider: PushProviderFCM,
+		Token:    req.FCMToken,
+	}
+
+	_, err := register.RegisterPushToken(srv, userID, deviceID)
+	if err != nil {
+		return nil, err
+	}
+	return &RegisterFCMResponse{Success: true}, nil
+}
+
+func removeDevicePushTokens(srv *Server, deviceID string) error {
+	err := srv.redisClient.Del(pushTokenKeys(deviceID)...).Err()
+	if err != nil {
+		log.Println(err)
+	}
+	return err
+}
@@ -0,0 +1,200 @@
+package ngobrel
+
+import (
+	"log"
+	"net"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// Rate limits are kept in redis as fixed window counters:
+//
+//   RL-OTP-PHONE-<phone>     OTPs sent to a phone number in the current hour
+//   RL-OTP-IP-<ip>           OTPs requested from an IP address in the current hour
+//   RL-SMS-<yyyymmdd>        SMS sent today
+//   RL-VERIFY-<phone>        OTP verifications since the last lockout or success
+//   RL-VERIFY-LOCKS-<phone>  OTP lockouts in the last LockoutMax
+//   LOCK-VERIFY-<phone>      set while the phone number is locked out
+//   RL-PIN-<userID>          PIN verifications since the last lockout or success
+//   RL-PIN-LOCKS-<userID>    PIN lockouts in the last LockoutMax
+//   LOCK-PIN-<userID>        set while the user is locked out from PIN verification
+
+func smsDailyBudget() int64 {
+	budget := int64(DefaultSmsDailyBudget)
+	if s := os.Getenv("SMS_DAILY_BUDGET"); s != "" {
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			log.Println("Invalid SMS_DAILY_BUDGET, using", budget)
+			return budget
+		}
+		budget = n
+	}
+	return budget
+}
+
+// Returns a ResourceExhausted error, telling the client when to try again
+// with the `retry-after` trailer in seconds
+func rateLimited(ctx context.Context, reason string, retryAfter time.Duration) error {
+	seconds := int64(retryAfter / time.Second)
+	if retryAfter%time.Second != 0 || seconds == 0 {
+		seconds++
+	}
+
+	err := grpc.SetTrailer(ctx, metadata.Pairs("retry-after", strconv.FormatInt(seconds, 10)))
+	if err != nil {
+		log.Println(err)
+	}
+	return status.Error(codes.ResourceExhausted, reason)
+}
+
+// Counts a hit in a fixed window, returns the time left in the window once the limit is exceeded
+func (srv *Server) hit(key string, limit int64, window time.Duration) (time.Duration, error) {
+	count, err := srv.redisClient.Incr(key).Result()
+	if err != nil {
+		log.Println(err)
+		return 0, err
+	}
+
+	ttl, err := srv.redisClient.TTL(key).Result()
+	if err != nil {
+		log.Println(err)
+		return 0, err
+	}
+	if ttl < 0 {
+		ttl = window
+		if err := srv.redisClient.Expire(key, window).Err(); err != nil {
+			log.Println(err)
+			return 0, err
+		}
+	}
+
+	if count > limit {
+		return ttl, nil
+	}
+	return 0, nil
+}
+
+func peerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if ok == false || p.Addr == nil {
+		return ""
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
+
+// Checks whether an OTP may be sent to a phone number
+func (srv *Server) limitOTPSend(ctx context.Context, phoneNumber string) error {
+	retryAfter, err := srv.hit("RL-OTP-PHONE-"+phoneNumber, OTPSendsPerPhonePerHour, time.Hour)
+	if err != nil {
+		return err
+	}
+	if retryAfter > 0 {
+		log.Println("Too many OTPs for", phoneNumber)
+		return rateLimited(ctx, "otp-too-many-requests", retryAfter)
+	}
+
+	if ip := peerIP(ctx); ip != "" {
+		retryAfter, err = srv.hit("RL-OTP-IP-"+ip, OTPSendsPerIPPerHour, time.Hour)
+		if err != nil {
+			return err
+		}
+		if retryAfter > 0 {
+			log.Println("Too many OTPs from", ip)
+			return rateLimited(ctx, "otp-too-many-requests", retryAfter)
+		}
+	}
+
+	now := time.Now().UTC()
+	retryAfter, err = srv.hit("RL-SMS-"+now.Format("20060102"), srv.smsBudget, 24*time.Hour)
+	if err != nil {
+		return err
+	}
+	if retryAfter > 0 {
+		log.Println("SMS budget exhausted")
+		tomorrow := now.Truncate(24 * time.Hour).Add(24 * time.Hour)
+		return rateLimited(ctx, "sms-budget-exhausted", tomorrow.Sub(now))
+	}
+
+	return nil
+}
+
+// Counts a verification of a phone number before it is checked, failing while the number is locked out
+func (srv *Server) limitOTPVerify(ctx context.Context, phoneNumber string) error {
+	return srv.countAttempt(ctx, "VERIFY", phoneNumber, OTPVerifyAttempts, "otp-verification-locked")
+}
+
+func (srv *Server) resetOTPVerify(phoneNumber string) {
+	srv.resetAttempts("VERIFY", phoneNumber)
+}
+
+// Counts a PIN verification of a user before it is checked, failing while the user is locked out
+func (srv *Server) limitPINVerify(ctx context.Context, userID string) error {
+	return srv.countAttempt(ctx, "PIN", userID, PINVerifyAttempts, "pin-verification-locked")
+}
+
+func (srv *Server) resetPINVerify(userID string) {
+	srv.resetAttempts("PIN", userID)
+}
+
+// Checks the lockout, counts the attempt and locks out once there are too many in one step,
+// returning the milliseconds to wait or 0 when the attempt may go on
+var countAttemptScript = redis.NewScript(`
+local wait = redis.call("PTTL", KEYS[1])
+if wait > 0 then return wait end
+
+local attempts = redis.call("INCR", KEYS[2])
+redis.call("PEXPIRE", KEYS[2], ARGV[3])
+if attempts <= tonumber(ARGV[1]) then return 0 end
+
+local locks = redis.call("INCR", KEYS[3])
+redis.call("PEXPIRE", KEYS[3], ARGV[3])
+local lockout = tonumber(ARGV[2])
+for i = 2, locks do
+	if lockout >= tonumber(ARGV[3]) then break end
+	lockout = lockout * 2
+end
+lockout = math.min(lockout, tonumber(ARGV[3]))
+
+redis.call("SET", KEYS[1], "1", "PX", lockout)
+redis.call("DEL", KEYS[2])
+return lockout
+`)
+
+// Counts an attempt before it is checked, so concurrent attempts can not get past the limit
+// before their failures are known. Once there have been more attempts than allowed since the
+// last success, locks out for twice as long as the previous lockout.
+func (srv *Server) countAttempt(ctx context.Context, kind, id string, attempts int64, reason string) error {
+	keys := []string{"LOCK-" + kind + "-" + id, "RL-" + kind + "-" + id, "RL-" + kind + "-LOCKS-" + id}
+	wait, err := countAttemptScript.Run(srv.redisClient, keys, attempts,
+		int64(LockoutBase/time.Millisecond), int64(LockoutMax/time.Millisecond)).Int64()
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+	if wait > 0 {
+		log.Println("Locked out", kind, id)
+		return rateLimited(ctx, reason, time.Duration(wait)*time.Millisecond)
+	}
+	return nil
+}
+
+func (srv *Server) resetAttempts(kind, id string) {
+	err := srv.redisClient.Del("RL-"+kind+"-"+id, "RL-"+kind+"-LOCKS-"+id).Err()
+	if err != nil {
+		log.Println(err)
+	}
+}
@@ -7,16 +7,11 @@ import (
 	"encoding/hex"
 	"errors"
 	"io"
-	"io/ioutil"
 	"log"
-	"net/http"
 	"os"
 	"sync"
 	"time"
 
-	"golang.org/x/oauth2"
-	"golang.org/x/oauth2/google"
-
 	"github.com/disintegration/imaging"
 
 	"github.com/cespare/xxhash"
@@ -30,26 +25,39 @@ import (
 	"google.golang.org/grpc/metadata"
 )
 
-type FCMAuth struct {
-	client     *http.Client
-	expired    time.Time
-	projectURL string
-}
-
 type Server struct {
-	receiptStream sync.Map
-	smsClient     Sms
-	minioClient   minio.Client
-	tmpDir        string
-	fcmAuth       FCMAuth
-	db            *sql.DB
-	redisClient   *redis.Client
+	receiptStream   sync.Map
+	smsClient       Sms
+	minioClient     minio.Client
+	tmpDir          string
+	pushProviders   map[string]PushProvider
+	db              *sql.DB
+	redisClient     *redis.Client
+	otpLength       int
+	otpSecret       []byte
+	smsBudget       int64
+	smsTemplates    *smsTemplates
+	otpChannels     map[string]OTPChannel
+	otpChannelOrder []string
+	phoneRegion     string
+
+	// Returns the OTP in the responses, for testing only. Set with DEBUG_MODE=1
+	debugMode bool
+
+	accountDeletionWake chan struct{}
+	smsQueueWake        chan struct{}
+
+	pushQueue   chan *pushJob
+	pushWorkers sync.WaitGroup
+	pushLock    sync.RWMutex
+	pushClosed  bool
 }
 
 type ManagementMessage struct {
-	MessageType string                          `json:"messageType"`
-	Text        string                          `json:"text"`
-	Command     ManagementReceptionStateMessage `json:"command"`
+	MessageType string                           `json:"messageType"`
+	Text        string                           `json:"text"`
+	Command     *ManagementReceptionStateMessage `json:"command,omitempty"`
+	PhoneNumber string                           `json:"phoneNumber,omitempty"`
 }
 
 type ManagementReceptionStateMessage struct {
@@ -64,43 +72,52 @@ func NewServer(sms Sms, minioClient minio.Client) *Server {
 		tmpDir = "/tmp"
 	}
 
-	fcmConfigPath := os.Getenv("FCM_CONFIG_PATH")
-	if fcmConfigPath == "" {
-		log.Fatal("FCM_CONFIG_PATH is not set")
+	debugMode := os.Getenv("DEBUG_MODE") == "1"
+	if debugMode {
+		log.Println("DEBUG_MODE is set, OTPs are returned in the responses")
 	}
 
-	data, err := ioutil.ReadFile(fcmConfigPath)
+	pushProviders, err := pushProvidersFromEnv()
 	if err != nil {
 		log.Fatal(err)
-		return nil
+	}
+	if len(pushProviders) == 0 {
+		log.Println("No push provider is configured, notifications are not sent")
 	}
 
-	log.Println("Login to Google Firebase")
-	googleConfig, err := google.JWTConfigFromJSON(data, "https://www.googleapis.com/auth/firebase.messaging")
+	otpLength, otpSecret, err := otpSettings()
 	if err != nil {
-		log.Println(err)
-		log.Fatal("Unable to login to Google Firebase")
+		log.Fatal(err)
 	}
 
-	projectURL := os.Getenv("FCM_PROJECT_URL")
-	if projectURL == "" {
-		log.Fatal("FCM_PROJECT_URL is not set")
+	smsTemplates, err := loadSmsTemplates()
+	if err != nil {
+		log.Fatal(err)
 	}
 
-	fcmAuth := FCMAuth{
-		client:     googleConfig.Client(oauth2.NoContext),
-		expired:    time.Now().Add(1 * time.Hour),
-		projectURL: projectURL,
+	otpChannels, otpChannelOrder, err := otpChannelsFromEnv(sms)
+	if err != nil {
+		log.Fatal(err)
 	}
 
-	log.Println("Login OK", fcmAuth.client)
-
 	log.SetFlags(log.Lshortfile)
 	return &Server{
-		smsClient:   sms,
-		minioClient: minioClient,
-		tmpDir:      tmpDir,
-		fcmAuth:     fcmAuth,
+		smsClient:       sms,
+		minioClient:     minioClient,
+		tmpDir:          tmpDir,
+		pushProviders:   pushProviders,
+		debugMode:       debugMode,
+		otpLength:       otpLength,
+		otpSecret:       otpSecret,
+		smsBudget:       smsDailyBudget(),
+		smsTemplates:    smsTemplates,
+		otpChannels:     otpChannels,
+		otpChannelOrder: otpChannelOrder,
+		phoneRegion:     phoneRegion(),
+
+		accountDeletionWake: make(chan struct{}, 1),
+		smsQueueWake:        make(chan struct{}, 1),
+		pushQueue:           make(chan *pushJob, pushQueueSize()),
 	}
 }
 
@@ -132,32 +149,6 @@ func getToken(ctx context.Context) (string, error) {
 	return idList[0], nil
 }
 
-func getDeviceID(srv *Server, ctx context.Context) (uuid.UUID, error) {
-	token, err := getToken(ctx)
-	if err != nil {
-		return uuid.Nil, err
-	}
-
-	id, err := getDeviceIDFromToken(srv, token)
-	if err != nil {
-		return uuid.Nil, err
-	}
-	return uuid.FromString(id)
-}
-
-func getUserID(srv *Server, ctx context.Context) (uuid.UUID, error) {
-	token, err := getToken(ctx)
-	if err != nil {
-		return uuid.Nil, err
-	}
-
-	id, err := getUserIDFromToken(srv, token)
-	if err != nil {
-		return uuid.Nil, err
-	}
-	return uuid.FromString(id)
-}
-
 func (srv *Server) GetMessageNotification(in *GetMessagesRequest, stream Ngobrel_GetMessageNotificationServer) error {
 	recipientDeviceID, err := getDeviceID(srv, stream.Context())
 	if err != nil {
@@ -197,7 +188,7 @@ func (srv *Server) PutMessage(ctx context.Context, in *PutMessageRequest) (*PutM
 	now := time.Now().UnixNano() / 1000.0 // in microsecs
 	nowFloat := float64(now) / 1000000.0  // in secs
 
-	err = in.putMessageToUserIDCheckGroup(srv, senderID, senderDeviceID, recipientID, nowFloat)
+	err = in.putMessageToUserIDCheckGroup(srv, senderID, senderDeviceID, recipientID, nowFloat, false)
 	if err != nil {
 		return nil, err
 	}
@@ -241,6 +232,17 @@ func (srv *Server) UpdateConversation(ctx context.Context, in *UpdateConversatio
 }
 
 func (srv *Server) CreateProfile(ctx context.Context, in *CreateProfileRequest) (*CreateProfileResponse, error) {
+	phoneNumber, err := srv.normalizePhoneNumber(in.PhoneNumber)
+	if err != nil {
+		return nil, err
+	}
+	in.PhoneNumber = phoneNumber
+
+	err = srv.limitOTPSend(ctx, in.PhoneNumber)
+	if err != nil {
+		return nil, err
+	}
+
 	return in.CreateProfile(srv)
 }
 
@@ -266,6 +268,12 @@ func (srv *Server) PutContact(ctx context.Context, in *PutContactRequest) (*PutC
 		return nil, err
 	}
 
+	phoneNumber, err := srv.normalizePhoneNumber(in.PhoneNumber)
+	if err != nil {
+		return nil, err
+	}
+	in.PhoneNumber = phoneNumber
+
 	return in.PutContact(srv, userID)
 }
 
@@ -289,8 +297,303 @@ func (srv *Server) CreateGroupConversation(ctx context.Context, in *CreateGroupC
 }
 
 func (srv *Server) VerifyOTP(ctx context.Context, in *VerifyOTPRequest) (*VerifyOTPResponse, error) {
+	phoneNumber, err := srv.normalizePhoneNumber(in.PhoneNumber)
+	if err != nil {
+		return nil, err
+	}
+	in.PhoneNumber = phoneNumber
+
+	err = srv.limitOTPVerify(ctx, in.PhoneNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	ret, err := in.VerifyOTP(srv)
+	if err != nil {
+		return nil, err
+	}
+
+	srv.resetOTPVerify(in.PhoneNumber)
+	return ret, nil
+}
+
+func (srv *Server) RequestDeviceLink(ctx context.Context, in *RequestDeviceLinkRequest) (*RequestDeviceLinkResponse, error) {
+	return in.RequestDeviceLink(srv, ctx)
+}
+
+func (srv *Server) ApproveDeviceLink(ctx context.Context, in *ApproveDeviceLinkRequest) (*ApproveDeviceLinkResponse, error) {
+	userID, err := getUserID(srv, ctx)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return in.ApproveDeviceLink(srv, userID)
+}
+
+func (srv *Server) CompleteDeviceLink(ctx context.Context, in *CompleteDeviceLinkRequest) (*CompleteDeviceLinkResponse, error) {
+	return in.CompleteDeviceLink(srv)
+}
+
+func (srv *Server) ListDevices(ctx context.Context, in *ListDevicesRequest) (*ListDevicesResponse, error) {
+	userID, err := getUserID(srv, ctx)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	deviceID, err := getDeviceID(srv, ctx)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return in.ListDevices(srv, userID, deviceID)
+}
+
+func (srv *Server) RenameDevice(ctx context.Context, in *RenameDeviceRequest) (*RenameDeviceResponse, error) {
+	userID, err := getUserID(srv, ctx)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return in.RenameDevice(srv, userID)
+}
+
+func (srv *Server) RemoveDevice(ctx context.Context, in *RemoveDeviceRequest) (*RemoveDeviceResponse, error) {
+	userID, err := getUserID(srv, ctx)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return in.RemoveDevice(srv, userID)
+}
+
+func (srv *Server) RefreshToken(ctx context.Context, in *RefreshTokenRequest) (*RefreshTokenResponse, error) {
+	return in.RefreshSession(srv)
+}
+
+func (srv *Server) Logout(ctx context.Context, in *LogoutRequest) (*LogoutResponse, error) {
+	userID, err := getUserID(srv, ctx)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	deviceID, err := getDeviceID(srv, ctx)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return in.Logout(srv, userID, deviceID)
+}
+
+func (srv *Server) LogoutAllDevices(ctx context.Context, in *LogoutAllDevicesRequest) (*LogoutAllDevicesResponse, error) {
+	userID, err := getUserID(srv, ctx)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return in.LogoutAllDevices(srv, userID)
+}
+
+func (srv *Server) RequestPhoneNumberChange(ctx context.Context, in *RequestPhoneNumberChangeRequest) (*RequestPhoneNumberChangeResponse, error) {
+	userID, err := getUserID(srv, ctx)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	deviceID, err := getDeviceID(srv, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	phoneNumber, err := srv.normalizePhoneNumber(in.PhoneNumber)
+	if err != nil {
+		return nil, err
+	}
+	in.PhoneNumber = phoneNumber
+
+	err = srv.limitOTPSend(ctx, in.PhoneNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	return in.RequestPhoneNumberChange(srv, userID, deviceID)
+}
+
+func (srv *Server) ChangePhoneNumber(ctx context.Context, in *ChangePhoneNumberRequest) (*ChangePhoneNumberResponse, error) {
+	userID, err := getUserID(srv, ctx)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	deviceID, err := getDeviceID(srv, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	phoneNumber, err := srv.normalizePhoneNumber(in.PhoneNumber)
+	if err != nil {
+		return nil, err
+	}
+	in.PhoneNumber = phoneNumber
+
+	err = srv.limitOTPVerify(ctx, in.PhoneNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UnixNano() / 1000.0 // in microsecs
+	nowFloat := float64(now) / 1000000.0  // in secs
+
+	ret, err := in.ChangePhoneNumber(srv, userID, deviceID, nowFloat)
+	if err != nil {
+		return nil, err
+	}
+
+	srv.resetOTPVerify(in.PhoneNumber)
+	return ret, nil
+}
+
+func (srv *Server) DeleteAccount(ctx context.Context, in *DeleteAccountRequest) (*DeleteAccountResponse, error) {
+	userID, err := getUserID(srv, ctx)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	deviceID, err := getDeviceID(srv, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return in.DeleteAccount(srv, ctx, userID, deviceID)
+}
+
+func (srv *Server) RequestDataExport(ctx context.Context, in *RequestDataExportRequest) (*RequestDataExportResponse, error) {
+	userID, err := getUserID(srv, ctx)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return in.RequestDataExport(srv, userID)
+}
+
+func (srv *Server) GetDataExportStatus(ctx context.Context, in *GetDataExportStatusRequest) (*GetDataExportStatusResponse, error) {
+	userID, err := getUserID(srv, ctx)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return in.GetDataExportStatus(srv, userID)
+}
+
+func (srv *Server) SetPIN(ctx context.Context, in *SetPINRequest) (*SetPINResponse, error) {
+	userID, err := getUserID(srv, ctx)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return in.SetPIN(srv, ctx, userID)
+}
+
+func (srv *Server) RemovePIN(ctx context.Context, in *RemovePINRequest) (*RemovePINResponse, error) {
+	userID, err := getUserID(srv, ctx)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return in.RemovePIN(srv, ctx, userID)
+}
+
+func (srv *Server) VerifyPIN(ctx context.Context, in *VerifyPINRequest) (*VerifyPINResponse, error) {
+	p, ok := PrincipalFromContext(ctx)
+	if ok == false {
+		err := errors.New("invalid-session")
+		log.Println(err)
+		return nil, err
+	}
+
+	return in.VerifyPIN(srv, ctx, p)
+}
 
-	return in.VerifyOTP(srv)
+func (srv *Server) GetPINStatus(ctx context.Context, in *GetPINStatusRequest) (*GetPINStatusResponse, error) {
+	userID, err := getUserID(srv, ctx)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return in.GetPINStatus(srv, userID)
+}
+
+func (srv *Server) RequestEmailVerification(ctx context.Context, in *RequestEmailVerificationRequest) (*RequestEmailVerificationResponse, error) {
+	userID, err := getUserID(srv, ctx)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	deviceID, err := getDeviceID(srv, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	email, err := normalizeEmail(in.Email)
+	if err != nil {
+		return nil, err
+	}
+	in.Email = email
+
+	err = srv.limitOTPSend(ctx, in.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	return in.RequestEmailVerification(srv, userID, deviceID)
+}
+
+func (srv *Server) VerifyEmail(ctx context.Context, in *VerifyEmailRequest) (*VerifyEmailResponse, error) {
+	userID, err := getUserID(srv, ctx)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	deviceID, err := getDeviceID(srv, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	email, err := normalizeEmail(in.Email)
+	if err != nil {
+		return nil, err
+	}
+	in.Email = email
+
+	err = srv.limitOTPVerify(ctx, in.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	ret, err := in.VerifyEmail(srv, userID, deviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	srv.resetOTPVerify(in.Email)
+	return ret, nil
 }
 
 func (srv *Server) ListGroupParticipants(ctx context.Context, in *ListGroupParticipantsRequest) (*ListGroupParticipantsResponse, error) {
@@ -362,6 +665,142 @@ func (srv *Server) RenameGroup(ctx context.Context, in *RenameGroupRequest) (*Re
 	return in.RenameGroup(srv, userID)
 }
 
+func (srv *Server) UpdateGroupAvatar(ctx context.Context, in *UpdateGroupAvatarRequest) (*UpdateGroupAvatarResponse, error) {
+	userID, err := getUserID(srv, ctx)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	senderDeviceID, err := getDeviceID(srv, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UnixNano() / 1000.0 // in microsecs
+	nowFloat := float64(now) / 1000000.0  // in secs
+
+	return in.UpdateGroupAvatar(srv, userID, senderDeviceID, nowFloat)
+}
+
+func (srv *Server) DisbandGroup(ctx context.Context, in *DisbandGroupRequest) (*DisbandGroupResponse, error) {
+	userID, err := getUserID(srv, ctx)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	senderDeviceID, err := getDeviceID(srv, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UnixNano() / 1000.0 // in microsecs
+	nowFloat := float64(now) / 1000000.0  // in secs
+
+	return in.DisbandGroup(srv, userID, senderDeviceID, nowFloat)
+}
+
+func (srv *Server) EditGroup(ctx context.Context, in *EditGroupRequest) (*EditGroupResponse, error) {
+	userID, err := getUserID(srv, ctx)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return in.EditGroup(srv, userID)
+}
+
+func (srv *Server) GetGroupInfo(ctx context.Context, in *GetGroupInfoRequest) (*GetGroupInfoResponse, error) {
+	userID, err := getUserID(srv, ctx)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return in.GetGroupInfo(srv, userID)
+}
+
+func (srv *Server) BanFromGroup(ctx context.Context, in *BanFromGroupRequest) (*BanFromGroupResponse, error) {
+	userID, err := getUserID(srv, ctx)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	if userID.String() == in.UserID {
+		err := errors.New("ban-from-group-cant-ban-self")
+		return nil, err
+	}
+
+	return in.BanFromGroup(srv, userID)
+}
+
+func (srv *Server) UnbanFromGroup(ctx context.Context, in *UnbanFromGroupRequest) (*UnbanFromGroupResponse, error) {
+	userID, err := getUserID(srv, ctx)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return in.UnbanFromGroup(srv, userID)
+}
+
+func (srv *Server) ListGroupBans(ctx context.Context, in *ListGroupBansRequest) (*ListGroupBansResponse, error) {
+	userID, err := getUserID(srv, ctx)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return in.ListGroupBans(srv, userID)
+}
+
+func (srv *Server) MuteGroupMember(ctx context.Context, in *MuteGroupMemberRequest) (*MuteGroupMemberResponse, error) {
+	userID, err := getUserID(srv, ctx)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	if userID.String() == in.UserID {
+		err := errors.New("mute-group-member-cant-mute-self")
+		return nil, err
+	}
+
+	return in.MuteGroupMember(srv, userID)
+}
+
+func (srv *Server) UnmuteGroupMember(ctx context.Context, in *UnmuteGroupMemberRequest) (*UnmuteGroupMemberResponse, error) {
+	userID, err := getUserID(srv, ctx)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return in.UnmuteGroupMember(srv, userID)
+}
+
+func (srv *Server) ListGroupMutes(ctx context.Context, in *ListGroupMutesRequest) (*ListGroupMutesResponse, error) {
+	userID, err := getUserID(srv, ctx)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return in.ListGroupMutes(srv, userID)
+}
+
+func (srv *Server) DeleteConversation(ctx context.Context, in *DeleteConversationRequest) (*DeleteConversationResponse, error) {
+	userID, err := getUserID(srv, ctx)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return in.DeleteConversation(srv, userID)
+}
+
 func (srv *Server) Echo(ctx context.Context, in *EchoRequest) (*EchoResponse, error) {
 	log.Println("Echo")
 	return &EchoResponse{
@@ -614,6 +1053,58 @@ func (srv *Server) PrepareMediaForGroup(userID uuid.UUID, groupID, mediaID strin
 	return updateGroupAvatar(srv, userID, groupID, mediaID, b.Bytes())
 }
 
+// Removes a media which has been moved to the group's bucket by PrepareMediaForGroup
+func (srv *Server) RemoveMediaFromGroup(groupID, mediaID string) error {
+	err := srv.minioClient.RemoveObject(groupID, mediaID)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	return removeGroupMedia(srv, groupID, mediaID)
+}
+
+// Removes all objects of a group and the group's bucket itself
+func (srv *Server) RemoveGroupBucket(groupID string) error {
+	return srv.removeBucket(groupID)
+}
+
+func (srv *Server) removeBucket(bucket string) error {
+	exists, err := srv.minioClient.BucketExists(bucket)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	if exists == false {
+		return nil
+	}
+
+	doneCh := make(chan struct{})
+	defer close(doneCh)
+
+	for object := range srv.minioClient.ListObjectsV2(bucket, "", true, doneCh) {
+		if object.Err != nil {
+			log.Println(object.Err)
+			return object.Err
+		}
+
+		err = srv.minioClient.RemoveObject(bucket, object.Key)
+		if err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+
+	err = srv.minioClient.RemoveBucket(bucket)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	return nil
+}
+
 func (srv *Server) GetProfilePicture(in *GetProfilePictureRequest, stream Ngobrel_GetProfilePictureServer) error {
 	userID, err := getUserID(srv, stream.Context())
 	if err != nil {
@@ -641,7 +1132,29 @@ func (srv *Server) RegisterFCM(ctx context.Context, in *RegisterFCMRequest) (*Re
 		return nil, err
 	}
 
-	return in.RegisterFCM(srv, userID)
+	deviceID, err := getDeviceID(srv, ctx)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return in.RegisterFCM(srv, userID, deviceID)
+}
+
+func (srv *Server) RegisterPushToken(ctx context.Context, in *RegisterPushTokenRequest) (*RegisterPushTokenResponse, error) {
+	userID, err := getUserID(srv, ctx)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	deviceID, err := getDeviceID(srv, ctx)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return in.RegisterPushToken(srv, userID, deviceID)
 }
 
 func (srv *Server) AckMessageNotificationStream(ctx context.Context, in *AckMessageNotificationStreamRequest) (*AckMessageNotificationStreamResponse, error) {
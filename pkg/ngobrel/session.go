@@ -0,0 +1,239 @@
+package ngobrel
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/go-redis/redis"
+	uuid "github.com/satori/go.uuid"
+)
+
+// Sessions are kept in redis:
+//
+//   DEV-<token>    the device ID of an authentication token
+//   UID-<token>    the user ID of an authentication token
+//   REF-<refresh>  a hash of the user ID, device ID and authentication token of a refresh token
+//   SES-<userID>   the set of refresh tokens of a user
+//   SCOPE-<token>  "pin" when the authentication token is restricted to VerifyPIN
+//
+// Authentication tokens expire after AccessTokenTTL, refresh tokens after RefreshTokenTTL.
+// Restricted tokens have no refresh token and expire after PINTokenTTL.
+
+type session struct {
+	token        string
+	refreshToken string
+	expiredAt    time.Time
+}
+
+func newRandomToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// Creates a new pair of tokens for a device
+func createSession(srv *Server, userID, deviceID string) (*session, error) {
+	token, err := newRandomToken()
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	refreshToken, err := newRandomToken()
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	_, err = srv.redisClient.TxPipelined(func(pipe redis.Pipeliner) error {
+		pipe.Set("DEV-"+token, deviceID, AccessTokenTTL)
+		pipe.Set("UID-"+token, userID, AccessTokenTTL)
+		pipe.HMSet("REF-"+refreshToken, map[string]interface{}{
+			"user":   userID,
+			"device": deviceID,
+			"token":  token,
+		})
+		pipe.Expire("REF-"+refreshToken, RefreshTokenTTL)
+		pipe.SAdd("SES-"+userID, refreshToken)
+		pipe.Expire("SES-"+userID, RefreshTokenTTL)
+		return nil
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return &session{
+		token:        token,
+		refreshToken: refreshToken,
+		expiredAt:    time.Now().Add(AccessTokenTTL),
+	}, nil
+}
+
+// Creates a token which can only be used to verify the PIN of the user
+func createRestrictedSession(srv *Server, userID, deviceID string) (*session, error) {
+	token, err := newRandomToken()
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	_, err = srv.redisClient.TxPipelined(func(pipe redis.Pipeliner) error {
+		pipe.Set("DEV-"+token, deviceID, PINTokenTTL)
+		pipe.Set("UID-"+token, userID, PINTokenTTL)
+		pipe.Set("SCOPE-"+token, "pin", PINTokenTTL)
+		return nil
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return &session{
+		token:     token,
+		expiredAt: time.Now().Add(PINTokenTTL),
+	}, nil
+}
+
+func revokeRestrictedSession(srv *Server, token string) error {
+	err := srv.redisClient.Del("DEV-"+token, "UID-"+token, "SCOPE-"+token).Err()
+	if err != nil {
+		log.Println(err)
+	}
+	return err
+}
+
+// Removes a refresh token and its authentication token
+func revokeSession(srv *Server, userID, refreshToken, token string) error {
+	_, err := srv.redisClient.TxPipelined(func(pipe redis.Pipeliner) error {
+		pipe.Del("DEV-"+token, "UID-"+token, "REF-"+refreshToken)
+		pipe.SRem("SES-"+userID, refreshToken)
+		return nil
+	})
+	if err != nil {
+		log.Println(err)
+	}
+	return err
+}
+
+// Revokes the sessions of a user, or only those of a device if deviceID is not empty
+func revokeSessions(srv *Server, userID, deviceID string) error {
+	refreshTokens, err := srv.redisClient.SMembers("SES-" + userID).Result()
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	for _, refreshToken := range refreshTokens {
+		data, err := srv.redisClient.HGetAll("REF-" + refreshToken).Result()
+		if err != nil {
+			log.Println(err)
+			return err
+		}
+
+		if deviceID != "" && data["device"] != "" && data["device"] != deviceID {
+			continue
+		}
+
+		err = revokeSession(srv, userID, refreshToken, data["token"])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Reads and removes a refresh token in one step, so it can only be used once
+// even when the same token is sent by concurrent requests
+func claimRefreshToken(srv *Server, refreshToken string) (map[string]string, error) {
+	var get *redis.StringStringMapCmd
+	var del *redis.IntCmd
+	_, err := srv.redisClient.TxPipelined(func(pipe redis.Pipeliner) error {
+		get = pipe.HGetAll("REF-" + refreshToken)
+		del = pipe.Del("REF-" + refreshToken)
+		return nil
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	data := get.Val()
+	if del.Val() != 1 || data["user"] == "" || data["device"] == "" {
+		err := errors.New("invalid-refresh-token")
+		log.Println(err)
+		return nil, err
+	}
+	return data, nil
+}
+
+func (req *RefreshTokenRequest) RefreshSession(srv *Server) (*RefreshTokenResponse, error) {
+	data, err := claimRefreshToken(srv, req.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	userID := data["user"]
+	deviceID := data["device"]
+
+	err = revokeSession(srv, userID, req.RefreshToken, data["token"])
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := srv.db.Query(`SELECT device_id FROM devices WHERE user_id=$1 AND device_id=$2 AND device_state=1`, userID, deviceID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	defer rows.Close()
+	var activeDeviceID string
+	for rows.Next() {
+		if err := rows.Scan(&activeDeviceID); err != nil {
+			log.Println(err)
+			return nil, err
+		}
+	}
+
+	if activeDeviceID != deviceID {
+		err := errors.New("invalid-refresh-token")
+		log.Println(err, "device is no longer active")
+		return nil, err
+	}
+
+	s, err := createSession(srv, userID, deviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RefreshTokenResponse{
+		Token:        s.token,
+		RefreshToken: s.refreshToken,
+		ExpiredAt:    s.expiredAt.UnixNano() / 1000000,
+	}, nil
+}
+
+func (req *LogoutRequest) Logout(srv *Server, userID uuid.UUID, deviceID uuid.UUID) (*LogoutResponse, error) {
+	err := revokeSessions(srv, userID.String(), deviceID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return &LogoutResponse{Success: true}, nil
+}
+
+func (req *LogoutAllDevicesRequest) LogoutAllDevices(srv *Server, userID uuid.UUID) (*LogoutAllDevicesResponse, error) {
+	err := revokeSessions(srv, userID.String(), "")
+	if err != nil {
+		return nil, err
+	}
+
+	return &LogoutAllDevicesResponse{Success: true}, nil
+}
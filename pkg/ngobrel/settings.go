@@ -1,6 +1,119 @@
 package ngobrel
 
+import "time"
+
+// The sender of SMS when the brand does not have one
 const SmsSender = "+18087311210"
-const SmsMessage = "Horas! Kode Horas Anda adalah [%s]"
-const DebugMode = true
 
+// The lifetime of an authentication token
+const AccessTokenTTL = 1 * time.Hour
+
+// The lifetime of a refresh token
+const RefreshTokenTTL = 60 * 24 * time.Hour
+
+// The length of an OTP unless OTP_LENGTH is set
+const DefaultOTPLength = 6
+const MinOTPLength = 4
+const MaxOTPLength = 10
+
+// The lifetime of an OTP
+const OTPTTL = 10 * time.Minute
+
+// The number of OTPs which can be sent to a phone number in an hour
+const OTPSendsPerPhonePerHour = 5
+
+// The number of OTPs which can be requested from an IP address in an hour
+const OTPSendsPerIPPerHour = 20
+
+// The number of SMS which can be sent in a day unless SMS_DAILY_BUDGET is set
+const DefaultSmsDailyBudget = 10000
+
+// The number of failed verifications of a phone number before it is locked out
+const OTPVerifyAttempts = 5
+
+// The number of failed PIN verifications of a user before it is locked out
+const PINVerifyAttempts = 5
+
+// The first lockout lasts LockoutBase, each following one twice as long up to LockoutMax
+const LockoutBase = 1 * time.Minute
+const LockoutMax = 24 * time.Hour
+
+// The lifetime of a device link code
+const DeviceLinkTTL = 5 * time.Minute
+
+// The number of device links which can be requested from an IP address in an hour
+const DeviceLinksPerIPPerHour = 20
+
+// How often the last activity of a device is recorded
+const DeviceActivityInterval = 5 * time.Minute
+
+// The accepted number of digits of a PIN
+const MinPINLength = 4
+const MaxPINLength = 12
+
+// The lifetime of the restricted token given by VerifyOTP when the account has a PIN
+const PINTokenTTL = 10 * time.Minute
+
+// How often a user is reminded to enter the PIN, so it is not forgotten
+const PINReminderInterval = 7 * 24 * time.Hour
+
+// The region of phone numbers given without a country code, unless PHONE_DEFAULT_REGION is set
+const DefaultPhoneRegion = "ID"
+
+// How often failed account deletions are retried
+const AccountDeletionRetryInterval = 1 * time.Minute
+
+// How long a server may work on an account deletion before another one takes it over
+const AccountDeletionLockTTL = 10 * time.Minute
+
+// How often a user can request a data export
+const DataExportInterval = 24 * time.Hour
+
+// A data export still pending after this long is considered failed
+const DataExportTimeout = 1 * time.Hour
+
+// The number of consecutive failures after which an SMS provider is skipped for SmsBreakerCooldown
+const SmsBreakerFailures = 3
+const SmsBreakerCooldown = 1 * time.Minute
+
+// The number of goroutines sending queued SMS, unless SMS_WORKERS is set
+const DefaultSmsWorkers = 4
+
+// How often the SMS workers look for due messages when nothing wakes them up
+const SmsQueuePollInterval = 5 * time.Second
+
+// A queued SMS is tried SmsMaxAttempts times, waiting SmsRetryBase after the first failure
+// and twice as long after each following one, up to SmsRetryMax
+const SmsMaxAttempts = 5
+const SmsRetryBase = 10 * time.Second
+const SmsRetryMax = 5 * time.Minute
+
+// A message still being sent after this long is considered lost and sent again
+const SmsSendingTimeout = 2 * time.Minute
+
+// How long to wait for an OTP to be used before sending it over the next channel
+const OTPChannelFallbackTimeout = 1 * time.Minute
+
+// A notification is tried PushMaxAttempts times when the push provider is over quota or failing, waiting
+// PushRetryBase after the first failure and twice as long after each following one, up to PushRetryMax
+const PushMaxAttempts = 4
+const PushRetryBase = 1 * time.Second
+const PushRetryMax = 30 * time.Second
+
+// The number of goroutines sending notifications, unless PUSH_WORKERS is set
+const DefaultPushWorkers = 8
+
+// The number of notifications waiting to be sent, unless PUSH_QUEUE_SIZE is set
+const DefaultPushQueueSize = 1000
+
+// How long a sender waits for room in a full notification queue before dropping the notification
+const PushEnqueueTimeout = 100 * time.Millisecond
+
+// How long the queued notifications are given to be sent on shutdown
+const PushDrainTimeout = 30 * time.Second
+
+// How long APNs and Web Push keep a notification for a device which is offline
+const PushTTL = 24 * time.Hour
+
+// How often the APNs provider token is renewed, APNs accepts tokens from 20 to 60 minutes old
+const APNsTokenRefresh = 50 * time.Minute
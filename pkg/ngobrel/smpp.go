@@ -0,0 +1,302 @@
+package ngobrel
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"log"
+	"net"
+	"strconv"
+	"time"
+	"unicode/utf16"
+)
+
+// SmppSms sends through an SMSC with SMPP 3.4. Every message uses its own connection:
+// bind as a transmitter, submit_sm, unbind. Delivery receipts would come on a receiver
+// session, which is never kept open, so none are asked for. It is set up with SetValue:
+//
+//	addr       the host:port of the SMSC, required
+//	systemType the system_type of the bind
+//	sourceTON  the TON of the sender. By default 1 (international) for a sender
+//	           starting with "+", and 5 (alphanumeric) otherwise
+//	sourceNPI  the NPI of the sender. By default 1 (E.164) for a sender starting
+//	           with "+", and 0 otherwise
+//	destTON    the TON of the recipient, 1 (international) by default
+//	destNPI    the NPI of the recipient, 1 (E.164) by default
+//
+// Messages which are not ASCII are sent as UCS-2.
+type SmppSms struct {
+	systemID   string
+	password   string
+	addr       string
+	systemType string
+	sourceTON  byte
+	sourceNPI  byte
+	destTON    byte
+	destNPI    byte
+
+	// The TON and NPI of the sender are guessed from it, until either is set
+	sourceGuessed bool
+}
+
+const (
+	smppBindTransmitter = 0x00000002
+	smppSubmitSM        = 0x00000004
+	smppUnbind          = 0x00000006
+	smppEnquireLink     = 0x00000015
+	smppGenericNack     = 0x80000000
+	smppResponse        = 0x80000000
+
+	smppMessagePayload = 0x0424
+	smppMaxShortLength = 254
+	smppTimeout        = 30 * time.Second
+)
+
+type smppPDU struct {
+	commandID uint32
+	status    uint32
+	sequence  uint32
+	body      []byte
+}
+
+func NewSmppSms() *SmppSms {
+	return &SmppSms{
+		destTON:       1,
+		destNPI:       1,
+		sourceGuessed: true,
+	}
+}
+
+func (t *SmppSms) SetAccount(userID string, tokenID string) error {
+	t.systemID = userID
+	t.password = tokenID
+	return nil
+}
+
+func (t *SmppSms) SetValue(key string, value string) error {
+	switch key {
+	case "addr":
+		t.addr = value
+	case "systemType":
+		t.systemType = value
+	case "sourceTON", "sourceNPI", "destTON", "destNPI":
+		n, err := strconv.ParseUint(value, 10, 8)
+		if err != nil {
+			return err
+		}
+		switch key {
+		case "sourceTON":
+			t.sourceTON = byte(n)
+			t.sourceGuessed = false
+		case "sourceNPI":
+			t.sourceNPI = byte(n)
+			t.sourceGuessed = false
+		case "destTON":
+			t.destTON = byte(n)
+		case "destNPI":
+			t.destNPI = byte(n)
+		}
+	}
+	return nil
+}
+
+func writeCString(b *bytes.Buffer, s string) {
+	b.WriteString(s)
+	b.WriteByte(0)
+}
+
+func readCString(b []byte) string {
+	if i := bytes.IndexByte(b, 0); i >= 0 {
+		return string(b[:i])
+	}
+	return string(b)
+}
+
+func writePDU(conn net.Conn, pdu *smppPDU) error {
+	header := make([]byte, 16)
+	binary.BigEndian.PutUint32(header[0:], uint32(16+len(pdu.body)))
+	binary.BigEndian.PutUint32(header[4:], pdu.commandID)
+	binary.BigEndian.PutUint32(header[8:], pdu.status)
+	binary.BigEndian.PutUint32(header[12:], pdu.sequence)
+
+	_, err := conn.Write(append(header, pdu.body...))
+	return err
+}
+
+func readPDU(conn net.Conn) (*smppPDU, error) {
+	header := make([]byte, 16)
+	if _, err := io.ReadFull(conn, header); err != nil {
+		return nil, err
+	}
+
+	length := binary.BigEndian.Uint32(header[0:])
+	if length < 16 || length > 64*1024 {
+		return nil, errors.New("smpp-invalid-pdu-length")
+	}
+
+	pdu := &smppPDU{
+		commandID: binary.BigEndian.Uint32(header[4:]),
+		status:    binary.BigEndian.Uint32(header[8:]),
+		sequence:  binary.BigEndian.Uint32(header[12:]),
+		body:      make([]byte, length-16),
+	}
+	if _, err := io.ReadFull(conn, pdu.body); err != nil {
+		return nil, err
+	}
+	return pdu, nil
+}
+
+// Sends a request and waits for its response, answering enquire_link in the meantime
+func smppCall(conn net.Conn, pdu *smppPDU) (*smppPDU, error) {
+	if err := writePDU(conn, pdu); err != nil {
+		return nil, err
+	}
+
+	for {
+		resp, err := readPDU(conn)
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.commandID == smppEnquireLink {
+			err := writePDU(conn, &smppPDU{commandID: smppEnquireLink | smppResponse, sequence: resp.sequence})
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if resp.commandID == smppGenericNack {
+			return nil, errors.New("smpp-generic-nack-" + strconv.FormatUint(uint64(resp.status), 16))
+		}
+
+		if resp.commandID != pdu.commandID|smppResponse || resp.sequence != pdu.sequence {
+			continue
+		}
+
+		if resp.status != 0 {
+			return nil, errors.New("smpp-error-" + strconv.FormatUint(uint64(resp.status), 16))
+		}
+		return resp, nil
+	}
+}
+
+func isASCII(s string) bool {
+	for _, c := range s {
+		if c > 127 {
+			return false
+		}
+	}
+	return true
+}
+
+func (t *SmppSms) submitSM(from, to, message string) []byte {
+	var dataCoding byte
+	var shortMessage []byte
+	if isASCII(message) {
+		shortMessage = []byte(message)
+	} else {
+		dataCoding = 0x08 // UCS-2
+		for _, u := range utf16.Encode([]rune(message)) {
+			shortMessage = append(shortMessage, byte(u>>8), byte(u))
+		}
+	}
+
+	sourceTON, sourceNPI := t.sourceTON, t.sourceNPI
+	if t.sourceGuessed {
+		sourceTON, sourceNPI = 5, 0
+		if len(from) > 0 && from[0] == '+' {
+			sourceTON, sourceNPI = 1, 1
+		}
+	}
+	if sourceTON == 1 && len(from) > 0 && from[0] == '+' {
+		from = from[1:]
+	}
+
+	var b bytes.Buffer
+	writeCString(&b, "") // service_type
+	b.WriteByte(sourceTON)
+	b.WriteByte(sourceNPI)
+	writeCString(&b, from)
+	b.WriteByte(t.destTON)
+	b.WriteByte(t.destNPI)
+	if t.destTON == 1 && len(to) > 0 && to[0] == '+' {
+		to = to[1:]
+	}
+	writeCString(&b, to)
+	b.WriteByte(0)       // esm_class
+	b.WriteByte(0)       // protocol_id
+	b.WriteByte(0)       // priority_flag
+	writeCString(&b, "") // schedule_delivery_time
+	writeCString(&b, "") // validity_period
+	b.WriteByte(0)       // registered_delivery
+	b.WriteByte(0)       // replace_if_present_flag
+	b.WriteByte(dataCoding)
+	b.WriteByte(0) // sm_default_msg_id
+
+	// Long messages go in the message_payload TLV instead of short_message
+	if len(shortMessage) <= smppMaxShortLength {
+		b.WriteByte(byte(len(shortMessage)))
+		b.Write(shortMessage)
+	} else {
+		b.WriteByte(0)
+		tlv := make([]byte, 4)
+		binary.BigEndian.PutUint16(tlv[0:], smppMessagePayload)
+		binary.BigEndian.PutUint16(tlv[2:], uint16(len(shortMessage)))
+		b.Write(tlv)
+		b.Write(shortMessage)
+	}
+
+	return b.Bytes()
+}
+
+func (t *SmppSms) SendMessage(from string, to string, message string) (string, error) {
+	if t.addr == "" || t.systemID == "" {
+		err := errors.New("smpp-account-not-yet-setup")
+		log.Println(err)
+		return "", err
+	}
+
+	conn, err := net.DialTimeout("tcp", t.addr, smppTimeout)
+	if err != nil {
+		log.Println("Error connecting to SMSC", err)
+		return "", err
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(smppTimeout))
+
+	return t.send(conn, from, to, message)
+}
+
+// Binds, submits the message and unbinds on an open connection to the SMSC
+func (t *SmppSms) send(conn net.Conn, from string, to string, message string) (string, error) {
+	var bind bytes.Buffer
+	writeCString(&bind, t.systemID)
+	writeCString(&bind, t.password)
+	writeCString(&bind, t.systemType)
+	bind.WriteByte(0x34) // interface_version 3.4
+	bind.WriteByte(0)    // addr_ton
+	bind.WriteByte(0)    // addr_npi
+	writeCString(&bind, "")
+
+	_, err := smppCall(conn, &smppPDU{commandID: smppBindTransmitter, sequence: 1, body: bind.Bytes()})
+	if err != nil {
+		log.Println("SMPP bind failed", err)
+		return "", err
+	}
+
+	resp, err := smppCall(conn, &smppPDU{commandID: smppSubmitSM, sequence: 2, body: t.submitSM(from, to, message)})
+	if err != nil {
+		log.Println("SMPP submit_sm failed", err)
+		return "", err
+	}
+	messageID := readCString(resp.body)
+
+	// The message is accepted, a failing unbind does not matter
+	if _, err := smppCall(conn, &smppPDU{commandID: smppUnbind, sequence: 3}); err != nil {
+		log.Println("SMPP unbind failed", err)
+	}
+
+	return messageID, nil
+}
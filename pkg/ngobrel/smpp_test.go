@@ -0,0 +1,195 @@
+package ngobrel
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net"
+	"testing"
+	"unicode/utf16"
+)
+
+// The fields of a submit_sm body which the tests look at
+type smppSubmit struct {
+	sourceTON          byte
+	sourceNPI          byte
+	source             string
+	destTON            byte
+	destNPI            byte
+	dest               string
+	registeredDelivery byte
+	dataCoding         byte
+	message            []byte
+}
+
+func readCStringAt(b []byte, i int) (string, int) {
+	end := bytes.IndexByte(b[i:], 0)
+	return string(b[i : i+end]), i + end + 1
+}
+
+func parseSubmitSM(t *testing.T, b []byte) *smppSubmit {
+	s := &smppSubmit{}
+	_, i := readCStringAt(b, 0) // service_type
+	s.sourceTON, s.sourceNPI = b[i], b[i+1]
+	s.source, i = readCStringAt(b, i+2)
+	s.destTON, s.destNPI = b[i], b[i+1]
+	s.dest, i = readCStringAt(b, i+2)
+	i += 3                     // esm_class, protocol_id, priority_flag
+	_, i = readCStringAt(b, i) // schedule_delivery_time
+	_, i = readCStringAt(b, i) // validity_period
+	s.registeredDelivery = b[i]
+	s.dataCoding = b[i+2]
+	length := int(b[i+4])
+	i += 5
+	if length > 0 {
+		s.message = b[i : i+length]
+		return s
+	}
+
+	if len(b) < i+4 || binary.BigEndian.Uint16(b[i:]) != smppMessagePayload {
+		t.Fatalf("no message_payload in an empty short_message")
+	}
+	payloadLength := int(binary.BigEndian.Uint16(b[i+2:]))
+	s.message = b[i+4 : i+4+payloadLength]
+	return s
+}
+
+// A fake SMSC on the other end of a net.Pipe. It answers bind, submit_sm and unbind,
+// sending an enquire_link before the submit_sm response, and hands over what was submitted.
+func fakeSmsc(t *testing.T, conn net.Conn, messageID string, submitted chan<- []byte) {
+	defer conn.Close()
+	for {
+		pdu, err := readPDU(conn)
+		if err != nil {
+			return
+		}
+
+		var body []byte
+		switch pdu.commandID {
+		case smppBindTransmitter:
+			body = []byte("fake-smsc\x00")
+		case smppSubmitSM:
+			submitted <- pdu.body
+			if err := writePDU(conn, &smppPDU{commandID: smppEnquireLink, sequence: 100}); err != nil {
+				t.Error(err)
+				return
+			}
+			link, err := readPDU(conn)
+			if err != nil || link.commandID != smppEnquireLink|smppResponse || link.sequence != 100 {
+				t.Errorf("enquire_link not answered: %v %v", link, err)
+				return
+			}
+			body = []byte(messageID + "\x00")
+		case smppUnbind:
+		case smppEnquireLink | smppResponse:
+			continue
+		default:
+			t.Errorf("unexpected command %x", pdu.commandID)
+			return
+		}
+
+		resp := &smppPDU{commandID: pdu.commandID | smppResponse, sequence: pdu.sequence, body: body}
+		if err := writePDU(conn, resp); err != nil {
+			return
+		}
+	}
+}
+
+func sendThroughFakeSmsc(t *testing.T, sms *SmppSms, from string, to string, message string) (string, *smppSubmit) {
+	client, server := net.Pipe()
+	defer client.Close()
+
+	submitted := make(chan []byte, 1)
+	go fakeSmsc(t, server, "msg-1", submitted)
+
+	messageID, err := sms.send(client, from, to, message)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return messageID, parseSubmitSM(t, <-submitted)
+}
+
+func TestSmppSend(t *testing.T) {
+	sms := NewSmppSms()
+	messageID, s := sendThroughFakeSmsc(t, sms, "Ngobrel", "+6281234567", "Your code is 1234")
+
+	if messageID != "msg-1" {
+		t.Errorf("message ID: got %q", messageID)
+	}
+	if s.sourceTON != 5 || s.sourceNPI != 0 || s.source != "Ngobrel" {
+		t.Errorf("alphanumeric sender: got %d/%d %q", s.sourceTON, s.sourceNPI, s.source)
+	}
+	if s.destTON != 1 || s.destNPI != 1 || s.dest != "6281234567" {
+		t.Errorf("recipient: got %d/%d %q", s.destTON, s.destNPI, s.dest)
+	}
+	if s.registeredDelivery != 0 {
+		t.Errorf("registered_delivery: got %d", s.registeredDelivery)
+	}
+	if s.dataCoding != 0 || string(s.message) != "Your code is 1234" {
+		t.Errorf("message: got %d %q", s.dataCoding, s.message)
+	}
+}
+
+func TestSmppSourceTON(t *testing.T) {
+	_, s := sendThroughFakeSmsc(t, NewSmppSms(), "+6281111", "+6281234567", "hi")
+	if s.sourceTON != 1 || s.sourceNPI != 1 || s.source != "6281111" {
+		t.Errorf("international sender: got %d/%d %q", s.sourceTON, s.sourceNPI, s.source)
+	}
+
+	sms := NewSmppSms()
+	sms.SetValue("sourceTON", "2")
+	_, s = sendThroughFakeSmsc(t, sms, "+6281111", "+6281234567", "hi")
+	if s.sourceTON != 2 || s.sourceNPI != 0 || s.source != "+6281111" {
+		t.Errorf("configured sender: got %d/%d %q", s.sourceTON, s.sourceNPI, s.source)
+	}
+}
+
+func TestSmppUCS2(t *testing.T) {
+	message := "Kode Anda 1234 — jangan dibagikan"
+	_, s := sendThroughFakeSmsc(t, NewSmppSms(), "Ngobrel", "+6281234567", message)
+
+	if s.dataCoding != 0x08 {
+		t.Fatalf("data_coding: got %d", s.dataCoding)
+	}
+	units := make([]uint16, len(s.message)/2)
+	for i := range units {
+		units[i] = binary.BigEndian.Uint16(s.message[2*i:])
+	}
+	if got := string(utf16.Decode(units)); got != message {
+		t.Errorf("message: got %q", got)
+	}
+}
+
+func TestSmppMessagePayload(t *testing.T) {
+	message := string(bytes.Repeat([]byte("a"), smppMaxShortLength+1))
+	_, s := sendThroughFakeSmsc(t, NewSmppSms(), "Ngobrel", "+6281234567", message)
+	if string(s.message) != message {
+		t.Errorf("message_payload: got %d bytes", len(s.message))
+	}
+}
+
+func TestSmppErrors(t *testing.T) {
+	client, server := net.Pipe()
+	go func() {
+		pdu, err := readPDU(server)
+		if err != nil {
+			return
+		}
+		writePDU(server, &smppPDU{commandID: smppBindTransmitter | smppResponse, status: 0x0e, sequence: pdu.sequence})
+	}()
+	_, err := NewSmppSms().send(client, "Ngobrel", "+6281234567", "hi")
+	if err == nil || err.Error() != "smpp-error-e" {
+		t.Errorf("bind error: got %v", err)
+	}
+	client.Close()
+
+	client, server = net.Pipe()
+	go func() {
+		header := make([]byte, 16)
+		binary.BigEndian.PutUint32(header, 8)
+		server.Write(header)
+	}()
+	if _, err := readPDU(client); err == nil || err.Error() != "smpp-invalid-pdu-length" {
+		t.Errorf("short PDU: got %v", err)
+	}
+	client.Close()
+}
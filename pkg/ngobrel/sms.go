@@ -12,19 +12,22 @@ import (
 
 type Sms interface {
 	SetAccount(userID string, tokenID string) error
-	SendMessage(from string, to string, message string) error
+	// Sends a message and returns the message ID given by the provider, if any
+	SendMessage(from string, to string, message string) (string, error)
 	SetValue(key string, value string) error
 }
 
 type TwilioSms struct {
 	userID  string
 	tokenID string
+	baseURL string
 }
 
 type ZenzivaSms struct {
 	userKey   string
 	passKey   string
 	subdomain string
+	baseURL   string
 }
 
 type DummySms struct {
@@ -39,8 +42,8 @@ func (t *DummySms) SetAccount(userID string, tokenID string) error {
 	return nil
 }
 
-func (t *DummySms) SendMessage(from string, to string, message string) error {
-	return nil
+func (t *DummySms) SendMessage(from string, to string, message string) (string, error) {
+	return "", nil
 }
 
 func (t *DummySms) SetValue(key string, value string) error {
@@ -48,7 +51,9 @@ func (t *DummySms) SetValue(key string, value string) error {
 }
 
 func NewTwilioSms() *TwilioSms {
-	t := &TwilioSms{}
+	t := &TwilioSms{
+		baseURL: "https://api.twilio.com",
+	}
 
 	return t
 }
@@ -60,15 +65,15 @@ func (t *TwilioSms) SetAccount(userID string, tokenID string) error {
 	return nil
 }
 
-func (t *TwilioSms) SendMessage(from string, to string, message string) error {
+func (t *TwilioSms) SendMessage(from string, to string, message string) (string, error) {
 
 	if t.userID == "" || t.tokenID == "" {
 		err := errors.New("twilio-account-not-yet-setup")
 		log.Println(err)
-		return err
+		return "", err
 	}
 
-	urlStr := "https://api.twilio.com/2010-04-01/Accounts/" + t.userID + "/Messages.json"
+	urlStr := t.baseURL + "/2010-04-01/Accounts/" + t.userID + "/Messages.json"
 
 	msgData := url.Values{}
 	msgData.Set("To", to)
@@ -83,36 +88,43 @@ func (t *TwilioSms) SendMessage(from string, to string, message string) error {
 	req.Header.Add("Accept", "application/json")
 	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
 
+	var messageID string
 	resp, err := client.Do(req)
 	if err != nil {
 		log.Println("Error connecting to Twilio")
 		log.Println(err)
-		return err
+		return "", err
 	}
+	defer resp.Body.Close()
 	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
 		var data map[string]interface{}
 		decoder := json.NewDecoder(resp.Body)
 		err := decoder.Decode(&data)
 		if err != nil {
 			log.Println(err)
-			return err
+			return "", err
 		}
 		log.Println(data["sid"])
+		messageID, _ = data["sid"].(string)
 	} else {
 		log.Println(resp)
 		err := errors.New("twilio-unable-to-send-sms")
 		log.Println(err)
-		return err
+		return "", err
 	}
 	if err != nil {
 		log.Println(err)
-		return err
+		return "", err
 	}
 
-	return nil
+	return messageID, nil
 }
 
+// "url" replaces https://api.twilio.com, e.g. with a local stand-in
 func (t *TwilioSms) SetValue(key string, value string) error {
+	if key == "url" {
+		t.baseURL = strings.TrimSuffix(value, "/")
+	}
 	return nil
 }
 
@@ -129,22 +141,30 @@ func (t *ZenzivaSms) SetAccount(userID string, tokenID string) error {
 	return nil
 }
 
+// "url" replaces http://<subdomain>, e.g. with a local stand-in
 func (t *ZenzivaSms) SetValue(key string, value string) error {
 	if key == "subdomain" {
 		t.subdomain = value
 	}
+	if key == "url" {
+		t.baseURL = strings.TrimSuffix(value, "/")
+	}
 	return nil
 }
 
-func (t *ZenzivaSms) SendMessage(from string, to string, message string) error {
+func (t *ZenzivaSms) SendMessage(from string, to string, message string) (string, error) {
 
 	if t.userKey == "" || t.passKey == "" {
 		err := errors.New("zenziva-account-not-yet-setup")
 		log.Println(err)
-		return err
+		return "", err
 	}
 
-	urlStr := "http://" + t.subdomain + "/api/sendsms/"
+	baseURL := t.baseURL
+	if baseURL == "" {
+		baseURL = "http://" + t.subdomain
+	}
+	urlStr := baseURL + "/api/sendsms/"
 
 	msgData := url.Values{}
 
@@ -161,31 +181,34 @@ func (t *ZenzivaSms) SendMessage(from string, to string, message string) error {
 	req.Header.Add("Accept", "application/json")
 	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
 
+	var messageID string
 	resp, err := client.Do(req)
 	if err != nil {
 		log.Println("Error connecting to Zenziva")
 		log.Println(err)
-		return err
+		return "", err
 	}
+	defer resp.Body.Close()
 	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
 		var data map[string]interface{}
 		decoder := json.NewDecoder(resp.Body)
 		err := decoder.Decode(&data)
 		if err != nil {
 			log.Println(err)
-			return err
+			return "", err
 		}
-		log.Println(data["sid"])
+		log.Println(data["messageId"])
+		messageID, _ = data["messageId"].(string)
 	} else {
 		log.Println(resp)
 		err := errors.New("zenziva-unable-to-send-sms")
 		log.Println(err)
-		return err
+		return "", err
 	}
 	if err != nil {
 		log.Println(err)
-		return err
+		return "", err
 	}
 
-	return nil
+	return messageID, nil
 }
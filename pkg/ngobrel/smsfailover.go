@@ -0,0 +1,153 @@
+package ngobrel
+
+import (
+	"errors"
+	"log"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FailoverSms sends through the first healthy provider which can reach the destination,
+// falling back to the next one when sending fails.
+//
+// Providers with prefixes, e.g. "+62", are tried first for numbers starting with one of them,
+// followed by the providers without prefixes, in the order they are added. A provider which
+// fails SmsBreakerFailures times in a row is skipped for SmsBreakerCooldown, unless there is
+// no other provider left to try.
+type FailoverSms struct {
+	mutex     sync.Mutex
+	providers []*smsProvider
+	now       func() time.Time
+}
+
+type smsProvider struct {
+	name      string
+	sms       Sms
+	prefixes  []string
+	failures  int
+	openUntil time.Time
+}
+
+func NewFailoverSms() *FailoverSms {
+	return &FailoverSms{
+		now: time.Now,
+	}
+}
+
+// Adds a provider, only used for phone numbers starting with one of the prefixes if there are any
+func (t *FailoverSms) AddProvider(name string, sms Sms, prefixes ...string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.providers = append(t.providers, &smsProvider{
+		name:     name,
+		sms:      sms,
+		prefixes: prefixes,
+	})
+}
+
+// Accounts are set on each provider when it is added
+func (t *FailoverSms) SetAccount(userID string, tokenID string) error {
+	return nil
+}
+
+// "<provider>.<key>" sets a value of the provider
+func (t *FailoverSms) SetValue(key string, value string) error {
+	parts := strings.SplitN(key, ".", 2)
+	if len(parts) != 2 {
+		return nil
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	for _, p := range t.providers {
+		if p.name == parts[0] {
+			return p.sms.SetValue(parts[1], value)
+		}
+	}
+	return errors.New("sms-provider-not-found")
+}
+
+func (p *smsProvider) routes(to string) bool {
+	for _, prefix := range p.prefixes {
+		if strings.HasPrefix(to, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// The providers for a phone number, healthy ones first
+func (t *FailoverSms) candidates(to string) []*smsProvider {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	var routed, fallback []*smsProvider
+	for _, p := range t.providers {
+		if len(p.prefixes) == 0 {
+			fallback = append(fallback, p)
+		} else if p.routes(to) {
+			routed = append(routed, p)
+		}
+	}
+
+	now := t.now()
+	var healthy, broken []*smsProvider
+	for _, p := range append(routed, fallback...) {
+		if now.Before(p.openUntil) {
+			broken = append(broken, p)
+		} else {
+			healthy = append(healthy, p)
+		}
+	}
+
+	return append(healthy, broken...)
+}
+
+func (t *FailoverSms) succeeded(p *smsProvider) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if p.failures >= SmsBreakerFailures {
+		log.Println("SMS provider", p.name, "is healthy again")
+	}
+	p.failures = 0
+	p.openUntil = time.Time{}
+}
+
+func (t *FailoverSms) failed(p *smsProvider) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	p.failures++
+	if p.failures >= SmsBreakerFailures {
+		log.Println("SMS provider", p.name, "failed", p.failures, "times, skipping it for", SmsBreakerCooldown)
+		p.openUntil = t.now().Add(SmsBreakerCooldown)
+	}
+}
+
+func (t *FailoverSms) SendMessage(from string, to string, message string) (string, error) {
+	candidates := t.candidates(to)
+	if len(candidates) == 0 {
+		err := errors.New("no-sms-provider-for-destination")
+		log.Println(err, to)
+		return "", err
+	}
+
+	var err error
+	for _, p := range candidates {
+		var messageID string
+		messageID, err = p.sms.SendMessage(from, to, message)
+		if err == nil {
+			t.succeeded(p)
+			return messageID, nil
+		}
+
+		log.Println("SMS provider", p.name, "failed:", err)
+		t.failed(p)
+	}
+
+	return "", err
+}
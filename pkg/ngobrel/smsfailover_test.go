@@ -0,0 +1,209 @@
+package ngobrel
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// A local stand-in for Twilio or Zenziva, answering with the JSON each of them returns
+type fakeSmsProvider struct {
+	*fakeProvider
+	ok fakeResponse
+}
+
+func newFakeSmsProvider(idField string, id string) *fakeSmsProvider {
+	ok := fakeResponse{
+		header: map[string]string{"Content-Type": "application/json"},
+		body:   fmt.Sprintf(`{"%s": "%s"}`, idField, id),
+	}
+	return &fakeSmsProvider{
+		fakeProvider: newFakeProvider(func(r *http.Request, body []byte) string { return "" }, ok),
+		ok:           ok,
+	}
+}
+
+func (f *fakeSmsProvider) setFailing(failing bool) {
+	if failing {
+		f.setFallback(fakeResponse{statusCode: http.StatusInternalServerError})
+	} else {
+		f.setFallback(f.ok)
+	}
+}
+
+func (f *fakeSmsProvider) hitCount() int {
+	return len(f.requestsOf(""))
+}
+
+func newTestTwilio(f *fakeSmsProvider) Sms {
+	twilio := NewTwilioSms()
+	twilio.SetAccount("AC123", "token")
+	twilio.SetValue("url", f.URL)
+	return twilio
+}
+
+func newTestZenziva(f *fakeSmsProvider) Sms {
+	zenziva := NewZenzivaSms()
+	zenziva.SetAccount("user", "pass")
+	zenziva.SetValue("url", f.URL)
+	return zenziva
+}
+
+func TestFailoverSmsOrderedFailover(t *testing.T) {
+	twilio := newFakeSmsProvider("sid", "SM1")
+	defer twilio.Close()
+	zenziva := newFakeSmsProvider("messageId", "Z1")
+	defer zenziva.Close()
+
+	sms := NewFailoverSms()
+	sms.AddProvider("twilio", newTestTwilio(twilio))
+	sms.AddProvider("zenziva", newTestZenziva(zenziva))
+
+	id, err := sms.SendMessage(SmsSender, "+6281234567890", "hello")
+	if err != nil || id != "SM1" {
+		t.Fatalf("got %q, %v, expected the first provider to send", id, err)
+	}
+	if twilio.hitCount() != 1 || zenziva.hitCount() != 0 {
+		t.Fatalf("hits %d, %d, expected only the first provider", twilio.hitCount(), zenziva.hitCount())
+	}
+
+	twilio.setFailing(true)
+	id, err = sms.SendMessage(SmsSender, "+6281234567890", "hello")
+	if err != nil || id != "Z1" {
+		t.Fatalf("got %q, %v, expected the second provider to send", id, err)
+	}
+	if twilio.hitCount() != 2 || zenziva.hitCount() != 1 {
+		t.Fatalf("hits %d, %d, expected the first provider to be tried first", twilio.hitCount(), zenziva.hitCount())
+	}
+
+	zenziva.setFailing(true)
+	_, err = sms.SendMessage(SmsSender, "+6281234567890", "hello")
+	if err == nil {
+		t.Fatal("expected an error when every provider fails")
+	}
+}
+
+func TestFailoverSmsPrefixRouting(t *testing.T) {
+	twilio := newFakeSmsProvider("sid", "SM1")
+	defer twilio.Close()
+	zenziva := newFakeSmsProvider("messageId", "Z1")
+	defer zenziva.Close()
+
+	sms := NewFailoverSms()
+	sms.AddProvider("twilio", newTestTwilio(twilio))
+	sms.AddProvider("zenziva", newTestZenziva(zenziva), "+62")
+
+	id, err := sms.SendMessage(SmsSender, "+6281234567890", "hello")
+	if err != nil || id != "Z1" {
+		t.Fatalf("got %q, %v, expected the provider of +62 to send", id, err)
+	}
+
+	id, err = sms.SendMessage(SmsSender, "+14155550100", "hello")
+	if err != nil || id != "SM1" {
+		t.Fatalf("got %q, %v, expected the provider without prefixes to send", id, err)
+	}
+
+	// A provider with prefixes never sends to other numbers
+	twilio.setFailing(true)
+	_, err = sms.SendMessage(SmsSender, "+14155550100", "hello")
+	if err == nil {
+		t.Fatal("expected an error when the only provider for the number fails")
+	}
+	if zenziva.hitCount() != 1 {
+		t.Fatalf("the provider of +62 was used %d times, expected once", zenziva.hitCount())
+	}
+}
+
+func TestFailoverSmsBreaker(t *testing.T) {
+	twilio := newFakeSmsProvider("sid", "SM1")
+	defer twilio.Close()
+	zenziva := newFakeSmsProvider("messageId", "Z1")
+	defer zenziva.Close()
+
+	now := time.Now()
+	sms := NewFailoverSms()
+	sms.now = func() time.Time { return now }
+	sms.AddProvider("twilio", newTestTwilio(twilio))
+	sms.AddProvider("zenziva", newTestZenziva(zenziva))
+
+	twilio.setFailing(true)
+	for i := 0; i < SmsBreakerFailures; i++ {
+		if _, err := sms.SendMessage(SmsSender, "+6281234567890", "hello"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if twilio.hitCount() != SmsBreakerFailures {
+		t.Fatalf("the failing provider was tried %d times, expected %d", twilio.hitCount(), SmsBreakerFailures)
+	}
+
+	// The breaker is open, the failing provider is skipped
+	id, err := sms.SendMessage(SmsSender, "+6281234567890", "hello")
+	if err != nil || id != "Z1" {
+		t.Fatalf("got %q, %v", id, err)
+	}
+	if twilio.hitCount() != SmsBreakerFailures {
+		t.Fatal("the provider was tried while its breaker is open")
+	}
+
+	// Still open just before the cooldown ends
+	now = now.Add(SmsBreakerCooldown - time.Second)
+	sms.SendMessage(SmsSender, "+6281234567890", "hello")
+	if twilio.hitCount() != SmsBreakerFailures {
+		t.Fatal("the provider was tried before the cooldown ended")
+	}
+
+	// Tried first again once the cooldown has passed, and healthy after it succeeds
+	twilio.setFailing(false)
+	now = now.Add(2 * time.Second)
+	id, err = sms.SendMessage(SmsSender, "+6281234567890", "hello")
+	if err != nil || id != "SM1" {
+		t.Fatalf("got %q, %v, expected the provider to be tried after the cooldown", id, err)
+	}
+	if sms.providers[0].failures != 0 {
+		t.Fatalf("failures is %d after a success", sms.providers[0].failures)
+	}
+}
+
+func TestFailoverSmsBreakerLastResort(t *testing.T) {
+	twilio := newFakeSmsProvider("sid", "SM1")
+	defer twilio.Close()
+
+	sms := NewFailoverSms()
+	sms.AddProvider("twilio", newTestTwilio(twilio))
+
+	twilio.setFailing(true)
+	for i := 0; i < SmsBreakerFailures; i++ {
+		sms.SendMessage(SmsSender, "+6281234567890", "hello")
+	}
+
+	// With its breaker open, the only provider is still tried
+	twilio.setFailing(false)
+	id, err := sms.SendMessage(SmsSender, "+6281234567890", "hello")
+	if err != nil || id != "SM1" {
+		t.Fatalf("got %q, %v", id, err)
+	}
+}
+
+func TestFailoverSmsSetValue(t *testing.T) {
+	twilio := newFakeSmsProvider("sid", "SM1")
+	defer twilio.Close()
+
+	sms := NewFailoverSms()
+	sms.AddProvider("twilio", newTestTwilio(twilio))
+
+	if err := sms.SetValue("zenziva.url", "http://localhost"); err == nil {
+		t.Fatal("expected an error for an unknown provider")
+	}
+
+	other := newFakeSmsProvider("sid", "SM2")
+	defer other.Close()
+	if err := sms.SetValue("twilio.url", strings.TrimSuffix(other.URL, "/")+"/"); err != nil {
+		t.Fatal(err)
+	}
+	id, err := sms.SendMessage(SmsSender, "+6281234567890", "hello")
+	if err != nil || id != "SM2" {
+		t.Fatalf("got %q, %v, expected the value to reach the provider", id, err)
+	}
+}
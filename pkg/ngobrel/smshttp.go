@@ -0,0 +1,213 @@
+package ngobrel
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// HTTPSms sends through any provider with an HTTP API. It is set up with SetValue:
+//
+//	url           the URL template, required
+//	method        the HTTP method, POST by default
+//	body          the body template, empty by default
+//	header.<Name> a header template, e.g. header.Content-Type
+//	basicAuth     "true" to send the account and token as basic authentication
+//	messageID     the dot separated path of the message ID in a JSON response, e.g. messages.0.id
+//
+// Templates get From, To, Message, Account and Token, and can use the json function to
+// quote a string for a JSON body, e.g.
+//
+//	{"to": {{json .To}}, "text": {{json .Message}}}
+//
+// as well as urlquery from text/template for URLs and form bodies.
+type HTTPSms struct {
+	account   string
+	token     string
+	method    string
+	url       *template.Template
+	body      *template.Template
+	headers   map[string]*template.Template
+	basicAuth bool
+	messageID string
+	client    *http.Client
+}
+
+type httpSmsData struct {
+	From    string
+	To      string
+	Message string
+	Account string
+	Token   string
+}
+
+var httpSmsFuncs = template.FuncMap{
+	"json": func(s string) (string, error) {
+		b, err := json.Marshal(s)
+		return string(b), err
+	},
+}
+
+func NewHTTPSms() *HTTPSms {
+	return &HTTPSms{
+		method:  "POST",
+		headers: make(map[string]*template.Template),
+		client:  &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+func (t *HTTPSms) SetAccount(userID string, tokenID string) error {
+	t.account = userID
+	t.token = tokenID
+	return nil
+}
+
+func (t *HTTPSms) SetValue(key string, value string) error {
+	if strings.HasPrefix(key, "header.") {
+		tmpl, err := template.New(key).Funcs(httpSmsFuncs).Parse(value)
+		if err != nil {
+			return err
+		}
+		t.headers[strings.TrimPrefix(key, "header.")] = tmpl
+		return nil
+	}
+
+	switch key {
+	case "url", "body":
+		tmpl, err := template.New(key).Funcs(httpSmsFuncs).Parse(value)
+		if err != nil {
+			return err
+		}
+		if key == "url" {
+			t.url = tmpl
+		} else {
+			t.body = tmpl
+		}
+	case "method":
+		t.method = strings.ToUpper(value)
+	case "basicAuth":
+		t.basicAuth = value == "true"
+	case "messageID":
+		t.messageID = value
+	}
+	return nil
+}
+
+func execute(tmpl *template.Template, data interface{}) (string, error) {
+	var b bytes.Buffer
+	if err := tmpl.Execute(&b, data); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+func (t *HTTPSms) SendMessage(from string, to string, message string) (string, error) {
+	if t.url == nil {
+		err := errors.New("http-sms-url-not-yet-setup")
+		log.Println(err)
+		return "", err
+	}
+
+	data := &httpSmsData{
+		From:    from,
+		To:      to,
+		Message: message,
+		Account: t.account,
+		Token:   t.token,
+	}
+
+	urlStr, err := execute(t.url, data)
+	if err != nil {
+		log.Println(err)
+		return "", err
+	}
+
+	var body string
+	if t.body != nil {
+		body, err = execute(t.body, data)
+		if err != nil {
+			log.Println(err)
+			return "", err
+		}
+	}
+
+	req, err := http.NewRequest(t.method, urlStr, strings.NewReader(body))
+	if err != nil {
+		log.Println(err)
+		return "", err
+	}
+	for name, tmpl := range t.headers {
+		value, err := execute(tmpl, data)
+		if err != nil {
+			log.Println(err)
+			return "", err
+		}
+		req.Header.Set(name, value)
+	}
+	if t.basicAuth {
+		req.SetBasicAuth(t.account, t.token)
+	}
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		log.Println(err)
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		log.Println(err)
+		return "", err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		err := errors.New("http-sms-unable-to-send-sms")
+		log.Println(err, resp.StatusCode, string(respBody))
+		return "", err
+	}
+
+	if t.messageID == "" {
+		return "", nil
+	}
+
+	var response interface{}
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		log.Println(err)
+		return "", err
+	}
+	return jsonPath(response, t.messageID), nil
+}
+
+// Gets the value at a dot separated path of a decoded JSON document as a string
+func jsonPath(v interface{}, path string) string {
+	for _, key := range strings.Split(path, ".") {
+		switch node := v.(type) {
+		case map[string]interface{}:
+			v = node[key]
+		case []interface{}:
+			i, err := strconv.Atoi(key)
+			if err != nil || i < 0 || i >= len(node) {
+				return ""
+			}
+			v = node[i]
+		default:
+			return ""
+		}
+	}
+
+	switch value := v.(type) {
+	case string:
+		return value
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	}
+	return ""
+}
@@ -0,0 +1,222 @@
+package ngobrel
+
+import (
+	"crypto/subtle"
+	"database/sql"
+	"errors"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+)
+
+// Outgoing SMS, as well as the messages of the other OTP channels, are queued in sms_queue,
+// in the same transaction as whatever they are about, and sent by worker goroutines. A failed
+// message is retried with exponential backoff up to SmsMaxAttempts times. The message ID given
+// by the provider is kept so delivery receipts coming to SmsCallbackHandler can be matched.
+// OTP messages are rendered when they are sent, with the code kept in redis, so the database
+// never holds an OTP.
+//
+// The states of a message are queued, sending, sent, failed, delivered, undelivered and
+// cancelled, when a fallback message of an OTP is no longer needed or the OTP has expired.
+
+// Queues an OTP message to be sent over a channel once tx is committed, after the delay. locale
+// and brand are the ones of its template, otpPhoneNumber the phone number of the OTP, if any,
+// and otpKey the key its code is stored under by storeOTPCode.
+func queueMessage(tx *sql.Tx, channel, from, to, locale, brand, otpPhoneNumber, otpKey string, delay time.Duration) error {
+	_, err := tx.Exec(`INSERT INTO sms_queue (channel, sender, recipient, message, locale, brand, otp_phone_number, otp_key, status, attempts, next_attempt_at, created_at, updated_at)
+	values ($1, $2, $3, '', $4, $5, NULLIF($6, ''), $7, 'queued', 0, now() + $8::float8 * interval '1 second', now(), now())`,
+		channel, from, to, locale, brand, otpPhoneNumber, otpKey, delay.Seconds())
+	if err != nil {
+		log.Println(err)
+	}
+	return err
+}
+
+func (srv *Server) wakeSmsQueue() {
+	select {
+	case srv.smsQueueWake <- struct{}{}:
+	default:
+	}
+}
+
+// Reads SMS_WORKERS, the number of goroutines sending queued SMS
+func smsWorkers() int {
+	s := os.Getenv("SMS_WORKERS")
+	if s == "" {
+		return DefaultSmsWorkers
+	}
+
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 1 {
+		log.Fatal("SMS_WORKERS must be a positive number")
+	}
+	return n
+}
+
+// Starts the goroutines sending queued SMS
+func (srv *Server) StartSmsWorkers() {
+	for i := 0; i < smsWorkers(); i++ {
+		go srv.runSmsWorker()
+	}
+}
+
+func (srv *Server) runSmsWorker() {
+	ticker := time.NewTicker(SmsQueuePollInterval)
+	defer ticker.Stop()
+
+	for {
+		for srv.sendQueuedSms() {
+		}
+
+		select {
+		case <-ticker.C:
+		case <-srv.smsQueueWake:
+		}
+	}
+}
+
+// Sends the next due message, returns false when there is none
+func (srv *Server) sendQueuedSms() bool {
+	// Messages left in sending by a server which went away are sent again
+	_, err := srv.db.Exec(`UPDATE sms_queue SET status='queued', updated_at=now()
+	WHERE status='sending' AND updated_at < now() - $1::float8 * interval '1 second'`, SmsSendingTimeout.Seconds())
+	if err != nil {
+		log.Println(err)
+		return false
+	}
+
+	var id int64
+	var channel, from, to, message, locale, brand, otpPhoneNumber, otpKey string
+	var attempts int
+	err = srv.db.QueryRow(`UPDATE sms_queue SET status='sending', attempts=attempts+1, updated_at=now()
+	WHERE id = (SELECT id FROM sms_queue WHERE status='queued' AND next_attempt_at <= now() ORDER BY next_attempt_at, id LIMIT 1 FOR UPDATE SKIP LOCKED)
+	RETURNING id, channel, sender, recipient, message, locale, brand, COALESCE(otp_phone_number, ''), COALESCE(otp_key, ''), attempts`).Scan(
+		&id, &channel, &from, &to, &message, &locale, &brand, &otpPhoneNumber, &otpKey, &attempts)
+	if err == sql.ErrNoRows {
+		return false
+	}
+	if err != nil {
+		log.Println(err)
+		return false
+	}
+
+	otpChannel, ok := srv.otpChannels[channel]
+	if ok == false {
+		err = errors.New("otp-channel-not-available")
+		attempts = SmsMaxAttempts
+	} else if otpKey != "" {
+		message, err = renderOTPMessage(srv, channel, otpPhoneNumber, locale, brand, otpKey)
+	}
+
+	if err != nil && err.Error() == "otp-expired" {
+		// Nobody could use the OTP any more
+		_, err = srv.db.Exec(`UPDATE sms_queue SET status='cancelled', last_error='otp-expired', updated_at=now() WHERE id=$1`, id)
+		if err != nil {
+			log.Println(err)
+		}
+		return true
+	}
+
+	var messageID string
+	if err == nil {
+		if localized, isLocalized := otpChannel.(LocalizedOTPChannel); isLocalized {
+			messageID, err = localized.SendLocalizedMessage(from, to, message, locale)
+		} else {
+			messageID, err = otpChannel.SendMessage(from, to, message)
+		}
+	}
+	if err == nil {
+		_, err = srv.db.Exec(`UPDATE sms_queue SET status='sent', provider_message_id=NULLIF($1, ''), message='', last_error='', updated_at=now()
+		WHERE id=$2`, messageID, id)
+		if err != nil {
+			log.Println(err)
+		}
+		return true
+	}
+
+	if attempts >= SmsMaxAttempts {
+		log.Println("Giving up sending", channel, id, "to", to, err)
+		_, err = srv.db.Exec(`UPDATE sms_queue SET status='failed', message='', last_error=$1, updated_at=now() WHERE id=$2`, err.Error(), id)
+		if err != nil {
+			log.Println(err)
+		}
+		return true
+	}
+
+	backoff := SmsRetryBase
+	for i := 1; i < attempts && backoff < SmsRetryMax; i++ {
+		backoff *= 2
+	}
+	if backoff > SmsRetryMax {
+		backoff = SmsRetryMax
+	}
+
+	log.Println("Sending", channel, id, "failed, retrying in", backoff, err)
+	_, err = srv.db.Exec(`UPDATE sms_queue SET status='queued', last_error=$1, next_attempt_at=now() + $2::float8 * interval '1 second', updated_at=now()
+	WHERE id=$3`, err.Error(), backoff.Seconds(), id)
+	if err != nil {
+		log.Println(err)
+	}
+	return true
+}
+
+// Takes delivery receipts from the SMS providers. It understands the status callbacks of
+// Twilio (MessageSid and MessageStatus) and of providers posting messageId and status,
+// either as a form or in the query string. The callback URL has to carry SMS_CALLBACK_TOKEN
+// as ?token=; without a token the handler is not created, so the listener cannot run open.
+func (srv *Server) SmsCallbackHandler() (http.Handler, error) {
+	callbackToken := os.Getenv("SMS_CALLBACK_TOKEN")
+	if callbackToken == "" {
+		return nil, errors.New("SMS_CALLBACK_TOKEN is not set")
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, "invalid-request", http.StatusBadRequest)
+			return
+		}
+
+		if subtle.ConstantTimeCompare([]byte(r.Form.Get("token")), []byte(callbackToken)) != 1 {
+			http.Error(w, "invalid-token", http.StatusForbidden)
+			return
+		}
+
+		messageID := r.Form.Get("MessageSid")
+		if messageID == "" {
+			messageID = r.Form.Get("messageId")
+		}
+		providerStatus := r.Form.Get("MessageStatus")
+		if providerStatus == "" {
+			providerStatus = r.Form.Get("status")
+		}
+
+		if messageID == "" {
+			http.Error(w, "invalid-request", http.StatusBadRequest)
+			return
+		}
+
+		var status string
+		switch providerStatus {
+		case "delivered", "DELIVERED", "DELIVRD":
+			status = "delivered"
+		case "undelivered", "failed", "UNDELIVERED", "FAILED", "UNDELIV", "REJECTD", "EXPIRED":
+			status = "undelivered"
+		default:
+			// Intermediate states like queued or sent are not interesting
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		_, err := srv.db.Exec(`UPDATE sms_queue SET status=$1, last_error=$2, updated_at=now() WHERE provider_message_id=$3`,
+			status, providerStatus, messageID)
+		if err != nil {
+			log.Println(err)
+			http.Error(w, "internal-error", http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}), nil
+}
@@ -0,0 +1,182 @@
+package ngobrel
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// SMS providers are registered by type name, so they can be chosen from configuration.
+// The SMS_CONFIG_PATH file lists the providers in the order they are tried:
+//
+//	{
+//	  "providers": [
+//	    {"name": "zenziva", "type": "zenziva", "account": "userkey", "token": "passkey",
+//	     "prefixes": ["+62"], "values": {"subdomain": "reguler.zenziva.net"}},
+//	    {"name": "carrier", "type": "smpp", "account": "system-id", "token": "password",
+//	     "values": {"addr": "smsc.example.com:2775"}},
+//	    {"name": "twilio", "type": "twilio", "account": "AC...", "token": "..."}
+//	  ]
+//	}
+//
+// The values are passed to SetValue of the provider.
+
+var smsRegistry = struct {
+	sync.Mutex
+	factories map[string]func() Sms
+}{
+	factories: make(map[string]func() Sms),
+}
+
+func init() {
+	RegisterSms("dummy", func() Sms { return NewDummySms() })
+	RegisterSms("twilio", func() Sms { return NewTwilioSms() })
+	RegisterSms("zenziva", func() Sms { return NewZenzivaSms() })
+	RegisterSms("http", func() Sms { return NewHTTPSms() })
+	RegisterSms("smpp", func() Sms { return NewSmppSms() })
+}
+
+// Makes a provider type available to NewSms and the SMS configuration
+func RegisterSms(typeName string, factory func() Sms) {
+	smsRegistry.Lock()
+	defer smsRegistry.Unlock()
+
+	smsRegistry.factories[typeName] = factory
+}
+
+// The registered provider types
+func SmsTypes() []string {
+	smsRegistry.Lock()
+	defer smsRegistry.Unlock()
+
+	var types []string
+	for typeName := range smsRegistry.factories {
+		types = append(types, typeName)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// Creates a provider of a registered type
+func NewSms(typeName string) (Sms, error) {
+	smsRegistry.Lock()
+	factory, ok := smsRegistry.factories[typeName]
+	smsRegistry.Unlock()
+
+	if ok == false {
+		return nil, errors.New("unknown SMS provider type " + typeName + ", known are " + strings.Join(SmsTypes(), ", "))
+	}
+	return factory(), nil
+}
+
+type SmsProviderConfig struct {
+	Name     string            `json:"name"`
+	Type     string            `json:"type"`
+	Account  string            `json:"account"`
+	Token    string            `json:"token"`
+	Prefixes []string          `json:"prefixes"`
+	Values   map[string]string `json:"values"`
+}
+
+type SmsConfig struct {
+	Providers []SmsProviderConfig `json:"providers"`
+}
+
+// Creates the providers of a configuration, tried in order by a FailoverSms
+func NewSmsFromConfig(config *SmsConfig) (Sms, error) {
+	if len(config.Providers) == 0 {
+		return nil, errors.New("no SMS providers configured")
+	}
+
+	failover := NewFailoverSms()
+	for _, p := range config.Providers {
+		sms, err := NewSms(p.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := sms.SetAccount(p.Account, p.Token); err != nil {
+			return nil, err
+		}
+		for key, value := range p.Values {
+			if err := sms.SetValue(key, value); err != nil {
+				return nil, err
+			}
+		}
+
+		name := p.Name
+		if name == "" {
+			name = p.Type
+		}
+		failover.AddProvider(name, sms, p.Prefixes...)
+	}
+	return failover, nil
+}
+
+// Creates the SMS providers from the environment:
+//
+//   - SMS_CONFIG_PATH, the configuration file described above, or else
+//   - SMS_PROVIDERS, a comma separated list of provider types each optionally followed by the
+//     phone number prefixes it is used for, e.g. "zenziva:+62,twilio". The accounts are read from
+//     SMS_ACCOUNT and SMS_TOKEN for Zenziva and TWILIO_ACCOUNT and TWILIO_TOKEN for Twilio,
+//     and <TYPE>_URL replaces the endpoint of the provider, or else
+//   - Zenziva when SMS_ACCOUNT is set and the dummy provider otherwise.
+func NewSmsFromEnv() (Sms, error) {
+	if path := os.Getenv("SMS_CONFIG_PATH"); path != "" {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		config := &SmsConfig{}
+		if err := json.Unmarshal(data, config); err != nil {
+			return nil, err
+		}
+		return NewSmsFromConfig(config)
+	}
+
+	providers := os.Getenv("SMS_PROVIDERS")
+	if providers == "" {
+		var smsClient Sms
+		smsAccount, smsAccountExists := os.LookupEnv("SMS_ACCOUNT")
+
+		if smsAccountExists {
+			smsClient = NewZenzivaSms()
+		} else {
+			smsClient = NewDummySms()
+		}
+		smsClient.SetAccount(smsAccount, os.Getenv("SMS_TOKEN"))
+		smsClient.SetValue("subdomain", os.Getenv("SMS_SUBDOMAIN"))
+		return smsClient, nil
+	}
+
+	config := &SmsConfig{}
+	for _, provider := range strings.Split(providers, ",") {
+		parts := strings.Split(strings.TrimSpace(provider), ":")
+		p := SmsProviderConfig{
+			Type:     parts[0],
+			Prefixes: parts[1:],
+			Values:   make(map[string]string),
+		}
+
+		switch p.Type {
+		case "zenziva":
+			p.Account = os.Getenv("SMS_ACCOUNT")
+			p.Token = os.Getenv("SMS_TOKEN")
+			p.Values["subdomain"] = os.Getenv("SMS_SUBDOMAIN")
+		case "twilio":
+			p.Account = os.Getenv("TWILIO_ACCOUNT")
+			p.Token = os.Getenv("TWILIO_TOKEN")
+		}
+		if url := os.Getenv(strings.ToUpper(p.Type) + "_URL"); url != "" {
+			p.Values["url"] = url
+		}
+
+		config.Providers = append(config.Providers, p)
+	}
+	return NewSmsFromConfig(config)
+}
@@ -0,0 +1,202 @@
+package ngobrel
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+	"text/template"
+)
+
+// The OTP messages are read from the JSON file at SMS_TEMPLATES_PATH:
+//
+//	{
+//	  "defaultBrand": "horas",
+//	  "defaultLocale": "id",
+//	  "prefixes": {"+62": "id", "+1": "en"},
+//	  "brands": {
+//	    "horas": {
+//	      "appName": "Horas",
+//	      "sender": "+18087311210",
+//	      "templates": {
+//	        "id": "Horas! Kode {{.AppName}} Anda adalah [{{.Code}}]",
+//	        "en": "Your {{.AppName}} code is {{.Code}}, valid for {{.ExpiryMinutes}} minutes"
+//	      }
+//	    }
+//	  }
+//	}
+//
+// The locale is the one asked by the client, or else the one of the longest matching phone
+// number prefix, or else defaultLocale. Templates get AppName, Code and ExpiryMinutes.
+
+type smsBrandConfig struct {
+	AppName   string            `json:"appName"`
+	Sender    string            `json:"sender"`
+	Templates map[string]string `json:"templates"`
+}
+
+type smsTemplateConfig struct {
+	DefaultBrand  string                    `json:"defaultBrand"`
+	DefaultLocale string                    `json:"defaultLocale"`
+	Prefixes      map[string]string         `json:"prefixes"`
+	Brands        map[string]smsBrandConfig `json:"brands"`
+}
+
+type smsBrand struct {
+	appName   string
+	sender    string
+	templates map[string]*template.Template
+}
+
+type smsTemplates struct {
+	defaultBrand  string
+	defaultLocale string
+	prefixes      map[string]string
+	brands        map[string]*smsBrand
+}
+
+type smsTemplateData struct {
+	AppName       string
+	Code          string
+	ExpiryMinutes int
+}
+
+var defaultSmsTemplateConfig = smsTemplateConfig{
+	DefaultBrand:  "horas",
+	DefaultLocale: "id",
+	Prefixes:      map[string]string{"+62": "id"},
+	Brands: map[string]smsBrandConfig{
+		"horas": {
+			AppName: "Horas",
+			Sender:  SmsSender,
+			Templates: map[string]string{
+				"id": "Horas! Kode {{.AppName}} Anda adalah [{{.Code}}]",
+				"en": "Your {{.AppName}} code is {{.Code}}, valid for {{.ExpiryMinutes}} minutes",
+			},
+		},
+	},
+}
+
+// Reads the templates from SMS_TEMPLATES_PATH, or uses the built in ones when it is not set
+func loadSmsTemplates() (*smsTemplates, error) {
+	config := defaultSmsTemplateConfig
+
+	if path := os.Getenv("SMS_TEMPLATES_PATH"); path != "" {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		config = smsTemplateConfig{}
+		if err := json.Unmarshal(data, &config); err != nil {
+			return nil, err
+		}
+	}
+
+	t := &smsTemplates{
+		defaultBrand:  config.DefaultBrand,
+		defaultLocale: strings.ToLower(config.DefaultLocale),
+		prefixes:      config.Prefixes,
+		brands:        make(map[string]*smsBrand),
+	}
+
+	for name, brandConfig := range config.Brands {
+		brand := &smsBrand{
+			appName:   brandConfig.AppName,
+			sender:    brandConfig.Sender,
+			templates: make(map[string]*template.Template),
+		}
+		if brand.sender == "" {
+			brand.sender = SmsSender
+		}
+
+		for locale, text := range brandConfig.Templates {
+			tmpl, err := template.New(name + "/" + locale).Option("missingkey=error").Parse(text)
+			if err != nil {
+				return nil, err
+			}
+			brand.templates[strings.ToLower(locale)] = tmpl
+		}
+		t.brands[name] = brand
+	}
+
+	defaultBrand, ok := t.brands[t.defaultBrand]
+	if ok == false {
+		return nil, errors.New("the default SMS brand " + t.defaultBrand + " has no templates")
+	}
+	if _, ok := defaultBrand.templates[t.defaultLocale]; ok == false {
+		return nil, errors.New("the default SMS brand has no template for the default locale " + t.defaultLocale)
+	}
+
+	return t, nil
+}
+
+// Finds the template of the locale, e.g. "id-ID" falls back to "id", and the locale it is of
+func (b *smsBrand) template(locale string) (*template.Template, string) {
+	locale = strings.ToLower(strings.Replace(locale, "_", "-", -1))
+	for locale != "" {
+		if tmpl, ok := b.templates[locale]; ok {
+			return tmpl, locale
+		}
+
+		i := strings.LastIndex(locale, "-")
+		if i < 0 {
+			break
+		}
+		locale = locale[:i]
+	}
+	return nil, ""
+}
+
+func (t *smsTemplates) localeOf(phoneNumber string) string {
+	locale := ""
+	longest := 0
+	for prefix, l := range t.prefixes {
+		if len(prefix) > longest && strings.HasPrefix(phoneNumber, prefix) {
+			locale = l
+			longest = len(prefix)
+		}
+	}
+	return locale
+}
+
+// Finds the brand and the template of the OTP message for a phone number, and the locale of the template
+func (t *smsTemplates) lookup(phoneNumber, locale, brandName string) (*smsBrand, *template.Template, string) {
+	brand, ok := t.brands[brandName]
+	if ok == false {
+		brand = t.brands[t.defaultBrand]
+	}
+
+	tmpl, locale := brand.template(locale)
+	if tmpl == nil {
+		tmpl, locale = brand.template(t.localeOf(phoneNumber))
+	}
+	if tmpl == nil {
+		tmpl, locale = brand.template(t.defaultLocale)
+	}
+	if tmpl == nil {
+		tmpl, locale = t.brands[t.defaultBrand].template(t.defaultLocale)
+	}
+	return brand, tmpl, locale
+}
+
+// Renders the OTP message for a phone number, returning the sender, the text and its locale
+func (t *smsTemplates) otpMessage(phoneNumber, locale, brandName, code string) (string, string, string, error) {
+	brand, tmpl, locale := t.lookup(phoneNumber, locale, brandName)
+
+	var b bytes.Buffer
+	err := tmpl.Execute(&b, &smsTemplateData{
+		AppName:       brand.appName,
+		Code:          code,
+		ExpiryMinutes: int(OTPTTL.Minutes()),
+	})
+	if err != nil {
+		log.Println(err)
+		return "", "", "", err
+	}
+
+	return brand.sender, b.String(), locale, nil
+}
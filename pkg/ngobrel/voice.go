@@ -0,0 +1,176 @@
+package ngobrel
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TwilioVoice reads messages out in a phone call, with Twilio text to speech, in the language
+// of the locale the message is written in. It is set up with SetValue:
+//
+//	from             the calling number, required
+//	url              replaces https://api.twilio.com, e.g. with a local stand-in
+//	language         the language of messages of an unknown locale, en-US by default
+//	language.LOCALE  the language of the messages of LOCALE, e.g. language.ms = ms-MY
+//	repeat           how many times the message is read, 2 by default
+type TwilioVoice struct {
+	userID    string
+	tokenID   string
+	from      string
+	baseURL   string
+	language  string
+	languages map[string]string
+	repeat    int
+	client    *http.Client
+}
+
+type twimlSay struct {
+	XMLName  xml.Name `xml:"Say"`
+	Language string   `xml:"language,attr,omitempty"`
+	Text     string   `xml:",chardata"`
+}
+
+type twimlPause struct {
+	XMLName xml.Name `xml:"Pause"`
+	Length  int      `xml:"length,attr"`
+}
+
+type twimlResponse struct {
+	XMLName xml.Name `xml:"Response"`
+	Verbs   []interface{}
+}
+
+func NewTwilioVoice() *TwilioVoice {
+	return &TwilioVoice{
+		baseURL:  "https://api.twilio.com",
+		language: "en-US",
+		languages: map[string]string{
+			"en": "en-US",
+			"id": "id-ID",
+			"ms": "ms-MY",
+		},
+		repeat: 2,
+		client: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+func (t *TwilioVoice) SetAccount(userID string, tokenID string) error {
+	t.userID = userID
+	t.tokenID = tokenID
+	return nil
+}
+
+func (t *TwilioVoice) SetValue(key string, value string) error {
+	switch key {
+	case "from":
+		t.from = value
+	case "url":
+		t.baseURL = strings.TrimSuffix(value, "/")
+	case "language":
+		t.language = value
+	case "repeat":
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 1 {
+			return errors.New("invalid-repeat")
+		}
+		t.repeat = n
+	default:
+		if strings.HasPrefix(key, "language.") {
+			t.languages[strings.ToLower(strings.TrimPrefix(key, "language."))] = value
+		}
+	}
+	return nil
+}
+
+// The language a message of the locale is spoken in. A locale with a region, e.g. en-gb,
+// is used as it is, one without is looked up in the languages.
+func (t *TwilioVoice) languageOf(locale string) string {
+	locale = strings.ToLower(locale)
+	if language, ok := t.languages[locale]; ok {
+		return language
+	}
+
+	parts := strings.SplitN(locale, "-", 2)
+	if len(parts) == 2 && len(parts[1]) == 2 {
+		return parts[0] + "-" + strings.ToUpper(parts[1])
+	}
+	return t.language
+}
+
+func (t *TwilioVoice) twiml(message string, language string) (string, error) {
+	response := &twimlResponse{}
+	for i := 0; i < t.repeat; i++ {
+		if i > 0 {
+			response.Verbs = append(response.Verbs, &twimlPause{Length: 1})
+		}
+		response.Verbs = append(response.Verbs, &twimlSay{Language: language, Text: message})
+	}
+
+	b, err := xml.Marshal(response)
+	return string(b), err
+}
+
+func (t *TwilioVoice) SendMessage(from string, to string, message string) (string, error) {
+	return t.SendLocalizedMessage(from, to, message, "")
+}
+
+// The sender of the OTP message may not be able to call, so the configured number is used instead
+func (t *TwilioVoice) SendLocalizedMessage(from string, to string, message string, locale string) (string, error) {
+	if t.userID == "" || t.tokenID == "" || t.from == "" {
+		err := errors.New("twilio-voice-account-not-yet-setup")
+		log.Println(err)
+		return "", err
+	}
+
+	twiml, err := t.twiml(message, t.languageOf(locale))
+	if err != nil {
+		log.Println(err)
+		return "", err
+	}
+
+	urlStr := t.baseURL + "/2010-04-01/Accounts/" + t.userID + "/Calls.json"
+
+	callData := url.Values{}
+	callData.Set("To", to)
+	callData.Set("From", t.from)
+	callData.Set("Twiml", twiml)
+
+	req, err := http.NewRequest("POST", urlStr, strings.NewReader(callData.Encode()))
+	if err != nil {
+		log.Println(err)
+		return "", err
+	}
+	req.SetBasicAuth(t.userID, t.tokenID)
+	req.Header.Add("Accept", "application/json")
+	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		log.Println("Error connecting to Twilio")
+		log.Println(err)
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		err := errors.New("twilio-unable-to-call")
+		log.Println(err, resp.Status)
+		return "", err
+	}
+
+	var data map[string]interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+		log.Println(err)
+		return "", err
+	}
+
+	callSid, _ := data["sid"].(string)
+	return callSid, nil
+}
@@ -0,0 +1,47 @@
+package ngobrel
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestTwilioVoiceLanguage(t *testing.T) {
+	var twiml string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		twiml = r.Form.Get("Twiml")
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"sid": "CA1"}`)
+	}))
+	defer server.Close()
+
+	voice := NewTwilioVoice()
+	voice.SetAccount("AC123", "token")
+	voice.SetValue("from", "+15005550006")
+	voice.SetValue("url", server.URL)
+	voice.SetValue("language.jv", "id-ID")
+
+	cases := []struct {
+		locale   string
+		language string
+	}{
+		{"id", "id-ID"},
+		{"ms", "ms-MY"},
+		{"en-gb", "en-GB"},
+		{"jv", "id-ID"},
+		{"xx", "en-US"},
+		{"", "en-US"},
+	}
+	for _, c := range cases {
+		callSid, err := voice.SendLocalizedMessage("Horas", "+6281234567", "1, 2, 3, 4", c.locale)
+		if err != nil || callSid != "CA1" {
+			t.Fatalf("%q: %q %v", c.locale, callSid, err)
+		}
+		if strings.Contains(twiml, `<Say language="`+c.language+`">1, 2, 3, 4</Say>`) == false {
+			t.Errorf("%q: got %s", c.locale, twiml)
+		}
+	}
+}
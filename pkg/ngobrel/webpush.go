@@ -0,0 +1,411 @@
+package ngobrel
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"expvar"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"math/big"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"syscall"
+	"time"
+	"unicode/utf8"
+
+	"golang.org/x/crypto/hkdf"
+)
+
+// Counts the outcomes of the Web Push requests, as fcmStats does for FCM
+var webPushStats = expvar.NewMap("webpush")
+
+// The longest notification body sent, in bytes
+const webPushMaxBody = 2048
+
+// Sends notifications to browsers with the Web Push protocol (RFC 8030), encrypting the payload
+// for the subscription (RFC 8291) and identifying the server with a VAPID key (RFC 8292)
+type WebPush struct {
+	client    *http.Client
+	key       *ecdsa.PrivateKey
+	publicKey string
+	// A mailto: or https: URL the push services can reach the operator at
+	subject string
+}
+
+// The PushSubscription a browser gives, in JSON
+type webPushSubscription struct {
+	Endpoint string `json:"endpoint"`
+	Keys     struct {
+		P256dh string `json:"p256dh"`
+		Auth   string `json:"auth"`
+	} `json:"keys"`
+}
+
+// An error response of a push service
+type WebPushError struct {
+	StatusCode int
+	Message    string
+	RetryAfter time.Duration
+}
+
+func (e *WebPushError) Error() string {
+	return fmt.Sprintf("webpush: %d %s", e.StatusCode, e.Message)
+}
+
+// The subscription has expired or has been removed
+func (e *WebPushError) TokenInvalid() bool {
+	return e.StatusCode == http.StatusNotFound || e.StatusCode == http.StatusGone
+}
+
+func (e *WebPushError) Retryable() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= 500
+}
+
+func (e *WebPushError) retryAfter() time.Duration {
+	return e.RetryAfter
+}
+
+func (e *WebPushError) outcome() string {
+	switch {
+	case e.TokenInvalid():
+		return "gone"
+	case e.StatusCode == http.StatusTooManyRequests:
+		return "too_many_requests"
+	case e.StatusCode >= 500:
+		return "server_error"
+	}
+	return "rejected"
+}
+
+// The endpoint of a subscription is given by the client, so the server must not be made to
+// post to itself or to its network through it. Only https endpoints are taken, and the
+// addresses they resolve to are checked when connecting, see checkWebPushAddress.
+var errWebPushAddress = errors.New("webpush-endpoint-not-allowed")
+
+// The networks a push service can not be in, besides loopback, link-local, multicast and
+// unspecified addresses
+var webPushBlockedNets = parseCIDRs(
+	"0.0.0.0/8",
+	"10.0.0.0/8",
+	"100.64.0.0/10",
+	"172.16.0.0/12",
+	"192.0.0.0/24",
+	"192.168.0.0/16",
+	"198.18.0.0/15",
+	"240.0.0.0/4",
+	"fc00::/7",
+)
+
+func parseCIDRs(cidrs ...string) []*net.IPNet {
+	nets := make([]*net.IPNet, len(cidrs))
+	for i, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		nets[i] = network
+	}
+	return nets
+}
+
+func isPublicIP(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() {
+		return false
+	}
+	for _, network := range webPushBlockedNets {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// Refuses to connect to an address which is not public, whatever the endpoint resolved to
+func checkWebPushAddress(network, address string, c syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || isPublicIP(ip) == false {
+		log.Println("Refusing to send Web Push to", address)
+		return errWebPushAddress
+	}
+	return nil
+}
+
+// An HTTP client which only connects to public addresses, directly
+func newWebPushClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control:   checkWebPushAddress,
+	}
+	return &http.Client{
+		Timeout: 30 * time.Second,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: 10 * time.Second,
+			MaxIdleConns:        100,
+			IdleConnTimeout:     90 * time.Second,
+		},
+	}
+}
+
+// Whether a request failed because the endpoint is not a public address
+func isWebPushAddressError(err error) bool {
+	if urlErr, ok := err.(*url.Error); ok {
+		err = urlErr.Err
+	}
+	if opErr, ok := err.(*net.OpError); ok {
+		err = opErr.Err
+	}
+	if syscallErr, ok := err.(*os.SyscallError); ok {
+		err = syscallErr.Err
+	}
+	return err == errWebPushAddress
+}
+
+func parseWebPushSubscription(token string) (*webPushSubscription, error) {
+	var subscription webPushSubscription
+	if err := json.Unmarshal([]byte(token), &subscription); err != nil {
+		return nil, err
+	}
+
+	endpoint, err := url.Parse(subscription.Endpoint)
+	if err != nil || endpoint.Host == "" || endpoint.Scheme != "https" {
+		return nil, errors.New("invalid-push-endpoint")
+	}
+	if ip := net.ParseIP(endpoint.Hostname()); ip != nil && isPublicIP(ip) == false {
+		return nil, errors.New("invalid-push-endpoint")
+	}
+	if subscription.Keys.P256dh == "" || subscription.Keys.Auth == "" {
+		return nil, errors.New("invalid-push-keys")
+	}
+	return &subscription, nil
+}
+
+// Sets up Web Push with the VAPID private key, the raw P-256 scalar in base64url
+// as the web-push tools generate it
+func NewWebPush(privateKey string, subject string) (*WebPush, error) {
+	d, err := decodeBase64URL(privateKey)
+	if err != nil || len(d) != 32 {
+		return nil, errors.New("invalid-vapid-key")
+	}
+
+	curve := elliptic.P256()
+	key := &ecdsa.PrivateKey{D: new(big.Int).SetBytes(d)}
+	key.PublicKey.Curve = curve
+	key.PublicKey.X, key.PublicKey.Y = curve.ScalarBaseMult(d)
+
+	return &WebPush{
+		client:    newWebPushClient(),
+		key:       key,
+		publicKey: base64.RawURLEncoding.EncodeToString(elliptic.Marshal(curve, key.PublicKey.X, key.PublicKey.Y)),
+		subject:   subject,
+	}, nil
+}
+
+func newWebPushFromEnv() (*WebPush, error) {
+	subject := os.Getenv("VAPID_SUBJECT")
+	if subject == "" {
+		return nil, errors.New("VAPID_SUBJECT is not set")
+	}
+	return NewWebPush(os.Getenv("VAPID_PRIVATE_KEY"), subject)
+}
+
+// The public key the browsers subscribe with, as applicationServerKey
+func (w *WebPush) PublicKey() string {
+	return w.publicKey
+}
+
+func (w *WebPush) SendNotification(token string, notification *PushNotification) error {
+	subscription, err := parseWebPushSubscription(token)
+	if err != nil {
+		log.Println(err)
+		return &WebPushError{StatusCode: http.StatusGone, Message: err.Error()}
+	}
+
+	// The encrypted payload must fit in a single 4096 bytes record
+	body := notification.Body
+	if len(body) > webPushMaxBody {
+		cut := webPushMaxBody
+		for cut > 0 && utf8.RuneStart(body[cut]) == false {
+			cut--
+		}
+		body = body[:cut]
+	}
+
+	payload, err := json.Marshal(map[string]interface{}{
+		"title": notification.Title,
+		"body":  body,
+		"data":  notification.Data,
+	})
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	urgency := "normal"
+	if notification.Title != "" || notification.Body != "" {
+		urgency = "high"
+	}
+
+	return sendWithRetry(webPushStats, "Web Push", func() error {
+		return w.send(subscription, payload, urgency)
+	})
+}
+
+// Sends a notification once. Push service errors are returned as *WebPushError.
+func (w *WebPush) send(subscription *webPushSubscription, payload []byte, urgency string) error {
+	body, err := encryptWebPush(subscription, payload)
+	if err != nil {
+		log.Println(err)
+		return &WebPushError{StatusCode: http.StatusGone, Message: err.Error()}
+	}
+
+	endpoint, _ := url.Parse(subscription.Endpoint)
+	vapid, err := signJWT(w.key,
+		map[string]string{"typ": "JWT", "alg": "ES256"},
+		map[string]interface{}{
+			"aud": endpoint.Scheme + "://" + endpoint.Host,
+			"exp": time.Now().Add(12 * time.Hour).Unix(),
+			"sub": w.subject,
+		})
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	req, err := http.NewRequest("POST", subscription.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "vapid t="+vapid+", k="+w.publicKey)
+	req.Header.Set("Content-Encoding", "aes128gcm")
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("TTL", strconv.Itoa(int(PushTTL.Seconds())))
+	req.Header.Set("Urgency", urgency)
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		if isWebPushAddressError(err) {
+			return &WebPushError{StatusCode: http.StatusGone, Message: err.Error()}
+		}
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	webPushErr := &WebPushError{StatusCode: resp.StatusCode, Message: string(data)}
+	if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds > 0 {
+		webPushErr.RetryAfter = time.Duration(seconds) * time.Second
+	}
+	return webPushErr
+}
+
+// Encrypts a payload for a subscription as a single aes128gcm record (RFC 8188, RFC 8291)
+func encryptWebPush(subscription *webPushSubscription, payload []byte) ([]byte, error) {
+	// A new key pair and salt for every message
+	asPrivate, _, _, err := elliptic.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+
+	return encryptWebPushWith(subscription, payload, asPrivate, salt)
+}
+
+// Encrypts with the given private key of the server and salt
+func encryptWebPushWith(subscription *webPushSubscription, payload []byte, asPrivate []byte, salt []byte) ([]byte, error) {
+	curve := elliptic.P256()
+
+	uaPublic, err := decodeBase64URL(subscription.Keys.P256dh)
+	if err != nil {
+		return nil, err
+	}
+	uaX, uaY := elliptic.Unmarshal(curve, uaPublic)
+	if uaX == nil {
+		return nil, errors.New("invalid-push-keys")
+	}
+
+	authSecret, err := decodeBase64URL(subscription.Keys.Auth)
+	if err != nil {
+		return nil, err
+	}
+
+	asX, asY := curve.ScalarBaseMult(asPrivate)
+	asPublic := elliptic.Marshal(curve, asX, asY)
+
+	sharedX, _ := curve.ScalarMult(uaX, uaY, asPrivate)
+	ecdhSecret := make([]byte, 32)
+	copyPadded(ecdhSecret, sharedX)
+
+	keyInfo := append([]byte("WebPush: info\x00"), uaPublic...)
+	keyInfo = append(keyInfo, asPublic...)
+	ikm, err := hkdfExpand(ecdhSecret, authSecret, keyInfo, 32)
+	if err != nil {
+		return nil, err
+	}
+
+	cek, err := hkdfExpand(ikm, salt, []byte("Content-Encoding: aes128gcm\x00"), 16)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := hkdfExpand(ikm, salt, []byte("Content-Encoding: nonce\x00"), 12)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(cek)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	// The padding delimiter of the last record
+	plaintext := append(append([]byte{}, payload...), 0x02)
+	ciphertext := gcm.Seal(nil, nonce, plaintext, nil)
+
+	// salt, record size, key ID length, the public key of the server as key ID, the record
+	var b bytes.Buffer
+	b.Write(salt)
+	binary.Write(&b, binary.BigEndian, uint32(4096))
+	b.WriteByte(byte(len(asPublic)))
+	b.Write(asPublic)
+	b.Write(ciphertext)
+	return b.Bytes(), nil
+}
+
+func hkdfExpand(secret, salt, info []byte, length int) ([]byte, error) {
+	out := make([]byte, length)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, secret, salt, info), out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
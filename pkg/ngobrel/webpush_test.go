@@ -0,0 +1,214 @@
+package ngobrel
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/elliptic"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// The example of RFC 8291, section 5
+const (
+	rfc8291Plaintext = "When I grow up, I want to be a watermelon"
+	rfc8291ASPrivate = "yfWPiYE-n46HLnH0KqZOF1fJJU3MYrct3AELtAQ-oRw"
+	rfc8291UAPrivate = "q1dXpw3UpT5VOmu_cf_v6ih07Aems3njxI-JWgLcM94"
+	rfc8291UAPublic  = "BCVxsr7N_eNgVRqvHtD0zTZsEc6-VV-JvLexhqUzORcxaOzi6-AYWXvTBHm4bjyPjs7Vd8pZGH6SRpkNtoIAiw4"
+	rfc8291Salt      = "DGv6ra1nlYgDCS1FRnbzlw"
+	rfc8291Auth      = "BTBZMqHH6r4Tts7J_aSIgg"
+	rfc8291Body      = "DGv6ra1nlYgDCS1FRnbzlwAAEABBBP4z9KsN6nGRTbVYI_c7VJSPQTBtkgcy27mlmlMoZIIgDll6e3vCYLocInmYWAmS6TlzAC8wEqKK6PBru3jl7A_yl95bQpu6cVPTpK4Mqgkf1CXztLVBSt2Ks3oZwbuwXPXLWyouBWLVWGNWQexSgSxsj_Qulcy4a-fN"
+)
+
+func mustDecodeBase64URL(t *testing.T, s string) []byte {
+	b, err := decodeBase64URL(s)
+	if err != nil {
+		t.Fatal(s, err)
+	}
+	return b
+}
+
+func TestEncryptWebPushRFC8291(t *testing.T) {
+	subscription := &webPushSubscription{Endpoint: "https://push.example.net/push/JzLQ3raZJfFBR0aqvOMsLrt54w4rJUsV"}
+	subscription.Keys.P256dh = rfc8291UAPublic
+	subscription.Keys.Auth = rfc8291Auth
+
+	body, err := encryptWebPushWith(subscription, []byte(rfc8291Plaintext),
+		mustDecodeBase64URL(t, rfc8291ASPrivate), mustDecodeBase64URL(t, rfc8291Salt))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := base64.RawURLEncoding.EncodeToString(body); got != rfc8291Body {
+		t.Errorf("got %s\nwant %s", got, rfc8291Body)
+	}
+}
+
+// Decrypts a message as the browser does, with the private key of the subscription
+func decryptWebPush(t *testing.T, body []byte, uaPrivate []byte, uaPublic []byte, authSecret []byte) []byte {
+	curve := elliptic.P256()
+	salt := body[:16]
+	keyIDLength := int(body[20])
+	asPublic := body[21 : 21+keyIDLength]
+	if binary.BigEndian.Uint32(body[16:20]) != 4096 {
+		t.Fatalf("record size %d", binary.BigEndian.Uint32(body[16:20]))
+	}
+
+	asX, asY := elliptic.Unmarshal(curve, asPublic)
+	sharedX, _ := curve.ScalarMult(asX, asY, uaPrivate)
+	ecdhSecret := make([]byte, 32)
+	copyPadded(ecdhSecret, sharedX)
+
+	keyInfo := append(append([]byte("WebPush: info\x00"), uaPublic...), asPublic...)
+	ikm, _ := hkdfExpand(ecdhSecret, authSecret, keyInfo, 32)
+	cek, _ := hkdfExpand(ikm, salt, []byte("Content-Encoding: aes128gcm\x00"), 16)
+	nonce, _ := hkdfExpand(ikm, salt, []byte("Content-Encoding: nonce\x00"), 12)
+
+	block, _ := aes.NewCipher(cek)
+	gcm, _ := cipher.NewGCM(block)
+	plaintext, err := gcm.Open(nil, nonce, body[21+keyIDLength:], nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if plaintext[len(plaintext)-1] != 0x02 {
+		t.Fatalf("no padding delimiter")
+	}
+	return plaintext[:len(plaintext)-1]
+}
+
+func TestWebPushSend(t *testing.T) {
+	var received []byte
+	var authorization string
+	service := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received, _ = ioutil.ReadAll(r.Body)
+		authorization = r.Header.Get("Authorization")
+		if r.Header.Get("Content-Encoding") != "aes128gcm" || r.Header.Get("TTL") == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer service.Close()
+
+	webPush, err := NewWebPush(rfc8291ASPrivate, "mailto:ops@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The stand-in listens on loopback, which the client of NewWebPush refuses, so it is
+	// reached as push.example.net by a client trusting its certificate
+	addr := service.Listener.Addr().String()
+	_, port, _ := net.SplitHostPort(addr)
+	client := service.Client()
+	transport := client.Transport.(*http.Transport)
+	transport.TLSClientConfig.ServerName = "example.com"
+	transport.DialContext = func(ctx context.Context, network, _ string) (net.Conn, error) {
+		return (&net.Dialer{}).DialContext(ctx, network, addr)
+	}
+	webPush.client = client
+
+	token, _ := json.Marshal(map[string]interface{}{
+		"endpoint": "https://push.example.net:" + port + "/push/1",
+		"keys":     map[string]string{"p256dh": rfc8291UAPublic, "auth": rfc8291Auth},
+	})
+
+	err = webPush.SendNotification(string(token), &PushNotification{Title: "Horas", Body: "Hi", Data: map[string]string{"chatID": "1"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	payload := decryptWebPush(t, received, mustDecodeBase64URL(t, rfc8291UAPrivate),
+		mustDecodeBase64URL(t, rfc8291UAPublic), mustDecodeBase64URL(t, rfc8291Auth))
+	var notification map[string]interface{}
+	if err := json.Unmarshal(payload, &notification); err != nil || notification["title"] != "Horas" || notification["body"] != "Hi" {
+		t.Errorf("payload: %s %v", payload, err)
+	}
+
+	prefix, suffix := "vapid t=", ", k="+webPush.PublicKey()
+	if strings.HasPrefix(authorization, prefix) == false || strings.HasSuffix(authorization, suffix) == false {
+		t.Fatalf("authorization: %s", authorization)
+	}
+	jwt := strings.TrimSuffix(strings.TrimPrefix(authorization, prefix), suffix)
+	claims := verifyJWT(t, jwt, &webPush.key.PublicKey)
+	if claims["aud"] != "https://push.example.net:"+port || claims["sub"] != "mailto:ops@example.com" {
+		t.Errorf("claims: %v", claims)
+	}
+}
+
+func TestWebPushEndpoints(t *testing.T) {
+	for _, endpoint := range []string{
+		"http://push.example.net/1",
+		"https://127.0.0.1/1",
+		"https://10.1.2.3/1",
+		"https://[::1]/1",
+		"https://169.254.169.254/latest/meta-data",
+		"https://[fd00::1]/1",
+		"ftp://push.example.net/1",
+	} {
+		token, _ := json.Marshal(map[string]interface{}{
+			"endpoint": endpoint,
+			"keys":     map[string]string{"p256dh": rfc8291UAPublic, "auth": rfc8291Auth},
+		})
+		if _, err := parseWebPushSubscription(string(token)); err == nil {
+			t.Errorf("%s was accepted", endpoint)
+		}
+	}
+
+	token, _ := json.Marshal(map[string]interface{}{
+		"endpoint": "https://fcm.googleapis.com/fcm/send/abc",
+		"keys":     map[string]string{"p256dh": rfc8291UAPublic, "auth": rfc8291Auth},
+	})
+	if _, err := parseWebPushSubscription(string(token)); err != nil {
+		t.Errorf("a push service was refused: %v", err)
+	}
+}
+
+// A name resolving to loopback passes parseWebPushSubscription, the dialer has to refuse it
+func TestWebPushRefusesPrivateAddresses(t *testing.T) {
+	requests := 0
+	service := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer service.Close()
+
+	webPush, err := NewWebPush(rfc8291ASPrivate, "mailto:ops@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	waits, restore := fakePushSleep()
+	defer restore()
+
+	endpoint := strings.Replace(service.URL, "127.0.0.1", "localhost", 1) + "/push/1"
+	token, _ := json.Marshal(map[string]interface{}{
+		"endpoint": endpoint,
+		"keys":     map[string]string{"p256dh": rfc8291UAPublic, "auth": rfc8291Auth},
+	})
+
+	err = webPush.SendNotification(string(token), &PushNotification{})
+	webPushErr, ok := err.(*WebPushError)
+	if ok == false || webPushErr.TokenInvalid() == false {
+		t.Fatalf("got %v", err)
+	}
+	if requests != 0 || len(*waits) != 0 {
+		t.Errorf("%d requests, waits %v", requests, *waits)
+	}
+
+	for _, address := range []string{"127.0.0.1:443", "[::1]:443", "10.0.0.1:443", "192.168.1.1:443", "169.254.169.254:80", "[fe80::1]:443", "0.0.0.0:443", "[::ffff:127.0.0.1]:443"} {
+		if checkWebPushAddress("tcp", address, nil) != errWebPushAddress {
+			t.Errorf("%s was allowed", address)
+		}
+	}
+	for _, address := range []string{"142.250.4.95:443", "[2607:f8b0:4004:c1b::5f]:443"} {
+		if err := checkWebPushAddress("tcp", address, nil); err != nil {
+			t.Errorf("%s was refused: %v", address, err)
+		}
+	}
+}
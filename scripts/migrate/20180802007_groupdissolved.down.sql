@@ -0,0 +1 @@
+ALTER TABLE group_list DROP COLUMN dissolved_at;
@@ -0,0 +1 @@
+ALTER TABLE group_list ADD COLUMN dissolved_at TIMESTAMP null;
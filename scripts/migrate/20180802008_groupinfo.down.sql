@@ -0,0 +1,3 @@
+ALTER TABLE group_list DROP COLUMN description;
+ALTER TABLE group_list DROP COLUMN topic;
+ALTER TABLE group_list DROP COLUMN custom_data;
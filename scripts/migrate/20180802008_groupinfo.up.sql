@@ -0,0 +1,3 @@
+ALTER TABLE group_list ADD COLUMN description TEXT default '';
+ALTER TABLE group_list ADD COLUMN topic TEXT default '';
+ALTER TABLE group_list ADD COLUMN custom_data TEXT;
@@ -0,0 +1,2 @@
+DROP TABLE group_bans;
+DROP TABLE group_mutes;
@@ -0,0 +1,17 @@
+
+CREATE TABLE group_bans (
+  chat_id UUID not null,
+  user_id UUID not null,
+  banned_by UUID not null,
+  created_at TIMESTAMP not null,
+  PRIMARY KEY (chat_id, user_id)
+);
+
+CREATE TABLE group_mutes (
+  chat_id UUID not null,
+  user_id UUID not null,
+  muted_by UUID not null,
+  created_at TIMESTAMP not null,
+  expired_at TIMESTAMP not null,
+  PRIMARY KEY (chat_id, user_id)
+);
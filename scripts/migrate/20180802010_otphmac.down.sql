@@ -0,0 +1,7 @@
+DELETE FROM otp;
+ALTER TABLE otp DROP CONSTRAINT otp_pkey;
+ALTER TABLE otp DROP COLUMN phone_number;
+ALTER TABLE otp DROP COLUMN otp_hash;
+ALTER TABLE otp DROP COLUMN created_at;
+ALTER TABLE otp ADD COLUMN otp_code BIGINT not null;
+ALTER TABLE otp ADD PRIMARY KEY (otp_code);
@@ -0,0 +1,7 @@
+DELETE FROM otp;
+ALTER TABLE otp DROP CONSTRAINT otp_pkey;
+ALTER TABLE otp DROP COLUMN otp_code;
+ALTER TABLE otp ADD COLUMN phone_number TEXT not null;
+ALTER TABLE otp ADD COLUMN otp_hash TEXT not null;
+ALTER TABLE otp ADD COLUMN created_at TIMESTAMP not null;
+ALTER TABLE otp ADD PRIMARY KEY (phone_number);
@@ -0,0 +1,2 @@
+ALTER TABLE devices ADD COLUMN is_primary BOOLEAN not null default false;
+UPDATE devices SET is_primary = true WHERE device_state = 1;
@@ -0,0 +1,3 @@
+ALTER TABLE devices DROP COLUMN device_name;
+ALTER TABLE devices DROP COLUMN platform;
+ALTER TABLE devices DROP COLUMN last_active_at;
@@ -0,0 +1,3 @@
+ALTER TABLE devices ADD COLUMN device_name TEXT default '';
+ALTER TABLE devices ADD COLUMN platform TEXT default '';
+ALTER TABLE devices ADD COLUMN last_active_at TIMESTAMP null;
@@ -0,0 +1,2 @@
+ALTER TABLE profile DROP COLUMN pin_hash;
+ALTER TABLE profile DROP COLUMN pin_verified_at;
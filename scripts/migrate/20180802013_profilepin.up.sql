@@ -0,0 +1,2 @@
+ALTER TABLE profile ADD COLUMN pin_hash TEXT null;
+ALTER TABLE profile ADD COLUMN pin_verified_at TIMESTAMP null;
@@ -0,0 +1,2 @@
+DROP TABLE account_deletions;
+ALTER TABLE profile DROP COLUMN deleted_at;
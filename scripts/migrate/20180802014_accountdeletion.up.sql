@@ -0,0 +1,12 @@
+ALTER TABLE profile ADD COLUMN deleted_at TIMESTAMP null;
+
+CREATE TABLE account_deletions (
+  user_id UUID not null,
+  requested_by UUID not null,
+  requested_at TIMESTAMP not null,
+  completed_at TIMESTAMP null,
+  step SMALLINT not null default 0,
+  attempts INT not null default 0,
+  last_error TEXT default '',
+  PRIMARY KEY (user_id)
+);
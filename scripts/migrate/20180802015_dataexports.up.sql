@@ -0,0 +1,12 @@
+CREATE TABLE data_exports (
+  export_id UUID not null,
+  user_id UUID not null,
+  status TEXT not null,
+  media_id TEXT null,
+  requested_at TIMESTAMP not null,
+  completed_at TIMESTAMP null,
+  PRIMARY KEY (export_id)
+);
+
+CREATE INDEX data_exports_user_id on data_exports(user_id);
+CREATE INDEX data_exports_media_id on data_exports(media_id);
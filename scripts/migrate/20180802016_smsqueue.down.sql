@@ -0,0 +1 @@
+DROP TABLE sms_queue;
@@ -0,0 +1,17 @@
+CREATE TABLE sms_queue (
+  id BIGSERIAL not null,
+  sender TEXT not null,
+  phone_number TEXT not null,
+  message TEXT not null,
+  status TEXT not null,
+  attempts INT not null default 0,
+  next_attempt_at TIMESTAMP not null,
+  provider_message_id TEXT null,
+  last_error TEXT default '',
+  created_at TIMESTAMP not null,
+  updated_at TIMESTAMP not null,
+  PRIMARY KEY (id)
+);
+
+CREATE INDEX sms_queue_status_next_attempt_at on sms_queue(status, next_attempt_at);
+CREATE INDEX sms_queue_provider_message_id on sms_queue(provider_message_id);
@@ -0,0 +1,5 @@
+DROP INDEX sms_queue_otp_phone_number;
+
+ALTER TABLE sms_queue DROP COLUMN otp_phone_number;
+ALTER TABLE sms_queue DROP COLUMN channel;
+ALTER TABLE sms_queue RENAME COLUMN recipient TO phone_number;
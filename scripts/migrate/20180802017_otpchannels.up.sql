@@ -0,0 +1,5 @@
+ALTER TABLE sms_queue RENAME COLUMN phone_number TO recipient;
+ALTER TABLE sms_queue ADD COLUMN channel TEXT not null default 'sms';
+ALTER TABLE sms_queue ADD COLUMN otp_phone_number TEXT null;
+
+CREATE INDEX sms_queue_otp_phone_number on sms_queue(otp_phone_number, status);
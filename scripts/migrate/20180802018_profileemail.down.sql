@@ -0,0 +1,4 @@
+ALTER TABLE profile DROP COLUMN email;
+ALTER TABLE profile DROP COLUMN pending_email;
+ALTER TABLE profile DROP COLUMN pending_email_hash;
+ALTER TABLE profile DROP COLUMN pending_email_expired_at;
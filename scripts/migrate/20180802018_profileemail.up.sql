@@ -0,0 +1,4 @@
+ALTER TABLE profile ADD COLUMN email TEXT null;
+ALTER TABLE profile ADD COLUMN pending_email TEXT null;
+ALTER TABLE profile ADD COLUMN pending_email_hash TEXT null;
+ALTER TABLE profile ADD COLUMN pending_email_expired_at TIMESTAMP null;
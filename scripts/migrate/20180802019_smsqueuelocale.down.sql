@@ -0,0 +1 @@
+ALTER TABLE sms_queue DROP COLUMN locale;
@@ -0,0 +1 @@
+ALTER TABLE sms_queue ADD COLUMN locale TEXT not null default '';
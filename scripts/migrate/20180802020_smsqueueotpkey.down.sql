@@ -0,0 +1,2 @@
+ALTER TABLE sms_queue DROP COLUMN otp_key;
+ALTER TABLE sms_queue DROP COLUMN brand;
@@ -0,0 +1,2 @@
+ALTER TABLE sms_queue ADD COLUMN brand TEXT not null default '';
+ALTER TABLE sms_queue ADD COLUMN otp_key TEXT null;